@@ -78,3 +78,37 @@ func TimeValue(t *time.Time) time.Time {
 	}
 	return time.Time{}
 }
+
+// StringSlice returns a pointer to the string slice passed in.
+func StringSlice(s []string) *[]string {
+	return &s
+}
+
+// StringSliceValue returns the value of the string slice pointer passed in or
+// nil if the pointer is nil.
+func StringSliceValue(s *[]string) []string {
+	if s != nil {
+		return *s
+	}
+	return nil
+}
+
+// Value returns a pointer to the value passed in.
+//
+// It can be used for any type that doesn't have a dedicated helper, e.g.:
+//
+//	auth0.Value(int64(3600))
+//	auth0.Value(map[string]interface{}{"foo": "bar"})
+func Value[T any](v T) *T {
+	return &v
+}
+
+// From returns the value of the pointer passed in or the zero value of its
+// type if the pointer is nil.
+func From[T any](p *T) T {
+	if p != nil {
+		return *p
+	}
+	var zero T
+	return zero
+}
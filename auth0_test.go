@@ -1,6 +1,7 @@
 package auth0
 
 import (
+	"reflect"
 	"testing"
 	"time"
 )
@@ -86,3 +87,42 @@ func TestTime(t *testing.T) {
 		}
 	}
 }
+
+func TestStringSlice(t *testing.T) {
+	for _, test := range []struct {
+		in       *[]string
+		expected []string
+	}{
+		{nil, nil},
+		{StringSlice(nil), nil},
+		{StringSlice([]string{}), []string{}},
+		{StringSlice([]string{"foo", "bar"}), []string{"foo", "bar"}},
+	} {
+		have := StringSliceValue(test.in)
+		if !reflect.DeepEqual(have, test.expected) {
+			t.Errorf("unexpected output. have %v, expected %v", have, test.expected)
+		}
+	}
+}
+
+func TestValue(t *testing.T) {
+	for _, test := range []struct {
+		in       interface{}
+		expected interface{}
+	}{
+		{From[string](nil), ""},
+		{From(Value("foo")), "foo"},
+		{From[int64](nil), int64(0)},
+		{From(Value(int64(3600))), int64(3600)},
+		{From[bool](nil), false},
+		{From(Value(true)), true},
+		{From[[]string](nil), []string(nil)},
+		{From(Value([]string{"foo"})), []string{"foo"}},
+		{From[map[string]interface{}](nil), map[string]interface{}(nil)},
+		{From(Value(map[string]interface{}{"foo": "bar"})), map[string]interface{}{"foo": "bar"}},
+	} {
+		if !reflect.DeepEqual(test.in, test.expected) {
+			t.Errorf("unexpected output. have %v, expected %v", test.in, test.expected)
+		}
+	}
+}
@@ -3,11 +3,11 @@ module github.com/auth0/go-auth0
 go 1.19
 
 require (
-	github.com/PuerkitoBio/rehttp v1.1.0
 	github.com/joho/godotenv v1.5.1
 	github.com/stretchr/testify v1.8.4
 	golang.org/x/oauth2 v0.9.0
 	gopkg.in/dnaeon/go-vcr.v3 v3.1.2
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -17,5 +17,4 @@ require (
 	golang.org/x/net v0.11.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
 	google.golang.org/protobuf v1.28.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/auth0/go-auth0/tokencache"
+)
+
+// clientAssertionType is the type of the client assertions sent with the
+// private_key_jwt authentication method.
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// clientAssertionLifetime is how long client assertions are valid.
+const clientAssertionLifetime = 2 * time.Minute
+
+type signingAlgorithm struct {
+	hash crypto.Hash
+	pss  bool
+
+	// Size in bytes of the r and s values of ECDSA signatures, or 0 for RSA.
+	ecdsaSize int
+}
+
+var signingAlgorithms = map[string]signingAlgorithm{
+	"RS256": {hash: crypto.SHA256},
+	"RS384": {hash: crypto.SHA384},
+	"RS512": {hash: crypto.SHA512},
+	"PS256": {hash: crypto.SHA256, pss: true},
+	"PS384": {hash: crypto.SHA384, pss: true},
+	"PS512": {hash: crypto.SHA512, pss: true},
+	"ES256": {hash: crypto.SHA256, ecdsaSize: 32},
+	"ES384": {hash: crypto.SHA384, ecdsaSize: 48},
+	"ES512": {hash: crypto.SHA512, ecdsaSize: 66},
+}
+
+// SignJWT returns a JWT with the given claims, signed by signer with the
+// algorithm, such as RS256 or ES256.
+//
+// The private key is only used through signer, so that it can be kept in a
+// hardware security module or a key management service, and so that the
+// signature is computed by the implementation of the signer, such as a FIPS
+// validated one.
+func SignJWT(signer crypto.Signer, algorithm string, claims interface{}) (string, error) {
+	alg, ok := signingAlgorithms[algorithm]
+	if !ok {
+		return "", fmt.Errorf("unsupported signing algorithm %q", algorithm)
+	}
+	if err := alg.checkKey(signer.Public(), algorithm); err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": algorithm, "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	h := alg.hash.New()
+	h.Write([]byte(signingInput))
+	digest := h.Sum(nil)
+
+	var opts crypto.SignerOpts = alg.hash
+	if alg.pss {
+		opts = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: alg.hash}
+	}
+
+	signature, err := signer.Sign(rand.Reader, digest, opts)
+	if err != nil {
+		return "", err
+	}
+
+	if alg.ecdsaSize > 0 {
+		if signature, err = ecdsaJWSSignature(signature, alg.ecdsaSize); err != nil {
+			return "", err
+		}
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func (alg signingAlgorithm) checkKey(key crypto.PublicKey, algorithm string) error {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if alg.ecdsaSize == 0 {
+			return nil
+		}
+	case *ecdsa.PublicKey:
+		if alg.ecdsaSize == 0 {
+			break
+		}
+		// Each algorithm signs with a single curve, whose size is that of the
+		// r and s values of signatures.
+		if bitSize := k.Curve.Params().BitSize; (bitSize+7)/8 != alg.ecdsaSize {
+			return fmt.Errorf("signing algorithm %q can't be used with a P-%d key", algorithm, bitSize)
+		}
+		return nil
+	}
+	return fmt.Errorf("signing algorithm %q can't be used with a %T key", algorithm, key)
+}
+
+// ecdsaJWSSignature converts an ASN.1 encoded ECDSA signature, as returned by
+// crypto.Signer implementations, into the concatenation of r and s used by
+// JWS.
+func ecdsaJWSSignature(signature []byte, size int) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(signature, &sig); err != nil {
+		return nil, fmt.Errorf("invalid ECDSA signature: %w", err)
+	}
+	if sig.R.BitLen() > 8*size || sig.S.BitLen() > 8*size {
+		return nil, fmt.Errorf("invalid ECDSA signature: r and s must fit in %d bytes", size)
+	}
+
+	jws := make([]byte, 2*size)
+	sig.R.FillBytes(jws[:size])
+	sig.S.FillBytes(jws[size:])
+
+	return jws, nil
+}
+
+// ClientAssertion returns a client assertion authenticating the client with
+// the private_key_jwt authentication method to the tenant at uri, valid from
+// now.
+func ClientAssertion(signer crypto.Signer, algorithm, uri, clientID string, now time.Time) (string, error) {
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	return SignJWT(signer, algorithm, map[string]interface{}{
+		"iss": clientID,
+		"sub": clientID,
+		"aud": uri + "/",
+		"iat": now.Unix(),
+		"exp": now.Add(clientAssertionLifetime).Unix(),
+		"jti": hex.EncodeToString(jti),
+	})
+}
+
+// OAuth2ClientCredentialsPrivateKeyJWT sets the oauth2 client credentials,
+// authenticating with client assertions signed by signer with the algorithm
+// rather than with a client secret. Tokens are reused until they expire
+// according to clock, and persisted in cache if not nil.
+func OAuth2ClientCredentialsPrivateKeyJWT(
+	ctx context.Context,
+	uri,
+	clientID string,
+	signer crypto.Signer,
+	algorithm,
+	audience string,
+	clock Clock,
+	cache tokencache.Cache,
+) oauth2.TokenSource {
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	var source oauth2.TokenSource = tokenSourceFunc(func() (*oauth2.Token, error) {
+		assertion, err := ClientAssertion(signer, algorithm, uri, clientID, clock.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := &clientcredentials.Config{
+			ClientID:  clientID,
+			TokenURL:  uri + "/oauth/token",
+			AuthStyle: oauth2.AuthStyleInParams,
+			EndpointParams: url.Values{
+				"audience":              []string{audience},
+				"client_assertion_type": []string{clientAssertionType},
+				"client_assertion":      []string{assertion},
+			},
+		}
+
+		return cfg.Token(ctx)
+	})
+	if cache != nil {
+		source = CachedTokenSource(source, cache, tokenCacheKey(uri, clientID, audience), clock)
+	}
+
+	return ReuseTokenSource(source, clock)
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// verifyJWT checks the signature of the JWT with the public key and returns
+// its claims.
+func verifyJWT(t *testing.T, token string, key crypto.PublicKey) map[string]interface{} {
+	t.Helper()
+
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+	var h map[string]string
+	require.NoError(t, json.Unmarshal(header, &h))
+
+	alg := signingAlgorithms[h["alg"]]
+	hash := alg.hash.New()
+	hash.Write([]byte(parts[0] + "." + parts[1]))
+	digest := hash.Sum(nil)
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if alg.pss {
+			assert.NoError(t, rsa.VerifyPSS(key, alg.hash, digest, signature, nil))
+		} else {
+			assert.NoError(t, rsa.VerifyPKCS1v15(key, alg.hash, digest, signature))
+		}
+	case *ecdsa.PublicKey:
+		require.Len(t, signature, 2*alg.ecdsaSize)
+		r := new(big.Int).SetBytes(signature[:alg.ecdsaSize])
+		s := new(big.Int).SetBytes(signature[alg.ecdsaSize:])
+		assert.True(t, ecdsa.Verify(key, digest, r, s))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	var claims map[string]interface{}
+	require.NoError(t, json.Unmarshal(payload, &claims))
+
+	return claims
+}
+
+func TestSignJWT(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecKey384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		algorithm string
+		signer    crypto.Signer
+	}{
+		{"RS256", rsaKey},
+		{"RS384", rsaKey},
+		{"PS256", rsaKey},
+		{"ES256", ecKey},
+		{"ES384", ecKey384},
+	} {
+		t.Run(test.algorithm, func(t *testing.T) {
+			token, err := SignJWT(test.signer, test.algorithm, map[string]string{"sub": "client"})
+			require.NoError(t, err)
+
+			claims := verifyJWT(t, token, test.signer.Public())
+			assert.Equal(t, "client", claims["sub"])
+		})
+	}
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		_, err := SignJWT(rsaKey, "HS256", nil)
+		assert.EqualError(t, err, `unsupported signing algorithm "HS256"`)
+	})
+
+	t.Run("algorithm of another key type", func(t *testing.T) {
+		_, err := SignJWT(ecKey, "RS256", nil)
+		assert.EqualError(t, err, `signing algorithm "RS256" can't be used with a *ecdsa.PublicKey key`)
+	})
+
+	t.Run("algorithm of another curve", func(t *testing.T) {
+		ecKey521, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+		require.NoError(t, err)
+
+		_, err = SignJWT(ecKey384, "ES256", nil)
+		assert.EqualError(t, err, `signing algorithm "ES256" can't be used with a P-384 key`)
+
+		_, err = SignJWT(ecKey521, "ES256", nil)
+		assert.EqualError(t, err, `signing algorithm "ES256" can't be used with a P-521 key`)
+
+		_, err = SignJWT(ecKey, "ES512", nil)
+		assert.EqualError(t, err, `signing algorithm "ES512" can't be used with a P-256 key`)
+	})
+}
+
+func TestOAuth2ClientCredentialsPrivateKeyJWT(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var uri string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "clientID", r.PostForm.Get("client_id"))
+		assert.Equal(t, "myAudience", r.PostForm.Get("audience"))
+		assert.Empty(t, r.PostForm.Get("client_secret"))
+		assert.Equal(t, clientAssertionType, r.PostForm.Get("client_assertion_type"))
+
+		claims := verifyJWT(t, r.PostForm.Get("client_assertion"), key.Public())
+		assert.Equal(t, "clientID", claims["iss"])
+		assert.Equal(t, "clientID", claims["sub"])
+		assert.Equal(t, uri+"/", claims["aud"])
+		assert.NotEmpty(t, claims["jti"])
+		assert.Equal(t, clientAssertionLifetime.Seconds(), claims["exp"].(float64)-claims["iat"].(float64))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"someToken","token_type":"Bearer","expires_in":86400}`))
+	})
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+	uri = testServer.URL
+
+	tokenSource := OAuth2ClientCredentialsPrivateKeyJWT(
+		context.Background(),
+		testServer.URL,
+		"clientID",
+		key,
+		"ES256",
+		"myAudience",
+		nil,
+		nil,
+	)
+
+	token, err := tokenSource.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "someToken", token.AccessToken)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), token.Expiry, time.Minute)
+}
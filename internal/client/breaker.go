@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitOpenError is returned by the circuit breaker transport when requests
+// are rejected without being sent because the remote server is failing.
+type CircuitOpenError struct {
+	// Failures is the number of consecutive failures which opened the circuit.
+	Failures int
+	// RetryAfter is the time after which a probe request will be let through.
+	RetryAfter time.Time
+}
+
+// Error implements the error interface.
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf(
+		"circuit breaker is open after %d consecutive failures, retry after %s",
+		e.Failures,
+		e.RetryAfter.Format(time.RFC3339),
+	)
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type circuitBreaker struct {
+	base      http.RoundTripper
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+}
+
+// CircuitBreakerTransport wraps base transport with a circuit breaker.
+//
+// After threshold consecutive failures, either 5xx responses or timeouts, the
+// circuit opens and requests fail immediately with a *CircuitOpenError. Once
+// the cooldown has elapsed a single probe request is let through, closing the
+// circuit again if it succeeds.
+func CircuitBreakerTransport(base http.RoundTripper, threshold int, cooldown time.Duration) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &circuitBreaker{
+		base:      base,
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// RoundTrip executes a single HTTP transaction unless the circuit is open.
+func (cb *circuitBreaker) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := cb.allow(); err != nil {
+		return nil, err
+	}
+
+	res, err := cb.base.RoundTrip(req)
+	cb.record(isCircuitFailure(res, err), err)
+
+	return res, err
+}
+
+func (cb *circuitBreaker) allow() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case circuitOpen:
+		retryAfter := cb.openedAt.Add(cb.cooldown)
+		if cb.now().Before(retryAfter) {
+			return &CircuitOpenError{Failures: cb.failures, RetryAfter: retryAfter}
+		}
+		cb.state = circuitHalfOpen
+		return nil
+	case circuitHalfOpen:
+		return &CircuitOpenError{Failures: cb.failures, RetryAfter: cb.now().Add(cb.cooldown)}
+	default:
+		return nil
+	}
+}
+
+func (cb *circuitBreaker) record(failed bool, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if !failed {
+		switch {
+		case err == nil:
+			cb.state = circuitClosed
+			cb.failures = 0
+		case cb.state == circuitHalfOpen:
+			// The probe failed for reasons unrelated to the health of the
+			// remote server, such as being canceled, so let another one through.
+			cb.state = circuitOpen
+		}
+		return
+	}
+
+	cb.failures++
+	if cb.state == circuitHalfOpen || cb.failures >= cb.threshold {
+		cb.state = circuitOpen
+		cb.openedAt = cb.now()
+	}
+}
+
+func isCircuitFailure(res *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return true
+		}
+		var netErr net.Error
+		return errors.As(err, &netErr) && netErr.Timeout()
+	}
+	return res.StatusCode >= http.StatusInternalServerError
+}
+
+// WithCircuitBreaker configures the client to stop sending requests after
+// threshold consecutive failures, until a probe request succeeds.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Option {
+	return func(c *http.Client) {
+		c.Transport = CircuitBreakerTransport(c.Transport, threshold, cooldown)
+	}
+}
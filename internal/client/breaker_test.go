@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreakerTransport(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	requests := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(status)
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	now := time.Now()
+	transport := CircuitBreakerTransport(s.Client().Transport, 2, time.Minute)
+	transport.(*circuitBreaker).now = func() time.Time { return now }
+	c := &http.Client{Transport: transport}
+
+	for i := 0; i < 2; i++ {
+		res, err := c.Get(s.URL)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	}
+	assert.Equal(t, 2, requests)
+
+	_, err := c.Get(s.URL)
+	var circuitErr *CircuitOpenError
+	require.ErrorAs(t, err, &circuitErr)
+	assert.Equal(t, 2, circuitErr.Failures)
+	assert.Equal(t, now.Add(time.Minute), circuitErr.RetryAfter)
+	assert.Equal(t, 2, requests)
+
+	// A failing probe opens the circuit again.
+	now = now.Add(time.Minute)
+	res, err := c.Get(s.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	assert.Equal(t, 3, requests)
+
+	_, err = c.Get(s.URL)
+	require.ErrorAs(t, err, &circuitErr)
+	assert.Equal(t, 3, requests)
+
+	// A successful probe closes the circuit.
+	now = now.Add(time.Minute)
+	status = http.StatusOK
+	for i := 0; i < 3; i++ {
+		res, err = c.Get(s.URL)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	}
+	assert.Equal(t, 6, requests)
+}
+
+func TestCircuitBreakerTransport_Timeouts(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	c := &http.Client{Transport: CircuitBreakerTransport(s.Client().Transport, 1, time.Minute)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	require.NoError(t, err)
+	_, err = c.Do(req)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	require.NoError(t, err)
+	_, err = c.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = c.Get(s.URL)
+	var circuitErr *CircuitOpenError
+	assert.ErrorAs(t, err, &circuitErr)
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ReadCache caches the successful responses of GET requests whose path is
+// under one of its paths, for a limited time.
+//
+// Requests with any other method invalidate the cached responses under the
+// same path, as they may have changed the resources.
+type ReadCache struct {
+	ttl   time.Duration
+	paths []string
+
+	mu         sync.Mutex
+	entries    map[string]*cacheEntry
+	generation uint64
+}
+
+type cacheEntry struct {
+	path    string
+	status  int
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+// NewReadCache returns a ReadCache keeping responses for ttl, for the requests
+// whose path is one of paths or under one of them.
+func NewReadCache(ttl time.Duration, paths []string) *ReadCache {
+	return &ReadCache{
+		ttl:     ttl,
+		paths:   paths,
+		entries: map[string]*cacheEntry{},
+	}
+}
+
+// Transport wraps base transport so that requests go through the cache.
+func (c *ReadCache) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		path := c.cachedPath(req.URL.Path)
+		if path == "" {
+			return base.RoundTrip(req)
+		}
+
+		if req.Method != http.MethodGet {
+			res, err := base.RoundTrip(req)
+			c.invalidate(path)
+			return res, err
+		}
+
+		key := req.URL.String()
+		if res := c.lookup(key, req); res != nil {
+			return res, nil
+		}
+
+		generation := c.currentGeneration()
+
+		res, err := base.RoundTrip(req)
+		if err != nil || res.StatusCode != http.StatusOK {
+			return res, err
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		res.Body = io.NopCloser(bytes.NewReader(body))
+
+		// The rate limit reported by the response is stale once it's served
+		// from the cache.
+		header := res.Header.Clone()
+		for _, name := range []string{"X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset"} {
+			header.Del(name)
+		}
+
+		c.store(key, generation, &cacheEntry{
+			path:   path,
+			status: res.StatusCode,
+			header: header,
+			body:   body,
+		})
+
+		return res, nil
+	})
+}
+
+// Invalidate drops every cached response.
+func (c *ReadCache) Invalidate() {
+	c.invalidate("")
+}
+
+func (c *ReadCache) cachedPath(path string) string {
+	for _, p := range c.paths {
+		if path == p || strings.HasPrefix(path, p+"/") {
+			return p
+		}
+	}
+	return ""
+}
+
+func (c *ReadCache) lookup(key string, req *http.Request) *http.Response {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return nil
+	}
+
+	return &http.Response{
+		Status:        http.StatusText(e.status),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+func (c *ReadCache) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generation
+}
+
+// store caches the entry, unless the cache was invalidated since the request
+// was sent, in which case the response may be stale already.
+func (c *ReadCache) store(key string, generation uint64, e *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+
+	e.expires = now.Add(c.ttl)
+	c.entries[key] = e
+}
+
+// invalidate drops the cached responses under path, or every cached response
+// when path is empty.
+func (c *ReadCache) invalidate(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for k, e := range c.entries {
+		if path == "" || e.path == path {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// WithReadCache configures the client to cache responses in cache.
+func WithReadCache(cache *ReadCache) Option {
+	return func(c *http.Client) {
+		if cache != nil {
+			c.Transport = cache.Transport(c.Transport)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCache(t *testing.T) {
+	requests := map[string]int{}
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+		if r.URL.Path == "/api/v2/clients/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(r.URL.Path))
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	cache := NewReadCache(50*time.Millisecond, []string{"/api/v2/clients"})
+	c := &http.Client{Transport: cache.Transport(s.Client().Transport)}
+
+	send := func(method, path string) string {
+		t.Helper()
+		req, err := http.NewRequest(method, s.URL+path, nil)
+		require.NoError(t, err)
+		res, err := c.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, "/api/v2/clients/1", send(http.MethodGet, "/api/v2/clients/1"))
+		send(http.MethodGet, "/api/v2/clients")
+		send(http.MethodGet, "/api/v2/clients/missing")
+		send(http.MethodGet, "/api/v2/client-grants")
+	}
+	assert.Equal(t, map[string]int{
+		"GET /api/v2/clients/1":       1,
+		"GET /api/v2/clients":         1,
+		"GET /api/v2/clients/missing": 2,
+		"GET /api/v2/client-grants":   2,
+	}, requests)
+
+	send(http.MethodPatch, "/api/v2/clients/1")
+	send(http.MethodGet, "/api/v2/clients")
+	assert.Equal(t, 2, requests["GET /api/v2/clients"])
+
+	cache.Invalidate()
+	send(http.MethodGet, "/api/v2/clients")
+	assert.Equal(t, 3, requests["GET /api/v2/clients"])
+
+	time.Sleep(60 * time.Millisecond)
+	send(http.MethodGet, "/api/v2/clients")
+	assert.Equal(t, 4, requests["GET /api/v2/clients"])
+}
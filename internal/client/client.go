@@ -1,11 +1,15 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"math/rand"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -13,11 +17,11 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/PuerkitoBio/rehttp"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 
 	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/tokencache"
 )
 
 // UserAgent is the default user agent string.
@@ -58,32 +62,229 @@ func (rf RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
 	return rf(req)
 }
 
+// RetryHook is called before a failed request is sent again, with the number
+// of the attempt about to be made, starting at 2, the failed response, whose
+// body can be read, and how long is waited before the attempt.
+type RetryHook func(req *http.Request, attempt int, res *http.Response, delay time.Duration)
+
+// RetryDeadlineError is returned when a request isn't retried because waiting
+// before the next attempt would exceed the deadline of its context.
+type RetryDeadlineError struct {
+	// Attempts is the number of attempts made before giving up.
+	Attempts int
+	// Delay is how long would have been waited before the next attempt.
+	Delay time.Duration
+	// Deadline is the deadline of the context of the request.
+	Deadline time.Time
+	// Err is the error of the last attempt.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *RetryDeadlineError) Error() string {
+	return fmt.Sprintf(
+		"gave up after %d attempts: waiting %s for the next attempt would exceed the deadline %s: %v",
+		e.Attempts,
+		e.Delay,
+		e.Deadline.Format(time.RFC3339),
+		e.Err,
+	)
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *RetryDeadlineError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is context.DeadlineExceeded, so that giving up
+// because of the deadline can be told like the deadline being exceeded.
+func (e *RetryDeadlineError) Is(target error) bool {
+	return target == context.DeadlineExceeded
+}
+
+// CheckRetryDeadline returns a *RetryDeadlineError if waiting delay before the
+// next attempt, according to clock, would exceed the deadline of ctx.
+func CheckRetryDeadline(ctx context.Context, clock Clock, attempts int, delay time.Duration, err error) error {
+	deadline, ok := ctx.Deadline()
+	if !ok || !clock.Now().Add(delay).After(deadline) {
+		return nil
+	}
+	return &RetryDeadlineError{Attempts: attempts, Delay: delay, Deadline: deadline, Err: err}
+}
+
+// RetryPolicy configures which failed requests are sent again by
+// RetryTransport.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is sent again, or
+	// unlimited if negative.
+	MaxRetries int
+	// Statuses are the status codes of the responses retried in addition to
+	// 429, for requests with an idempotent method only, as the failed request
+	// may have been carried out.
+	Statuses []int
+}
+
+func (p RetryPolicy) retries(req *http.Request, res *http.Response) bool {
+	if res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+	for _, status := range p.Statuses {
+		if res.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
 // RateLimitTransport wraps base transport with rate limiting functionality.
 //
 // When a 429 status code is returned by the remote server, the
 // "X-RateLimit-Reset" header is used to determine how long the transport will
-// wait until re-issuing the failed request.
-func RateLimitTransport(base http.RoundTripper) http.RoundTripper {
+// wait, according to clock, until re-issuing the failed request. The hooks are
+// called before each new attempt. If the wait would exceed the deadline of the
+// context of the request, a *RetryDeadlineError is returned instead.
+func RateLimitTransport(base http.RoundTripper, clock Clock, onRetry ...RetryHook) http.RoundTripper {
+	return RetryTransport(base, clock, RetryPolicy{MaxRetries: -1}, onRetry...)
+}
+
+// RetryTransport wraps base transport, sending again the requests failing as
+// configured by policy, like RateLimitTransport does with rate limited ones.
+//
+// Rate limited requests are retried once the rate limit is reset, according
+// to the "X-RateLimit-Reset" header, while the others are retried with an
+// exponential backoff with jitter. Once the policy's maximum number of retries
+// is reached, the last response is returned.
+func RetryTransport(base http.RoundTripper, clock Clock, policy RetryPolicy, onRetry ...RetryHook) http.RoundTripper {
 	if base == nil {
 		base = http.DefaultTransport
 	}
-	return rehttp.NewTransport(base, retry, delay)
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		_, streaming := req.Body.(streamingBody)
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil && !streaming {
+			// Buffer the body so that it can be sent again.
+			body, err := io.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			req.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+
+		for attempt := 1; ; attempt++ {
+			res, err := base.RoundTrip(req)
+			if err != nil || !policy.retries(req, res) {
+				return res, err
+			}
+			if policy.MaxRetries >= 0 && attempt > policy.MaxRetries {
+				return res, nil
+			}
+
+			// Streamed bodies which can't be read again can't be resent.
+			if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+				return res, nil
+			}
+
+			wait := backoff(attempt)
+			if res.StatusCode == http.StatusTooManyRequests {
+				wait = delay(res, clock)
+			}
+			if err := CheckRetryDeadline(req.Context(), clock, attempt, wait, errors.New(res.Status)); err != nil {
+				_, _ = io.Copy(io.Discard, res.Body)
+				res.Body.Close()
+				return nil, err
+			}
+
+			for _, hook := range onRetry {
+				hook(req, attempt+1, res, wait)
+			}
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+
+			select {
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			case <-clock.After(wait):
+			}
+
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				req = req.Clone(req.Context())
+				req.Body = body
+			}
+		}
+	})
 }
 
-func retry(attempt rehttp.Attempt) bool {
-	if attempt.Response == nil {
-		return false
-	}
-	return attempt.Response.StatusCode == http.StatusTooManyRequests
+// StreamingBody marks body as too large to be held in memory, so that the
+// requests sending it aren't buffered to be sent again when rate limited.
+// Such requests are only sent again if they can get a new copy of their body
+// with GetBody.
+func StreamingBody(body io.ReadCloser) io.ReadCloser {
+	return streamingBody{body}
+}
+
+type streamingBody struct {
+	io.ReadCloser
 }
 
-func delay(attempt rehttp.Attempt) time.Duration {
-	resetAt := attempt.Response.Header.Get("X-RateLimit-Reset")
+func delay(res *http.Response, clock Clock) time.Duration {
+	resetAt := res.Header.Get("X-RateLimit-Reset")
 	resetAtUnix, err := strconv.ParseInt(resetAt, 10, 64)
 	if err != nil {
-		resetAtUnix = time.Now().Add(5 * time.Second).Unix()
+		resetAtUnix = clock.Now().Add(5 * time.Second).Unix()
 	}
-	return time.Duration(resetAtUnix-time.Now().Unix()) * time.Second
+	return time.Duration(resetAtUnix-clock.Now().Unix()) * time.Second
+}
+
+// backoff returns how long to wait before sending a failed request again after
+// the given number of attempts, doubling with each attempt up to a minute,
+// with a random jitter of up to half of it.
+func backoff(attempt int) time.Duration {
+	wait := time.Minute
+	if attempt < 8 {
+		wait = 500 * time.Millisecond << (attempt - 1)
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)))
+}
+
+// ConfigureTransport returns a copy of the base transport with the given
+// configuration functions applied to it.
+//
+// The base transport must be an *http.Transport, as this is the only type of
+// transport whose connections can be configured.
+func ConfigureTransport(base http.RoundTripper, configure ...func(*http.Transport)) (http.RoundTripper, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	transport, ok := base.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("cannot configure a transport of type %T", base)
+	}
+
+	transport = transport.Clone()
+	for _, fn := range configure {
+		fn(transport)
+	}
+
+	return transport, nil
 }
 
 // UserAgentTransport wraps base transport with a customized "User-Agent" header.
@@ -156,10 +357,19 @@ func WithDebug(debug bool) Option {
 	}
 }
 
-// WithRateLimit configures the client to enable rate limiting.
-func WithRateLimit() Option {
+// WithRetries configures the client to send again the requests failing as
+// configured by policy, as done by RetryTransport.
+func WithRetries(clock Clock, policy RetryPolicy, onRetry ...RetryHook) Option {
+	return func(c *http.Client) {
+		c.Transport = RetryTransport(c.Transport, clock, policy, onRetry...)
+	}
+}
+
+// WithRateLimit configures the client to enable rate limiting, waiting
+// according to clock and calling the hooks before each new attempt.
+func WithRateLimit(clock Clock, onRetry ...RetryHook) Option {
 	return func(c *http.Client) {
-		c.Transport = RateLimitTransport(c.Transport)
+		c.Transport = RateLimitTransport(c.Transport, clock, onRetry...)
 	}
 }
 
@@ -202,20 +412,32 @@ func Wrap(base *http.Client, tokenSource oauth2.TokenSource, options ...Option)
 	return client
 }
 
-// OAuth2ClientCredentials sets the oauth2 client credentials.
-func OAuth2ClientCredentials(ctx context.Context, uri, clientID, clientSecret string) oauth2.TokenSource {
+// OAuth2ClientCredentials sets the oauth2 client credentials. Tokens are
+// reused until they expire according to clock, and persisted in cache if not nil.
+func OAuth2ClientCredentials(
+	ctx context.Context,
+	uri,
+	clientID,
+	clientSecret string,
+	clock Clock,
+	cache tokencache.Cache,
+) oauth2.TokenSource {
 	audience := uri + "/api/v2/"
-	return OAuth2ClientCredentialsAndAudience(ctx, uri, clientID, clientSecret, audience)
+	return OAuth2ClientCredentialsAndAudience(ctx, uri, clientID, clientSecret, audience, clock, cache)
 }
 
 // OAuth2ClientCredentialsAndAudience sets the oauth2
-// client credentials with a custom audience.
+// client credentials with a custom audience. Tokens are
+// reused until they expire according to clock, and persisted
+// in cache if not nil.
 func OAuth2ClientCredentialsAndAudience(
 	ctx context.Context,
 	uri,
 	clientID,
 	clientSecret,
 	audience string,
+	clock Clock,
+	cache tokencache.Cache,
 ) oauth2.TokenSource {
 	cfg := &clientcredentials.Config{
 		ClientID:     clientID,
@@ -226,7 +448,14 @@ func OAuth2ClientCredentialsAndAudience(
 		},
 	}
 
-	return cfg.TokenSource(ctx)
+	var source oauth2.TokenSource = tokenSourceFunc(func() (*oauth2.Token, error) {
+		return cfg.Token(ctx)
+	})
+	if cache != nil {
+		source = CachedTokenSource(source, cache, tokenCacheKey(uri, clientID, audience), clock)
+	}
+
+	return ReuseTokenSource(source, clock)
 }
 
 // StaticToken sets a static token to be used for oauth2.
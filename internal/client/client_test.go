@@ -35,7 +35,7 @@ func TestWrapRateLimit(t *testing.T) {
 	s := httptest.NewServer(h)
 	defer s.Close()
 
-	c := Wrap(s.Client(), StaticToken(""), WithRateLimit(), WithDebug(true))
+	c := Wrap(s.Client(), StaticToken(""), WithRateLimit(nil), WithDebug(true))
 	r, err := c.Get(s.URL)
 	if err != nil {
 		t.Error(err)
@@ -51,6 +51,22 @@ func TestWrapRateLimit(t *testing.T) {
 	}
 }
 
+func TestBackoff(t *testing.T) {
+	for attempt, wait := range map[int]time.Duration{
+		1:  500 * time.Millisecond,
+		2:  time.Second,
+		3:  2 * time.Second,
+		8:  time.Minute,
+		20: time.Minute,
+	} {
+		for i := 0; i < 10; i++ {
+			d := backoff(attempt)
+			assert.GreaterOrEqual(t, d, wait/2)
+			assert.Less(t, d, wait)
+		}
+	}
+}
+
 func TestWrapUserAgent(t *testing.T) {
 	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ua := r.Header.Get("User-Agent")
@@ -92,6 +108,8 @@ func TestOAuth2ClientCredentialsAndAudience(t *testing.T) {
 		"clientID",
 		"clientSecret",
 		expectedAudience,
+		nil,
+		nil,
 	)
 
 	token, err := tokenSource.Token()
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/auth0/go-auth0/tokencache"
+)
+
+// Clock tells the time and waits for durations to elapse, so that the timing
+// of token expiry, retries and polling can be controlled in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current time
+	// on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// SystemClock is the Clock telling the time of the system.
+var SystemClock Clock = systemClock{}
+
+// tokenExpiryDelta is how long before their expiry tokens are refreshed, as
+// done by the oauth2 package.
+const tokenExpiryDelta = 10 * time.Second
+
+type reuseTokenSource struct {
+	source oauth2.TokenSource
+	clock  Clock
+
+	mu     sync.Mutex
+	token  *oauth2.Token
+	expiry time.Time
+}
+
+// ReuseTokenSource returns a token source returning the same token as long as
+// it's valid according to clock, retrieving a new token from source once it
+// expires.
+func ReuseTokenSource(source oauth2.TokenSource, clock Clock) oauth2.TokenSource {
+	if clock == nil {
+		clock = SystemClock
+	}
+	return &reuseTokenSource{source: source, clock: clock}
+}
+
+// Token returns the current token if it's still valid, or a new token.
+func (s *reuseTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && (s.expiry.IsZero() || s.clock.Now().Before(s.expiry.Add(-tokenExpiryDelta))) {
+		return s.token, nil
+	}
+
+	token, err := s.source.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// The expiry of the token is computed from its lifetime with the time of
+	// the system, which may not be the time of the clock.
+	s.token, s.expiry = token, time.Time{}
+	if !token.Expiry.IsZero() {
+		s.expiry = s.clock.Now().Add(time.Until(token.Expiry))
+	}
+
+	return token, nil
+}
+
+type tokenSourceFunc func() (*oauth2.Token, error)
+
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) {
+	return f()
+}
+
+// CachedTokenSource returns a token source returning the token persisted in
+// cache under key as long as it's valid according to clock, retrieving a new
+// token from source and persisting it once it expires.
+//
+// Errors of the cache are ignored, as tokens can still be retrieved from
+// source without it.
+func CachedTokenSource(source oauth2.TokenSource, cache tokencache.Cache, key string, clock Clock) oauth2.TokenSource {
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	return tokenSourceFunc(func() (*oauth2.Token, error) {
+		// Unlike the expiry computed by reuseTokenSource, the expiry of a
+		// persisted token is an absolute time, compared with the clock as is.
+		if token, err := cache.Get(key); err == nil && token != nil && token.AccessToken != "" &&
+			(token.Expiry.IsZero() || clock.Now().Before(token.Expiry.Add(-tokenExpiryDelta))) {
+			return token, nil
+		}
+
+		token, err := source.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		_ = cache.Set(key, token)
+
+		return token, nil
+	})
+}
+
+// tokenCacheKey returns the key of the tokens issued by the tenant at uri to
+// the client for the audience.
+func tokenCacheKey(uri, clientID, audience string) string {
+	return uri + "|" + clientID + "|" + audience
+}
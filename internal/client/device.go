@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// deviceCodeGrantType is the grant type used to exchange device codes for
+// tokens.
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// DeviceCode is the response of the device authorization endpoint, telling
+// the code the user has to enter at the verification URI.
+type DeviceCode struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+// deviceResponse is the response of the endpoints of the device
+// authorization flow.
+type deviceResponse struct {
+	DeviceCode
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	RefreshToken     string `json:"refresh_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// DeviceFlowError is returned when the device authorization flow fails, such
+// as when the user denied the authorization or the device code expired.
+type DeviceFlowError struct {
+	Code        string
+	Description string
+}
+
+// Error formats the error into a string representation.
+func (e *DeviceFlowError) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("device authorization failed: %s", e.Code)
+	}
+	return fmt.Sprintf("device authorization failed: %s: %s", e.Code, e.Description)
+}
+
+// RequestDeviceCode starts the device authorization flow of the tenant at
+// uri, requesting tokens for the audience with the given scopes.
+func RequestDeviceCode(ctx context.Context, uri, clientID, audience string, scopes []string) (*DeviceCode, error) {
+	res, err := postDeviceForm(ctx, uri+"/oauth/device/code", url.Values{
+		"client_id": {clientID},
+		"audience":  {audience},
+		"scope":     {strings.Join(scopes, " ")},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != "" {
+		return nil, &DeviceFlowError{Code: res.Error, Description: res.ErrorDescription}
+	}
+
+	return &res.DeviceCode, nil
+}
+
+// PollDeviceToken polls the token endpoint of the tenant at uri, waiting
+// according to clock, until the user authorized the device, and returns the
+// resulting token.
+func PollDeviceToken(ctx context.Context, uri, clientID string, code *DeviceCode, clock Clock) (*oauth2.Token, error) {
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	interval := time.Duration(code.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-clock.After(interval):
+		}
+
+		res, err := postDeviceForm(ctx, uri+"/oauth/token", url.Values{
+			"grant_type":  {deviceCodeGrantType},
+			"device_code": {code.DeviceCode},
+			"client_id":   {clientID},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		switch res.Error {
+		case "":
+			token := &oauth2.Token{
+				AccessToken:  res.AccessToken,
+				TokenType:    res.TokenType,
+				RefreshToken: res.RefreshToken,
+			}
+			if res.ExpiresIn > 0 {
+				token.Expiry = time.Now().Add(time.Duration(res.ExpiresIn) * time.Second)
+			}
+			return token, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		default:
+			return nil, &DeviceFlowError{Code: res.Error, Description: res.ErrorDescription}
+		}
+	}
+}
+
+// DeviceTokenSource returns a token source returning token as long as it's
+// valid according to clock, then refreshing it with its refresh token, if
+// any.
+func DeviceTokenSource(ctx context.Context, uri, clientID string, token *oauth2.Token, clock Clock) oauth2.TokenSource {
+	cfg := &oauth2.Config{
+		ClientID: clientID,
+		Endpoint: oauth2.Endpoint{
+			TokenURL:  uri + "/oauth/token",
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+
+	initial := token
+	refreshToken := token.RefreshToken
+	return ReuseTokenSource(tokenSourceFunc(func() (*oauth2.Token, error) {
+		if initial != nil {
+			token := initial
+			initial = nil
+			return token, nil
+		}
+		if refreshToken == "" {
+			return nil, fmt.Errorf("the access token expired and can't be refreshed without a refresh token")
+		}
+
+		token, err := cfg.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+		if err != nil {
+			return nil, err
+		}
+		if token.RefreshToken != "" {
+			refreshToken = token.RefreshToken
+		}
+		return token, nil
+	}), clock)
+}
+
+func postDeviceForm(ctx context.Context, uri string, form url.Values) (*deviceResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	httpClient := http.DefaultClient
+	if c, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok && c != nil {
+		httpClient = c
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+
+	var response deviceResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode the response of %s (%s): %w", uri, res.Status, err)
+	}
+	if res.StatusCode >= http.StatusBadRequest && response.Error == "" {
+		response.Error = http.StatusText(res.StatusCode)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestDeviceTokenSource(t *testing.T) {
+	var refreshToken string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.Form.Get("grant_type"))
+		refreshToken = r.Form.Get("refresh_token")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"refreshed","token_type":"Bearer","expires_in":86400}`))
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	source := DeviceTokenSource(context.Background(), s.URL, "client-id", &oauth2.Token{
+		AccessToken:  "initial",
+		RefreshToken: "refresh-token",
+		Expiry:       time.Now().Add(time.Second),
+	}, nil)
+
+	token, err := source.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "initial", token.AccessToken)
+
+	// The initial token expires within the expiry delta, so it's refreshed.
+	token, err = source.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "refreshed", token.AccessToken)
+	assert.Equal(t, "refresh-token", refreshToken)
+}
+
+func TestDeviceTokenSource_WithoutRefreshToken(t *testing.T) {
+	source := DeviceTokenSource(context.Background(), "https://example.auth0.com", "client-id", &oauth2.Token{
+		AccessToken: "initial",
+		Expiry:      time.Now().Add(time.Second),
+	}, nil)
+
+	_, err := source.Token()
+	require.NoError(t, err)
+
+	_, err = source.Token()
+	assert.EqualError(t, err, "the access token expired and can't be refreshed without a refresh token")
+}
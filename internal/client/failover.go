@@ -0,0 +1,69 @@
+package client
+
+import (
+	"errors"
+	"net"
+	"net/http"
+)
+
+// FailoverTransport wraps base transport so that requests sent to host which
+// fail to connect, because the host can't be resolved or the connection is
+// refused or times out, are sent again to each of the failover hosts in turn
+// until one of them can be connected to.
+//
+// Requests are always sent to host first. Requests sent to other hosts, and
+// requests whose body can't be sent again, aren't failed over.
+func FailoverTransport(base http.RoundTripper, host string, failover []string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if len(failover) == 0 {
+		return base
+	}
+
+	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		res, err := base.RoundTrip(req)
+		if req.URL.Host != host || (req.Body != nil && req.GetBody == nil) {
+			return res, err
+		}
+
+		for _, h := range failover {
+			if !isConnectionFailure(err) || req.Context().Err() != nil {
+				break
+			}
+
+			r := req.Clone(req.Context())
+			r.URL.Host = h
+			r.Host = ""
+			if req.Body != nil {
+				if r.Body, err = req.GetBody(); err != nil {
+					return nil, err
+				}
+			}
+
+			res, err = base.RoundTrip(r)
+		}
+
+		return res, err
+	})
+}
+
+// isConnectionFailure returns true if err was returned because the connection
+// to the host couldn't be established, in which case the request wasn't sent.
+func isConnectionFailure(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// WithFailover configures the client to send the requests to host that fail to
+// connect to each of the failover hosts in turn.
+func WithFailover(host string, failover []string) Option {
+	return func(c *http.Client) {
+		c.Transport = FailoverTransport(c.Transport, host, failover)
+	}
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverTransport(t *testing.T) {
+	var bodies []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusOK)
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	down := httptest.NewServer(h)
+	down.Close()
+
+	downURL, err := url.Parse(down.URL)
+	require.NoError(t, err)
+	upURL, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	c := &http.Client{Transport: FailoverTransport(http.DefaultTransport, downURL.Host, []string{"unresolvable.invalid", upURL.Host})}
+
+	res, err := c.Post(down.URL+"/api/v2/users", "application/json", strings.NewReader(`{"name":"Jane"}`))
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, upURL.Host, res.Request.URL.Host)
+	assert.Equal(t, []string{`{"name":"Jane"}`}, bodies)
+
+	// Requests to other hosts aren't failed over.
+	c = &http.Client{Transport: FailoverTransport(http.DefaultTransport, "example.com", []string{upURL.Host})}
+	_, err = c.Get(down.URL)
+	assert.Error(t, err)
+}
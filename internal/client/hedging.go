@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HedgingTransport wraps base transport so that GET and HEAD requests which
+// haven't received a response after delay are sent a second time, returning
+// whichever response is received first. The other attempt is canceled.
+//
+// Requests with other methods, or with a body that can't be sent again, are
+// sent once.
+func HedgingTransport(base http.RoundTripper, delay time.Duration) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if delay <= 0 {
+		return base
+	}
+
+	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if (req.Method != http.MethodGet && req.Method != http.MethodHead) ||
+			(req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+			return base.RoundTrip(req)
+		}
+
+		results := make(chan hedgedResult, 2)
+		send := func(body io.ReadCloser) {
+			ctx, cancel := context.WithCancel(req.Context())
+			r := req.Clone(ctx)
+			r.Body = body
+			go func() {
+				res, err := base.RoundTrip(r)
+				results <- hedgedResult{res, err, cancel}
+			}()
+		}
+
+		send(req.Body)
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case r := <-results:
+			return r.response()
+		case <-req.Context().Done():
+			r := <-results
+			return r.response()
+		case <-timer.C:
+			body := req.Body
+			if req.GetBody != nil {
+				var err error
+				if body, err = req.GetBody(); err != nil {
+					r := <-results
+					return r.response()
+				}
+			}
+			send(body)
+		}
+
+		first := <-results
+		if first.err != nil {
+			// Wait for the other attempt, as it may still succeed.
+			second := <-results
+			if second.err == nil {
+				first.cancel()
+				return second.response()
+			}
+			second.cancel()
+			return first.response()
+		}
+
+		go func() {
+			second := <-results
+			second.cancel()
+			if second.res != nil {
+				second.res.Body.Close()
+			}
+		}()
+
+		return first.response()
+	})
+}
+
+type hedgedResult struct {
+	res    *http.Response
+	err    error
+	cancel context.CancelFunc
+}
+
+// response returns the response of the attempt, whose context is canceled
+// once its body is closed.
+func (r hedgedResult) response() (*http.Response, error) {
+	if r.err != nil {
+		r.cancel()
+		return r.res, r.err
+	}
+
+	r.res.Body = &cancelingBody{ReadCloser: r.res.Body, cancel: r.cancel}
+	return r.res, nil
+}
+
+type cancelingBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the context of the request.
+func (b *cancelingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// WithHedging configures the client to send GET and HEAD requests a second
+// time when they haven't received a response after delay.
+func WithHedging(delay time.Duration) Option {
+	return func(c *http.Client) {
+		c.Transport = HedgingTransport(c.Transport, delay)
+	}
+}
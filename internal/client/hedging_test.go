@@ -0,0 +1,63 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHedgingTransport(t *testing.T) {
+	var attempts int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := atomic.AddInt32(&attempts, 1)
+		if r.URL.Path == "/slow" && attempt == 1 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+		w.Write([]byte(r.Method + " " + r.URL.Path))
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	c := &http.Client{Transport: HedgingTransport(s.Client().Transport, 20*time.Millisecond)}
+
+	for _, test := range []struct {
+		method, path string
+		attempts     int32
+	}{
+		{http.MethodGet, "/slow", 2},
+		{http.MethodGet, "/fast", 1},
+		{http.MethodPost, "/slow", 1},
+	} {
+		atomic.StoreInt32(&attempts, 0)
+
+		req, err := http.NewRequest(test.method, s.URL+test.path, strings.NewReader(""))
+		require.NoError(t, err)
+		if test.method == http.MethodGet {
+			req.Body = nil
+		}
+
+		start := time.Now()
+		res, err := c.Do(req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		res.Body.Close()
+
+		assert.Equal(t, test.method+" "+test.path, string(body))
+		assert.Equal(t, test.attempts, atomic.LoadInt32(&attempts), "%s %s", test.method, test.path)
+		if test.method == http.MethodGet {
+			assert.Less(t, time.Since(start), 500*time.Millisecond)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"container/list"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ConcurrencyLimitTransport wraps base transport so that no more than limit
+// requests are in flight at the same time.
+//
+// Requests over the limit wait for a slot in the order they were made, or
+// until their context is done. A slot is held until the body of the response
+// is closed.
+func ConcurrencyLimitTransport(base http.RoundTripper, limit int) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if limit < 1 {
+		return base
+	}
+
+	slots := newFIFOSemaphore(limit)
+
+	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if err := slots.acquire(req.Context()); err != nil {
+			return nil, err
+		}
+
+		release := slots.release
+
+		res, err := base.RoundTrip(req)
+		if err != nil {
+			release()
+			return res, err
+		}
+
+		res.Body = &releasingBody{ReadCloser: res.Body, release: release}
+
+		return res, nil
+	})
+}
+
+// fifoSemaphore hands out a limited number of slots, to the waiters in the
+// order they started waiting.
+type fifoSemaphore struct {
+	mu      sync.Mutex
+	free    int
+	waiters list.List // of chan struct{}, closed when given a slot.
+}
+
+func newFIFOSemaphore(slots int) *fifoSemaphore {
+	return &fifoSemaphore{free: slots}
+}
+
+// acquire takes a slot, waiting behind the earlier waiters for one to be
+// released, or until ctx is done.
+func (s *fifoSemaphore) acquire(ctx context.Context) error {
+	s.mu.Lock()
+	if s.free > 0 && s.waiters.Len() == 0 {
+		s.free--
+		s.mu.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	waiter := s.waiters.PushBack(ready)
+	s.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+	select {
+	case <-ready:
+		// The slot was handed over as ctx was done, pass it on.
+		s.mu.Unlock()
+		s.release()
+	default:
+		s.waiters.Remove(waiter)
+		s.mu.Unlock()
+	}
+
+	return ctx.Err()
+}
+
+// release gives back a slot, handing it over to the oldest waiter if any.
+func (s *fifoSemaphore) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if oldest := s.waiters.Front(); oldest != nil {
+		s.waiters.Remove(oldest)
+		close(oldest.Value.(chan struct{}))
+		return
+	}
+	s.free++
+}
+
+// waiting returns the number of waiters.
+func (s *fifoSemaphore) waiting() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.waiters.Len()
+}
+
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+// Close closes the body and releases the slot held by the request.
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// WithMaxConcurrentRequests configures the client to limit the number of
+// requests in flight at the same time.
+func WithMaxConcurrentRequests(limit int) Option {
+	return func(c *http.Client) {
+		c.Transport = ConcurrencyLimitTransport(c.Transport, limit)
+	}
+}
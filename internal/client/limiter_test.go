@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrencyLimitTransport(t *testing.T) {
+	var inFlight, maxInFlight int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			highest := atomic.LoadInt32(&maxInFlight)
+			if current <= highest || atomic.CompareAndSwapInt32(&maxInFlight, highest, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	c := &http.Client{Transport: ConcurrencyLimitTransport(s.Client().Transport, 2)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := c.Get(s.URL)
+			if assert.NoError(t, err) {
+				res.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), maxInFlight)
+}
+
+func TestConcurrencyLimitTransport_ContextDone(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	c := &http.Client{Transport: ConcurrencyLimitTransport(s.Client().Transport, 1)}
+
+	res, err := c.Get(s.URL)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	require.NoError(t, err)
+
+	_, err = c.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, res.Body.Close())
+
+	res, err = c.Get(s.URL)
+	require.NoError(t, err)
+	assert.NoError(t, res.Body.Close())
+}
+
+func TestFIFOSemaphore(t *testing.T) {
+	s := newFIFOSemaphore(1)
+	require.NoError(t, s.acquire(context.Background()))
+
+	var (
+		mu       sync.Mutex
+		admitted []int
+		wg       sync.WaitGroup
+	)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			require.NoError(t, s.acquire(context.Background()))
+
+			mu.Lock()
+			admitted = append(admitted, i)
+			mu.Unlock()
+
+			s.release()
+		}(i)
+
+		// Wait for each waiter to be queued before starting the next one.
+		require.Eventually(t, func() bool { return s.waiting() == i+1 }, time.Second, time.Millisecond)
+	}
+
+	// A waiter whose context is done leaves the queue.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, s.acquire(ctx), context.Canceled)
+	assert.Equal(t, 5, s.waiting())
+
+	s.release()
+	wg.Wait()
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, admitted)
+	assert.Equal(t, 0, s.waiting())
+	assert.Equal(t, 1, s.free)
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the rate of the requests sent by every client using it,
+// so that several clients of the same tenant share its rate limit.
+//
+// Requests are allowed at a steady rate, with bursts of up to a given number
+// of requests. When a request is rate limited by Auth0 anyway, such as by
+// clients of other processes, no request is allowed until the rate limit
+// resets.
+type RateLimiter struct {
+	rate  float64
+	burst float64
+	clock Clock
+
+	mu      sync.Mutex
+	tokens  float64
+	last    time.Time
+	blocked time.Time
+}
+
+// NewRateLimiter returns a rate limiter allowing requestsPerSecond requests
+// per second, with bursts of up to burst requests, according to clock. The
+// rate isn't limited if requestsPerSecond isn't positive, but requests are
+// still held while rate limited by Auth0.
+func NewRateLimiter(requestsPerSecond float64, burst int, clock Clock) *RateLimiter {
+	if clock == nil {
+		clock = SystemClock
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		clock:  clock,
+		tokens: float64(burst),
+		last:   clock.Now(),
+	}
+}
+
+// Wait waits until a request is allowed, or until ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-l.clock.After(wait):
+		return nil
+	}
+}
+
+// reserve takes a token from the bucket and returns how long to wait before
+// sending the request, the bucket going into debt for waiting requests so that
+// they're allowed in the order they were made.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	wait := l.blocked.Sub(now)
+	if l.rate <= 0 {
+		return wait
+	}
+
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+	}
+
+	l.tokens--
+	if l.tokens < 0 {
+		if debt := time.Duration(-l.tokens / l.rate * float64(time.Second)); debt > wait {
+			wait = debt
+		}
+	}
+
+	return wait
+}
+
+// cancel gives back the token taken by a request which wasn't sent.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate > 0 {
+		l.tokens++
+	}
+}
+
+// block prevents any request from being allowed until the given time.
+func (l *RateLimiter) block(until time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until.After(l.blocked) {
+		l.blocked = until
+	}
+}
+
+// RateLimiterTransport wraps base transport so that requests wait for limiter
+// to allow them before being sent. When a request is rate limited by Auth0,
+// the limiter holds every request until the rate limit resets.
+func RateLimiterTransport(base http.RoundTripper, limiter *RateLimiter) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if limiter == nil {
+		return base
+	}
+
+	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		res, err := base.RoundTrip(req)
+		if err == nil && res.StatusCode == http.StatusTooManyRequests {
+			limiter.block(limiter.clock.Now().Add(delay(res, limiter.clock)))
+		}
+
+		return res, err
+	})
+}
+
+// WithRateLimiter configures the client to wait for limiter to allow requests
+// before sending them.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *http.Client) {
+		c.Transport = RateLimiterTransport(c.Transport, limiter)
+	}
+}
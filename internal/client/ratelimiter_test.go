@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	limiter := NewRateLimiter(20, 2, nil)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, limiter.Wait(context.Background()))
+	}
+
+	// The first 2 requests are allowed by the burst, the next 2 at 20
+	// requests per second.
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimiter_ContextDone(t *testing.T) {
+	limiter := NewRateLimiter(1, 1, nil)
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := limiter.Wait(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRateLimiterTransport_Shared(t *testing.T) {
+	var requests int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusOK)
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	limiter := NewRateLimiter(20, 1, nil)
+	first := &http.Client{Transport: RateLimiterTransport(s.Client().Transport, limiter)}
+	second := &http.Client{Transport: RateLimiterTransport(s.Client().Transport, limiter)}
+
+	start := time.Now()
+	for _, c := range []*http.Client{first, second, first, second} {
+		res, err := c.Get(s.URL)
+		require.NoError(t, err)
+		res.Body.Close()
+	}
+
+	assert.Equal(t, int32(4), requests)
+	assert.GreaterOrEqual(t, time.Since(start), 140*time.Millisecond)
+}
+
+func TestRateLimiterTransport_TooManyRequests(t *testing.T) {
+	var limited int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.CompareAndSwapInt32(&limited, 0, 1) {
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Second).Unix(), 10))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	limiter := NewRateLimiter(0, 1, nil)
+	c := &http.Client{Transport: RateLimiterTransport(s.Client().Transport, limiter)}
+
+	res, err := c.Get(s.URL)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	require.NoError(t, err)
+
+	_, err = c.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,448 @@
+package management
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0"
+)
+
+const (
+	// ActionMigrationSourceRule is the source of the migrations of rules.
+	ActionMigrationSourceRule = "rule"
+	// ActionMigrationSourceHook is the source of the migrations of hooks.
+	ActionMigrationSourceHook = "hook"
+)
+
+// ActionMigrationRuntime is the Node runtime of the actions generated by a
+// migration.
+const ActionMigrationRuntime = "node18"
+
+// DefaultActionMigrationPollInterval is how often the status of a migrated
+// action is checked while it's being built, before it's deployed.
+const DefaultActionMigrationPollInterval = time.Second
+
+// actionMigrationTrigger describes the trigger replacing a rule or a hook
+// extensibility point.
+type actionMigrationTrigger struct {
+	id, version, handler, doc string
+}
+
+// ruleMigrationTrigger is the trigger replacing the rules.
+var ruleMigrationTrigger = actionMigrationTrigger{
+	id:      ActionTriggerPostLogin,
+	version: "v3",
+	handler: "onExecutePostLogin",
+	doc:     " * @param {Event} event - Details about the user and the context in which they are logging in.\n * @param {PostLoginAPI} api - Interface whose methods can be used to change the behavior of the login.",
+}
+
+// hookMigrationTriggers are the triggers replacing the hook extensibility
+// points.
+var hookMigrationTriggers = map[string]actionMigrationTrigger{
+	"credentials-exchange": {
+		id:      "credentials-exchange",
+		version: "v2",
+		handler: "onExecuteCredentialsExchange",
+		doc:     " * @param {Event} event - Details about the client credentials grant request.\n * @param {CredentialsExchangeAPI} api - Interface whose methods can be used to change the behavior of the client credentials grant.",
+	},
+	"pre-user-registration": {
+		id:      "pre-user-registration",
+		version: "v2",
+		handler: "onExecutePreUserRegistration",
+		doc:     " * @param {Event} event - Details about the context and user that is attempting to register.\n * @param {PreUserRegistrationAPI} api - Interface whose methods can be used to change the behavior of the signup.",
+	},
+	"post-user-registration": {
+		id:      "post-user-registration",
+		version: "v2",
+		handler: "onExecutePostUserRegistration",
+		doc:     " * @param {Event} event - Details about the context and user that has registered.\n * @param {PostUserRegistrationAPI} api - Methods and utilities to help change the behavior after a signup.",
+	},
+	"post-change-password": {
+		id:      "post-change-password",
+		version: "v2",
+		handler: "onExecutePostChangePassword",
+		doc:     " * @param {Event} event - Details about the user and the context in which the change password is happening.\n * @param {PostChangePasswordAPI} api - Methods and utilities to help change the behavior after a user changes their password.",
+	},
+}
+
+// ruleMigrationWarnings are the warnings of the rules using features which
+// have no direct equivalent in actions, by the code using them.
+var ruleMigrationWarnings = []struct {
+	code, warning string
+}{
+	{"context.redirect", "redirects must be ported to api.redirect.sendUserTo and exports.onContinuePostLogin"},
+	{"auth0.users.", "the auth0 object isn't available, use api.user.setAppMetadata and api.user.setUserMetadata or the Management API instead"},
+	{"auth0.accessToken", "the auth0 object isn't available, use the Management API with a client credentials grant instead"},
+	{"global.", "the global object isn't shared with actions, use api.cache instead"},
+	{"UnauthorizedError", "errors must be ported to api.access.deny"},
+	{"context.idToken", "custom claims must be ported to api.idToken.setCustomClaim"},
+	{"context.accessToken", "custom claims and scopes must be ported to api.accessToken"},
+	{"context.multifactor", "multi-factor authentication must be ported to api.multifactor.enable"},
+}
+
+// hookMigrationWarning is the warning of every hook, as the callback of hooks
+// doesn't exist in actions.
+const hookMigrationWarning = "the callback must be replaced by the api object of the action"
+
+var (
+	ruleConfigurationPattern = regexp.MustCompile(`configuration\s*(?:\.\s*([A-Za-z_$][A-Za-z0-9_$]*)|\[\s*['"]([^'"]+)['"]\s*\])`)
+	requirePattern           = regexp.MustCompile(`require\(\s*['"]([^'"]+)['"]\s*\)`)
+)
+
+// nodeBuiltinModules are the Node modules which aren't npm dependencies.
+var nodeBuiltinModules = map[string]bool{
+	"assert":         true,
+	"buffer":         true,
+	"child_process":  true,
+	"crypto":         true,
+	"dns":            true,
+	"events":         true,
+	"fs":             true,
+	"http":           true,
+	"https":          true,
+	"net":            true,
+	"os":             true,
+	"path":           true,
+	"querystring":    true,
+	"stream":         true,
+	"string_decoder": true,
+	"url":            true,
+	"util":           true,
+	"zlib":           true,
+}
+
+// ActionMigration is the draft of an action equivalent to a rule or a hook,
+// to help migrating them to actions.
+//
+// The code of the draft is a scaffold of the handler of the trigger, with the
+// code of the rule or the hook kept as a comment, which must be ported before
+// the action is bound to the trigger.
+//
+// See: https://auth0.com/docs/customize/actions/migrate
+type ActionMigration struct {
+	// Source is either ActionMigrationSourceRule or ActionMigrationSourceHook.
+	Source string
+
+	// SourceID is the id of the rule or the hook.
+	SourceID string
+
+	// SourceName is the name of the rule or the hook.
+	SourceName string
+
+	// SourceEnabled is whether the rule or the hook is enabled. The action
+	// should only be bound to the trigger when the source is disabled, as
+	// both would run otherwise.
+	SourceEnabled bool
+
+	// Order is the order of the rule, so that the actions are bound in the
+	// same order as the rules ran. It's 0 for hooks.
+	Order int
+
+	// Action is the draft of the action, or nil if the hook extensibility
+	// point has no equivalent trigger.
+	Action *Action
+
+	// Binding binds the action to its trigger, once the action is deployed
+	// and the source disabled.
+	Binding *ActionBinding
+
+	// Warnings describe what must be changed by hand, such as the APIs of
+	// rules and hooks which have no direct equivalent in actions.
+	Warnings []string
+}
+
+// SetSecret sets the value of the secret of the action with the given name.
+//
+// The values of the rule configurations and hook secrets can't be retrieved
+// from Auth0, so the secrets of the migrated actions have no values until they
+// are set.
+func (a *ActionMigration) SetSecret(name, value string) error {
+	if a.Action != nil && a.Action.Secrets != nil {
+		for i, s := range *a.Action.Secrets {
+			if s.GetName() == name {
+				(*a.Action.Secrets)[i].Value = auth0.String(value)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("the migration of the %s %q has no secret %q", a.Source, a.SourceName, name)
+}
+
+// MissingSecrets returns the names of the secrets of the action whose values
+// haven't been set.
+func (a *ActionMigration) MissingSecrets() []string {
+	var missing []string
+	if a.Action != nil && a.Action.Secrets != nil {
+		for _, s := range *a.Action.Secrets {
+			if s.Value == nil {
+				missing = append(missing, s.GetName())
+			}
+		}
+	}
+	return missing
+}
+
+// PlanMigration reads the rules and the hooks of the tenant, and returns the
+// drafts of the equivalent actions: the rules, sorted by order, and then the
+// hooks.
+//
+// Nothing is changed on the tenant, see DeployMigration to create the drafted
+// actions.
+func (m *ActionManager) PlanMigration(opts ...RequestOption) ([]*ActionMigration, error) {
+	rules, err := listAll(m.Management, listPages(m.Rule.List, (*RuleList).items), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var configKeys map[string]bool
+	if len(rules) > 0 {
+		configs, err := m.RuleConfig.List(opts...)
+		if err != nil {
+			return nil, err
+		}
+		configKeys = make(map[string]bool, len(configs))
+		for _, c := range configs {
+			configKeys[c.GetKey()] = true
+		}
+	}
+
+	hooks, err := listAll(m.Management, listPages(m.Hook.List, (*HookList).items), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].GetOrder() < rules[j].GetOrder()
+	})
+
+	migrations := make([]*ActionMigration, 0, len(rules)+len(hooks))
+	for _, r := range rules {
+		migrations = append(migrations, migrateRule(r, configKeys))
+	}
+	for _, h := range hooks {
+		secrets, err := m.Hook.Secrets(h.GetID(), opts...)
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, migrateHook(h, secrets))
+	}
+
+	return migrations, nil
+}
+
+// DeployMigration creates the action drafted by the migration, waits until
+// it's built and deploys it, without binding it to its trigger, so that it
+// doesn't run alongside the rule or the hook it replaces. The created action
+// is returned.
+//
+// The values of all the secrets of the action must have been set with
+// SetSecret. An error is returned if an action with the same name already
+// exists. The requests are made with ctx, unless a Context option is passed.
+func (m *ActionManager) DeployMigration(ctx context.Context, a *ActionMigration, opts ...RequestOption) (*Action, error) {
+	if a.Action == nil {
+		return nil, fmt.Errorf("the %s %q has no equivalent action", a.Source, a.SourceName)
+	}
+	if missing := a.MissingSecrets(); len(missing) > 0 {
+		return nil, fmt.Errorf("the values of the secrets %q of the action %q must be set", missing, a.Action.GetName())
+	}
+
+	opts = withContext(ctx, opts)
+
+	action := *a.Action
+	if err := m.Create(&action, opts...); err != nil {
+		return nil, err
+	}
+
+	for action.GetStatus() != ActionStatusBuilt {
+		if action.GetStatus() == ActionStatusFailed {
+			return nil, fmt.Errorf("failed to build the action %q", action.GetName())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-m.clock.After(DefaultActionMigrationPollInterval):
+		}
+
+		built, err := m.Read(action.GetID(), opts...)
+		if err != nil {
+			return nil, err
+		}
+		action = *built
+	}
+
+	v, err := m.Deploy(action.GetID(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	action.DeployedVersion = v
+
+	return &action, nil
+}
+
+// migrateRule returns the migration of the rule, whose configuration values
+// with the given keys become secrets of the action.
+func migrateRule(r *Rule, configKeys map[string]bool) *ActionMigration {
+	a := &ActionMigration{
+		Source:        ActionMigrationSourceRule,
+		SourceID:      r.GetID(),
+		SourceName:    r.GetName(),
+		SourceEnabled: r.GetEnabled(),
+		Order:         r.GetOrder(),
+	}
+
+	script := r.GetScript()
+	for _, w := range ruleMigrationWarnings {
+		if strings.Contains(script, w.code) {
+			a.Warnings = append(a.Warnings, w.warning)
+		}
+	}
+
+	var secrets []ActionSecret
+	seen := map[string]bool{}
+	for _, match := range ruleConfigurationPattern.FindAllStringSubmatch(script, -1) {
+		key := match[1] + match[2]
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if !configKeys[key] {
+			a.Warnings = append(a.Warnings, fmt.Sprintf("the rule configuration %q doesn't exist", key))
+		}
+		secrets = append(secrets, ActionSecret{Name: auth0.String(key)})
+	}
+	if len(secrets) > 0 {
+		a.Warnings = append(a.Warnings, "configuration values must be read from event.secrets")
+	}
+
+	dependencies := ruleDependencies(script, a)
+
+	a.Action = newMigratedAction(r.GetName(), ruleMigrationTrigger, "rule", r.GetID(), script, dependencies, secrets)
+	a.Binding = newMigratedActionBinding(r.GetName(), ruleMigrationTrigger)
+
+	return a
+}
+
+// ruleDependencies returns the npm modules required by the script, adding
+// warnings to the migration for the modules without a version.
+func ruleDependencies(script string, a *ActionMigration) []ActionDependency {
+	var dependencies []ActionDependency
+	seen := map[string]bool{}
+	for _, match := range requirePattern.FindAllStringSubmatch(script, -1) {
+		name, version := match[1], ""
+		if i := strings.LastIndex(name, "@"); i > 0 {
+			name, version = name[:i], name[i+1:]
+		}
+		if nodeBuiltinModules[name] || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		d := ActionDependency{Name: auth0.String(name)}
+		if version != "" {
+			d.Version = auth0.String(version)
+		} else {
+			a.Warnings = append(a.Warnings, fmt.Sprintf("the version of the module %q must be set", name))
+		}
+		dependencies = append(dependencies, d)
+	}
+	return dependencies
+}
+
+// migrateHook returns the migration of the hook, whose secrets become
+// secrets of the action.
+func migrateHook(h *Hook, secrets HookSecrets) *ActionMigration {
+	a := &ActionMigration{
+		Source:        ActionMigrationSourceHook,
+		SourceID:      h.GetID(),
+		SourceName:    h.GetName(),
+		SourceEnabled: h.GetEnabled(),
+	}
+
+	trigger, ok := hookMigrationTriggers[h.GetTriggerID()]
+	if !ok {
+		a.Warnings = append(a.Warnings, fmt.Sprintf("the extensibility point %q has no equivalent trigger", h.GetTriggerID()))
+		return a
+	}
+	a.Warnings = append(a.Warnings, hookMigrationWarning)
+
+	keys := secrets.Keys()
+	sort.Strings(keys)
+
+	var actionSecrets []ActionSecret
+	for _, key := range keys {
+		actionSecrets = append(actionSecrets, ActionSecret{Name: auth0.String(key)})
+	}
+	if len(actionSecrets) > 0 {
+		a.Warnings = append(a.Warnings, "secrets must be read from event.secrets")
+	}
+
+	var dependencies []ActionDependency
+	for name, version := range h.GetDependencies() {
+		dependencies = append(dependencies, ActionDependency{Name: auth0.String(name), Version: auth0.String(version)})
+	}
+	sort.Slice(dependencies, func(i, j int) bool {
+		return dependencies[i].GetName() < dependencies[j].GetName()
+	})
+
+	a.Action = newMigratedAction(h.GetName(), trigger, "hook", h.GetID(), h.GetScript(), dependencies, actionSecrets)
+	a.Binding = newMigratedActionBinding(h.GetName(), trigger)
+
+	return a
+}
+
+// newMigratedAction returns the draft of an action for the trigger, whose
+// code is a scaffold keeping the script of the source as a comment.
+func newMigratedAction(
+	name string,
+	trigger actionMigrationTrigger,
+	source, sourceID, script string,
+	dependencies []ActionDependency,
+	secrets []ActionSecret,
+) *Action {
+	var code strings.Builder
+	fmt.Fprintf(&code, "/**\n * Migrated from the %s %q (%s).\n *\n", source, name, sourceID)
+	fmt.Fprintf(&code, " * The code of the %s is kept below for reference, and must be ported\n", source)
+	fmt.Fprintf(&code, " * before the action is bound to the %s trigger.\n *\n", trigger.id)
+	fmt.Fprintf(&code, "%s\n */\n", trigger.doc)
+	fmt.Fprintf(&code, "exports.%s = async (event, api) => {\n  // TODO: port the %s.\n};\n\n", trigger.handler, source)
+	fmt.Fprintf(&code, "/*\n * Original %s:\n *\n", source)
+	for _, line := range strings.Split(strings.ReplaceAll(script, "*/", "*\\/"), "\n") {
+		code.WriteString(strings.TrimRight(" * "+line, " ") + "\n")
+	}
+	code.WriteString(" */\n")
+
+	a := &Action{
+		Name: auth0.String(name),
+		SupportedTriggers: []ActionTrigger{
+			{ID: auth0.String(trigger.id), Version: auth0.String(trigger.version)},
+		},
+		Code:    auth0.String(code.String()),
+		Runtime: auth0.String(ActionMigrationRuntime),
+	}
+	if len(dependencies) > 0 {
+		a.Dependencies = &dependencies
+	}
+	if len(secrets) > 0 {
+		a.Secrets = &secrets
+	}
+	return a
+}
+
+// newMigratedActionBinding returns the binding of the action with the given
+// name to the trigger.
+func newMigratedActionBinding(name string, trigger actionMigrationTrigger) *ActionBinding {
+	return &ActionBinding{
+		TriggerID:   auth0.String(trigger.id),
+		DisplayName: auth0.String(name),
+		Ref: &ActionBindingReference{
+			Type:  auth0.String(ActionBindingReferenceByName),
+			Value: auth0.String(name),
+		},
+	}
+}
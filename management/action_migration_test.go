@@ -0,0 +1,98 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/go-auth0"
+)
+
+func TestActionManager_PlanAndDeployMigration(t *testing.T) {
+	var created *Action
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/rules":
+			w.Write([]byte(`{"rules":[
+				{"id":"rul_2","name":"redirect","order":2,"enabled":true,"script":"function (user, context, callback) {\n  const _ = require('lodash@4.17.21');\n  const crypto = require('crypto');\n  context.redirect = { url: configuration.REDIRECT_URL + configuration['TOKEN'] };\n  return callback(null, user, context);\n}"},
+				{"id":"rul_1","name":"noop","order":1,"enabled":false,"script":"function (user, context, callback) {\n  callback(null, user, context);\n}"}
+			],"start":0,"limit":50,"total":2}`))
+		case "GET /api/v2/rules-configs":
+			w.Write([]byte(`[{"key":"REDIRECT_URL"}]`))
+		case "GET /api/v2/hooks":
+			w.Write([]byte(`{"hooks":[
+				{"id":"hook_1","name":"signup","triggerId":"pre-user-registration","enabled":true,"dependencies":{"axios":"1.6.0"},"script":"module.exports = function (user, context, cb) { cb(null, { user }); };"},
+				{"id":"hook_2","name":"sms","triggerId":"send-phone-message","enabled":false,"script":""}
+			],"start":0,"limit":50,"total":2}`))
+		case "GET /api/v2/hooks/hook_1/secrets":
+			w.Write([]byte(`{"API_TOKEN":"_VALUE_NOT_SHOWN_"}`))
+		case "GET /api/v2/hooks/hook_2/secrets":
+			w.Write([]byte(`{}`))
+		case "POST /api/v2/actions/actions":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.Write([]byte(`{"id":"act_1","name":"signup","status":"pending"}`))
+		case "GET /api/v2/actions/actions/act_1":
+			w.Write([]byte(`{"id":"act_1","name":"signup","status":"built"}`))
+		case "POST /api/v2/actions/actions/act_1/deploy":
+			w.Write([]byte(`{"id":"ver_1","deployed":true}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	m := newTestManagement(t, h, WithClock(immediateClock{}))
+
+	migrations, err := m.Action.PlanMigration()
+	require.NoError(t, err)
+	require.Len(t, migrations, 4)
+
+	noop := migrations[0]
+	assert.Equal(t, "rul_1", noop.SourceID)
+	assert.Equal(t, ActionMigrationSourceRule, noop.Source)
+	assert.False(t, noop.SourceEnabled)
+	assert.Empty(t, noop.Warnings)
+	assert.Equal(t, []ActionTrigger{{ID: auth0.String("post-login"), Version: auth0.String("v3")}}, noop.Action.SupportedTriggers)
+	assert.Contains(t, noop.Action.GetCode(), "exports.onExecutePostLogin = async (event, api) => {")
+	assert.Contains(t, noop.Action.GetCode(), " *   callback(null, user, context);\n")
+	assert.Equal(t, "noop", noop.Binding.Ref.GetValue())
+
+	redirect := migrations[1]
+	assert.Equal(t, 2, redirect.Order)
+	assert.Equal(t, []ActionDependency{{Name: auth0.String("lodash"), Version: auth0.String("4.17.21")}}, *redirect.Action.Dependencies)
+	assert.Equal(t, []string{"REDIRECT_URL", "TOKEN"}, redirect.MissingSecrets())
+	assert.Equal(t, []string{
+		"redirects must be ported to api.redirect.sendUserTo and exports.onContinuePostLogin",
+		`the rule configuration "TOKEN" doesn't exist`,
+		"configuration values must be read from event.secrets",
+	}, redirect.Warnings)
+
+	signup := migrations[2]
+	assert.Equal(t, "pre-user-registration", signup.Action.SupportedTriggers[0].GetID())
+	assert.Contains(t, signup.Action.GetCode(), "exports.onExecutePreUserRegistration")
+	assert.Equal(t, []ActionDependency{{Name: auth0.String("axios"), Version: auth0.String("1.6.0")}}, *signup.Action.Dependencies)
+
+	sms := migrations[3]
+	assert.Nil(t, sms.Action)
+	assert.Equal(t, []string{`the extensibility point "send-phone-message" has no equivalent trigger`}, sms.Warnings)
+
+	_, err = m.Action.DeployMigration(context.Background(), sms)
+	assert.EqualError(t, err, `the hook "sms" has no equivalent action`)
+
+	_, err = m.Action.DeployMigration(context.Background(), signup)
+	assert.EqualError(t, err, `the values of the secrets ["API_TOKEN"] of the action "signup" must be set`)
+
+	assert.Error(t, signup.SetSecret("UNKNOWN", "value"))
+	require.NoError(t, signup.SetSecret("API_TOKEN", "s3cr3t"))
+	assert.NotContains(t, signup.String(), "s3cr3t")
+
+	action, err := m.Action.DeployMigration(context.Background(), signup)
+	require.NoError(t, err)
+	assert.Equal(t, "act_1", action.GetID())
+	assert.Equal(t, "ver_1", action.DeployedVersion.GetID())
+	assert.Equal(t, "s3cr3t", (*created.Secrets)[0].GetValue())
+	assert.Equal(t, "node18", created.GetRuntime())
+}
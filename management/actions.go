@@ -1,10 +1,5 @@
 package management
 
-import (
-	"net/http"
-	"time"
-)
-
 const (
 	// ActionTriggerPostLogin constant.
 	ActionTriggerPostLogin string = "post-login"
@@ -41,7 +36,7 @@ type ActionDependency struct {
 type ActionSecret struct {
 	Name      *string    `json:"name"`
 	Value     *string    `json:"value,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
 }
 
 // ActionVersionError is used to keep track of
@@ -94,11 +89,11 @@ type Action struct {
 	// True if all of an Action's contents have been deployed.
 	AllChangesDeployed bool `json:"all_changes_deployed,omitempty"`
 	// The time when this action was built successfully.
-	BuiltAt *time.Time `json:"built_at,omitempty"`
+	BuiltAt *Timestamp `json:"built_at,omitempty"`
 	// The time when this action was created.
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
 	// The time when this action was updated.
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
 }
 
 // ActionList is a list of Actions.
@@ -121,9 +116,9 @@ type ActionVersion struct {
 	Errors []*ActionVersionError `json:"errors,omitempty"`
 	Action *Action               `json:"action,omitempty"`
 
-	BuiltAt   *time.Time `json:"built_at,omitempty"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	BuiltAt   *Timestamp `json:"built_at,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
 }
 
 // ActionVersionList is a list of ActionVersions.
@@ -156,8 +151,8 @@ type ActionBinding struct {
 	Action  *Action                 `json:"action,omitempty"`
 	Secrets []*ActionSecret         `json:"secrets,omitempty"`
 
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
 }
 
 // ActionBindingList is a list of ActionBindings.
@@ -184,8 +179,8 @@ type ActionExecutionResult struct {
 	ActionName *string                `json:"action_name,omitempty"`
 	Error      map[string]interface{} `json:"error,omitempty"`
 
-	StartedAt *time.Time `json:"started_at,omitempty"`
-	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	StartedAt *Timestamp `json:"started_at,omitempty"`
+	EndedAt   *Timestamp `json:"ended_at,omitempty"`
 }
 
 // ActionExecution is used to retrieve information
@@ -196,8 +191,8 @@ type ActionExecution struct {
 	Status    *string                  `json:"status"`
 	Results   []*ActionExecutionResult `json:"results"`
 
-	CreatedAt *time.Time `json:"created_at"`
-	UpdatedAt *time.Time `json:"updated_at"`
+	CreatedAt *Timestamp `json:"created_at"`
+	UpdatedAt *Timestamp `json:"updated_at"`
 }
 
 // ActionLogSessionFilter defines a filter for the log session.
@@ -210,7 +205,7 @@ type ActionLogSessionFilter struct {
 // logs from Actions.
 type ActionLogSession struct {
 	URL     *string    `json:"url,omitempty"`
-	Expires *time.Time `json:"expires,omitempty"`
+	Expires *Timestamp `json:"expires,omitempty"`
 
 	Filters []ActionLogSessionFilter `json:"filters,omitempty"`
 }
@@ -225,12 +220,7 @@ func newActionManager(m *Management) *ActionManager {
 }
 
 func applyActionsListDefaults(options []RequestOption) RequestOption {
-	return newRequestOption(func(r *http.Request) {
-		PerPage(50).apply(r)
-		for _, option := range options {
-			option.apply(r)
-		}
-	})
+	return newRequestOptions(append([]RequestOption{PerPage(50)}, options...)...)
 }
 
 // Triggers lists the available triggers.
@@ -274,10 +264,50 @@ func (m *ActionManager) Delete(id string, opts ...RequestOption) error {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Actions/get_actions
 func (m *ActionManager) List(opts ...RequestOption) (l *ActionList, err error) {
-	err = m.Request("GET", m.URI("actions", "actions"), &l, applyActionsListDefaults(opts))
+	l, err = listPage[*ActionList](m.Management, m.URI("actions", "actions"), applyActionsListDefaults(opts))
 	return
 }
 
+// ListByTrigger lists the actions supporting the trigger with the given id,
+// e.g. "post-login".
+//
+// See: https://auth0.com/docs/api/management/v2#!/Actions/get_actions
+func (m *ActionManager) ListByTrigger(triggerID string, opts ...RequestOption) (*ActionList, error) {
+	return m.List(withOptions(opts, Parameter("triggerId", triggerID))...)
+}
+
+// ReadByName retrieves the action with the given name, which unlike its id is
+// usually the same across tenants.
+//
+// An error of type *AmbiguousNameError is returned if several actions have
+// the name.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Actions/get_actions
+func (m *ActionManager) ReadByName(name string, opts ...RequestOption) (*Action, error) {
+	if name == "" {
+		return nil, &APIError{StatusCode: 400, Err: "Bad Request", Message: "Name cannot be empty"}
+	}
+
+	// The name filter matches names exactly, so a single page is enough.
+	l, err := m.List(withOptions(opts, Parameter("actionName", name))...)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(l.Actions) {
+	case 0:
+		return nil, &APIError{StatusCode: 404, Err: "Not Found", Message: "Action not found"}
+	case 1:
+		return l.Actions[0], nil
+	}
+
+	ids := make([]string, len(l.Actions))
+	for i, a := range l.Actions {
+		ids[i] = a.GetID()
+	}
+	return nil, &AmbiguousNameError{Resource: "action", Name: name, IDs: ids}
+}
+
 // Version retrieves the version of an action.
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Actions/get_action_version
@@ -290,7 +320,7 @@ func (m *ActionManager) Version(id string, versionID string, opts ...RequestOpti
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Actions/get_action_versions
 func (m *ActionManager) Versions(id string, opts ...RequestOption) (c *ActionVersionList, err error) {
-	err = m.Request("GET", m.URI("actions", "actions", id, "versions"), &c, applyActionsListDefaults(opts))
+	c, err = listPage[*ActionVersionList](m.Management, m.URI("actions", "actions", id, "versions"), applyActionsListDefaults(opts))
 	return
 }
 
@@ -308,7 +338,7 @@ func (m *ActionManager) UpdateBindings(triggerID string, b []*ActionBinding, opt
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Actions/get_bindings
 func (m *ActionManager) Bindings(triggerID string, opts ...RequestOption) (bl *ActionBindingList, err error) {
-	err = m.Request("GET", m.URI("actions", "triggers", triggerID, "bindings"), &bl, applyActionsListDefaults(opts))
+	bl, err = listPage[*ActionBindingList](m.Management, m.URI("actions", "triggers", triggerID, "bindings"), applyActionsListDefaults(opts))
 	return
 }
 
@@ -106,6 +106,40 @@ func TestActionManager_List(t *testing.T) {
 	assert.Equal(t, expectedAction.GetID(), actionList.Actions[0].GetID())
 }
 
+func TestActionManager_ReadByNameAndListByTrigger(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/actions/actions", r.URL.Path)
+
+		switch {
+		case r.URL.Query().Get("triggerId") == "post-login":
+			w.Write([]byte(`{"total":2,"page":0,"per_page":50,"actions":[{"id":"act_1"},{"id":"act_2"}]}`))
+		case r.URL.Query().Get("actionName") == "enrich-profile":
+			w.Write([]byte(`{"total":1,"page":0,"per_page":50,"actions":[{"id":"act_1","name":"enrich-profile"}]}`))
+		case r.URL.Query().Get("actionName") == "duplicate":
+			w.Write([]byte(`{"total":2,"page":0,"per_page":50,"actions":[{"id":"act_3"},{"id":"act_4"}]}`))
+		default:
+			w.Write([]byte(`{"total":0,"page":0,"per_page":50,"actions":[]}`))
+		}
+	})
+	m := newTestManagement(t, h)
+
+	actions, err := m.Action.ListByTrigger("post-login")
+	require.NoError(t, err)
+	assert.Len(t, actions.Actions, 2)
+
+	action, err := m.Action.ReadByName("enrich-profile")
+	require.NoError(t, err)
+	assert.Equal(t, "act_1", action.GetID())
+
+	_, err = m.Action.ReadByName("missing")
+	assert.True(t, IsStatusNotFound(err))
+
+	_, err = m.Action.ReadByName("duplicate")
+	var ambiguousErr *AmbiguousNameError
+	require.ErrorAs(t, err, &ambiguousErr)
+	assert.Equal(t, []string{"act_3", "act_4"}, ambiguousErr.IDs)
+}
+
 func TestActionManager_Triggers(t *testing.T) {
 	configureHTTPTestRecordings(t)
 
@@ -189,3 +189,218 @@ func (m *AttackProtectionManager) UpdateSuspiciousIPThrottling(
 		opts...,
 	)
 }
+
+// The levels of bot detection.
+const (
+	// BotDetectionLevelLow challenges the requests most likely to come from
+	// bots.
+	BotDetectionLevelLow = "low"
+	// BotDetectionLevelMedium balances security and friction.
+	BotDetectionLevelMedium = "medium"
+	// BotDetectionLevelHigh challenges any request likely to come from bots.
+	BotDetectionLevelHigh = "high"
+)
+
+// The policies deciding when bot detection challenges users with a CAPTCHA.
+const (
+	// BotDetectionChallengeNever never challenges users.
+	BotDetectionChallengeNever = "never"
+	// BotDetectionChallengeWhenRisky challenges users when the request is
+	// likely to come from a bot.
+	BotDetectionChallengeWhenRisky = "when_risky"
+	// BotDetectionChallengeAlways always challenges users.
+	BotDetectionChallengeAlways = "always"
+)
+
+// BotDetection challenges requests likely to come from bots with a CAPTCHA.
+//
+// See: https://auth0.com/docs/secure/attack-protection/bot-detection
+type BotDetection struct {
+	// The level of bot detection. Can be one of "low", "medium" or "high".
+	BotDetectionLevel *string `json:"bot_detection_level,omitempty"`
+
+	// When to challenge users logging in with a password. Can be one of
+	// "never", "when_risky" or "always".
+	ChallengePasswordPolicy *string `json:"challenge_password_policy,omitempty"`
+
+	// When to challenge users logging in without a password. Can be one of
+	// "never", "when_risky" or "always".
+	ChallengePasswordlessPolicy *string `json:"challenge_passwordless_policy,omitempty"`
+
+	// When to challenge users resetting their password. Can be one of
+	// "never", "when_risky" or "always".
+	ChallengePasswordResetPolicy *string `json:"challenge_password_reset_policy,omitempty"`
+
+	// The IP addresses and CIDR ranges never challenged.
+	AllowList *[]string `json:"allowlist,omitempty"`
+
+	// Whether bot detection only logs the requests it would challenge.
+	MonitoringModeEnabled *bool `json:"monitoring_mode_enabled,omitempty"`
+}
+
+// GetBotDetection retrieves the bot detection configuration.
+//
+// Required scope: `read:attack_protection`
+//
+// See: https://auth0.com/docs/api/management/v2/attack-protection/get-bot-detection
+func (m *AttackProtectionManager) GetBotDetection(
+	opts ...RequestOption,
+) (*BotDetection, error) {
+	var botDetection BotDetection
+	err := m.Request(
+		http.MethodGet,
+		m.URI("attack-protection", "bot-detection"),
+		&botDetection,
+		opts...,
+	)
+
+	return &botDetection, err
+}
+
+// UpdateBotDetection updates the bot detection configuration.
+//
+// Required scope: `update:attack_protection`
+//
+// See: https://auth0.com/docs/api/management/v2/attack-protection/patch-bot-detection
+func (m *AttackProtectionManager) UpdateBotDetection(
+	botDetection *BotDetection,
+	opts ...RequestOption,
+) error {
+	return m.Request(
+		http.MethodPatch,
+		m.URI("attack-protection", "bot-detection"),
+		botDetection,
+		opts...,
+	)
+}
+
+// The CAPTCHA providers challenging users.
+const (
+	// CaptchaProviderAuthChallenge is the CAPTCHA provided by Auth0.
+	CaptchaProviderAuthChallenge = "auth_challenge"
+	// CaptchaProviderSimpleCaptcha is the simple CAPTCHA provided by Auth0.
+	CaptchaProviderSimpleCaptcha = "simple_captcha"
+	// CaptchaProviderRecaptchaV2 is Google reCAPTCHA v2.
+	CaptchaProviderRecaptchaV2 = "recaptcha_v2"
+	// CaptchaProviderRecaptchaEnterprise is Google reCAPTCHA Enterprise.
+	CaptchaProviderRecaptchaEnterprise = "recaptcha_enterprise"
+	// CaptchaProviderHCaptcha is hCaptcha.
+	CaptchaProviderHCaptcha = "hcaptcha"
+	// CaptchaProviderFriendlyCaptcha is Friendly Captcha.
+	CaptchaProviderFriendlyCaptcha = "friendly_captcha"
+	// CaptchaProviderArkose is Arkose Labs.
+	CaptchaProviderArkose = "arkose"
+)
+
+// Captcha selects and configures the CAPTCHA provider challenging users when
+// bot detection is triggered.
+//
+// Secrets are write-only, so they aren't returned when reading the
+// configuration.
+//
+// See: https://auth0.com/docs/secure/attack-protection/bot-detection/configure-recaptcha-enterprise
+type Captcha struct {
+	// The id of the active CAPTCHA provider, such as "recaptcha_v2" or
+	// "hcaptcha".
+	ActiveProviderID *string `json:"active_provider_id,omitempty"`
+
+	// The configuration of the CAPTCHA provided by Auth0.
+	AuthChallenge *CaptchaAuthChallenge `json:"auth_challenge,omitempty"`
+
+	// The configuration of Google reCAPTCHA v2.
+	RecaptchaV2 *CaptchaProvider `json:"recaptcha_v2,omitempty"`
+
+	// The configuration of Google reCAPTCHA Enterprise.
+	RecaptchaEnterprise *CaptchaRecaptchaEnterprise `json:"recaptcha_enterprise,omitempty"`
+
+	// The configuration of hCaptcha.
+	HCaptcha *CaptchaProvider `json:"hcaptcha,omitempty"`
+
+	// The configuration of Friendly Captcha.
+	FriendlyCaptcha *CaptchaProvider `json:"friendly_captcha,omitempty"`
+
+	// The configuration of Arkose Labs.
+	Arkose *CaptchaArkose `json:"arkose,omitempty"`
+}
+
+// CaptchaAuthChallenge configures the CAPTCHA provided by Auth0.
+type CaptchaAuthChallenge struct {
+	// Whether users are let through when the challenge can't be loaded.
+	FailOpen *bool `json:"fail_open,omitempty"`
+}
+
+// CaptchaProvider holds the keys of a third-party CAPTCHA provider, such as
+// reCAPTCHA v2, hCaptcha or Friendly Captcha.
+type CaptchaProvider struct {
+	// The site key of the provider.
+	SiteKey *string `json:"site_key,omitempty"`
+
+	// The secret of the provider. Write-only.
+	Secret *string `json:"secret,omitempty"`
+}
+
+// CaptchaRecaptchaEnterprise holds the keys of Google reCAPTCHA Enterprise.
+type CaptchaRecaptchaEnterprise struct {
+	// The site key of reCAPTCHA Enterprise.
+	SiteKey *string `json:"site_key,omitempty"`
+
+	// The API key of the Google Cloud project. Write-only.
+	APIKey *string `json:"api_key,omitempty"`
+
+	// The id of the Google Cloud project.
+	ProjectID *string `json:"project_id,omitempty"`
+}
+
+// CaptchaArkose holds the keys of Arkose Labs.
+type CaptchaArkose struct {
+	// The site key of Arkose Labs.
+	SiteKey *string `json:"site_key,omitempty"`
+
+	// The secret of Arkose Labs. Write-only.
+	Secret *string `json:"secret,omitempty"`
+
+	// The subdomain loading the Arkose Labs client.
+	ClientSubdomain *string `json:"client_subdomain,omitempty"`
+
+	// The subdomain verifying the Arkose Labs challenges.
+	VerifySubdomain *string `json:"verify_subdomain,omitempty"`
+
+	// Whether users are let through when Arkose Labs is unavailable.
+	FailOpen *bool `json:"fail_open,omitempty"`
+}
+
+// GetCaptcha retrieves the CAPTCHA configuration.
+//
+// Required scope: `read:attack_protection`
+//
+// See: https://auth0.com/docs/api/management/v2/attack-protection/get-captcha
+func (m *AttackProtectionManager) GetCaptcha(
+	opts ...RequestOption,
+) (*Captcha, error) {
+	var captcha Captcha
+	err := m.Request(
+		http.MethodGet,
+		m.URI("attack-protection", "captcha"),
+		&captcha,
+		opts...,
+	)
+
+	return &captcha, err
+}
+
+// UpdateCaptcha updates the CAPTCHA configuration.
+//
+// Required scope: `update:attack_protection`
+//
+// See: https://auth0.com/docs/api/management/v2/attack-protection/patch-captcha
+func (m *AttackProtectionManager) UpdateCaptcha(
+	captcha *Captcha,
+	opts ...RequestOption,
+) error {
+	return m.Request(
+		http.MethodPatch,
+		m.URI("attack-protection", "captcha"),
+		captcha,
+		opts...,
+	)
+}
@@ -1,9 +1,12 @@
 package management
 
 import (
+	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/auth0/go-auth0"
 )
@@ -126,3 +129,54 @@ func TestAttackProtection(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestAttackProtection_BotDetectionAndCaptcha(t *testing.T) {
+	var patched map[string]interface{}
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/attack-protection/bot-detection":
+			w.Write([]byte(`{"bot_detection_level":"medium","challenge_password_policy":"when_risky","allowlist":["10.0.0.0/8"],"monitoring_mode_enabled":false}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/attack-protection/captcha":
+			w.Write([]byte(`{"active_provider_id":"hcaptcha","hcaptcha":{"site_key":"site-key"}}`))
+		case r.Method == http.MethodPatch:
+			patched = nil
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&patched))
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	botDetection, err := m.AttackProtection.GetBotDetection()
+	require.NoError(t, err)
+	assert.Equal(t, BotDetectionLevelMedium, botDetection.GetBotDetectionLevel())
+	assert.Equal(t, BotDetectionChallengeWhenRisky, botDetection.GetChallengePasswordPolicy())
+	assert.Equal(t, []string{"10.0.0.0/8"}, botDetection.GetAllowList())
+
+	err = m.AttackProtection.UpdateBotDetection(&BotDetection{
+		ChallengePasswordlessPolicy: auth0.String(BotDetectionChallengeAlways),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"challenge_passwordless_policy": "always"}, patched)
+
+	captcha, err := m.AttackProtection.GetCaptcha()
+	require.NoError(t, err)
+	assert.Equal(t, CaptchaProviderHCaptcha, captcha.GetActiveProviderID())
+	assert.Equal(t, "site-key", captcha.GetHCaptcha().GetSiteKey())
+
+	update := &Captcha{
+		ActiveProviderID: auth0.String(CaptchaProviderRecaptchaV2),
+		RecaptchaV2: &CaptchaProvider{
+			SiteKey: auth0.String("site-key"),
+			Secret:  auth0.String("secret"),
+		},
+	}
+	err = m.AttackProtection.UpdateCaptcha(update)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"active_provider_id": "recaptcha_v2",
+		"recaptcha_v2":       map[string]interface{}{"site_key": "site-key", "secret": "secret"},
+	}, patched)
+	assert.Contains(t, update.String(), `"secret": "[REDACTED]"`)
+}
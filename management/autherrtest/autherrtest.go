@@ -0,0 +1,135 @@
+// Package autherrtest provides helpers to check the errors returned by the
+// Management API in tests.
+//
+//	_, err := m.User.Read("auth0|123")
+//	autherrtest.AssertStatus(t, err, http.StatusNotFound)
+//	autherrtest.AssertErrorCode(t, err, "inexistent_user")
+package autherrtest
+
+import (
+	"errors"
+
+	"github.com/auth0/go-auth0/management"
+)
+
+// TestingT is the subset of testing.TB used by the assertions, which is also
+// implemented by the test types of other testing libraries.
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+type tHelper interface {
+	Helper()
+}
+
+type failNower interface {
+	FailNow()
+}
+
+type errorCoder interface {
+	ErrorCode() string
+}
+
+// Status returns the status code of the Management API error wrapped by err,
+// or 0 if err doesn't wrap a management.Error.
+func Status(err error) int {
+	var managementErr management.Error
+	if !errors.As(err, &managementErr) {
+		return 0
+	}
+	return managementErr.Status()
+}
+
+// ErrorCode returns the error code of the Management API error wrapped by
+// err, such as "inexistent_user", or an empty string if err doesn't wrap one.
+func ErrorCode(err error) string {
+	var coder errorCoder
+	if !errors.As(err, &coder) {
+		return ""
+	}
+	return coder.ErrorCode()
+}
+
+// IsStatus returns a matcher reporting whether an error wraps a Management
+// API error with the given status code.
+func IsStatus(status int) func(err error) bool {
+	return func(err error) bool {
+		return Status(err) == status
+	}
+}
+
+// HasErrorCode returns a matcher reporting whether an error wraps a
+// Management API error with the given error code.
+func HasErrorCode(code string) func(err error) bool {
+	return func(err error) bool {
+		return ErrorCode(err) == code
+	}
+}
+
+// AssertStatus checks that err wraps a Management API error with the given
+// status code, and marks the test as failed otherwise. It returns whether the
+// assertion succeeded.
+func AssertStatus(t TestingT, err error, status int) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if err == nil {
+		t.Errorf("expected a Management API error with status %d, got no error", status)
+		return false
+	}
+	if actual := Status(err); actual != status {
+		t.Errorf("expected a Management API error with status %d, got status %d: %v", status, actual, err)
+		return false
+	}
+	return true
+}
+
+// AssertErrorCode checks that err wraps a Management API error with the given
+// error code, and marks the test as failed otherwise. It returns whether the
+// assertion succeeded.
+func AssertErrorCode(t TestingT, err error, code string) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if err == nil {
+		t.Errorf("expected a Management API error with error code %q, got no error", code)
+		return false
+	}
+	if actual := ErrorCode(err); actual != code {
+		t.Errorf("expected a Management API error with error code %q, got error code %q: %v", code, actual, err)
+		return false
+	}
+	return true
+}
+
+// RequireStatus is like AssertStatus, but stops the test when the assertion
+// fails.
+func RequireStatus(t TestingT, err error, status int) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !AssertStatus(t, err, status) {
+		failNow(t)
+	}
+}
+
+// RequireErrorCode is like AssertErrorCode, but stops the test when the
+// assertion fails.
+func RequireErrorCode(t TestingT, err error, code string) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !AssertErrorCode(t, err, code) {
+		failNow(t)
+	}
+}
+
+func failNow(t TestingT) {
+	if f, ok := t.(failNower); ok {
+		f.FailNow()
+	}
+}
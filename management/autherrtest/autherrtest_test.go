@@ -0,0 +1,64 @@
+package autherrtest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/go-auth0/management"
+)
+
+type recordingT struct {
+	errors []string
+	failed bool
+}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *recordingT) FailNow() {
+	t.failed = true
+}
+
+func TestAssertions(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"statusCode":404,"error":"Not Found","message":"The user does not exist.","errorCode":"inexistent_user"}`))
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	m, err := management.New(s.URL, management.WithInsecure())
+	require.NoError(t, err)
+
+	_, err = m.User.Read("auth0|123")
+	wrapped := fmt.Errorf("reading user: %w", err)
+
+	assert.Equal(t, http.StatusNotFound, Status(wrapped))
+	assert.Equal(t, "inexistent_user", ErrorCode(wrapped))
+	assert.True(t, IsStatus(http.StatusNotFound)(wrapped))
+	assert.True(t, HasErrorCode("inexistent_user")(wrapped))
+	assert.False(t, IsStatus(http.StatusNotFound)(errors.New("other")))
+
+	AssertStatus(t, wrapped, http.StatusNotFound)
+	AssertErrorCode(t, wrapped, "inexistent_user")
+	RequireStatus(t, wrapped, http.StatusNotFound)
+	RequireErrorCode(t, wrapped, "inexistent_user")
+
+	rt := &recordingT{}
+	assert.False(t, AssertStatus(rt, wrapped, http.StatusConflict))
+	assert.False(t, AssertErrorCode(rt, nil, "inexistent_user"))
+	RequireStatus(rt, errors.New("other"), http.StatusNotFound)
+	assert.True(t, rt.failed)
+	assert.Equal(t, []string{
+		"expected a Management API error with status 409, got status 404: reading user: 404 Not Found: The user does not exist.",
+		`expected a Management API error with error code "inexistent_user", got no error`,
+		"expected a Management API error with status 404, got status 0: other",
+	}, rt.errors)
+}
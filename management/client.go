@@ -1,12 +1,145 @@
 package management
 
 import (
+	"context"
+	"crypto"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
 
+	"golang.org/x/oauth2"
+
 	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/internal/client"
+)
+
+const (
+	// ClientAppTypeNative constant.
+	ClientAppTypeNative = "native"
+	// ClientAppTypeSPA constant.
+	ClientAppTypeSPA = "spa"
+	// ClientAppTypeRegularWeb constant.
+	ClientAppTypeRegularWeb = "regular_web"
+	// ClientAppTypeNonInteractive constant.
+	ClientAppTypeNonInteractive = "non_interactive"
+	// ClientAppTypeSSOIntegration constant.
+	ClientAppTypeSSOIntegration = "sso_integration"
+)
+
+const (
+	// ClientGrantTypeAuthorizationCode constant.
+	ClientGrantTypeAuthorizationCode = "authorization_code"
+	// ClientGrantTypeImplicit constant.
+	ClientGrantTypeImplicit = "implicit"
+	// ClientGrantTypeRefreshToken constant.
+	ClientGrantTypeRefreshToken = "refresh_token"
+	// ClientGrantTypeClientCredentials constant.
+	ClientGrantTypeClientCredentials = "client_credentials"
+	// ClientGrantTypePassword constant.
+	ClientGrantTypePassword = "password"
+	// ClientGrantTypePasswordRealm constant.
+	ClientGrantTypePasswordRealm = "http://auth0.com/oauth/grant-type/password-realm"
+	// ClientGrantTypeMFAOOB constant.
+	ClientGrantTypeMFAOOB = "http://auth0.com/oauth/grant-type/mfa-oob"
+	// ClientGrantTypeMFAOTP constant.
+	ClientGrantTypeMFAOTP = "http://auth0.com/oauth/grant-type/mfa-otp"
+	// ClientGrantTypeMFARecoveryCode constant.
+	ClientGrantTypeMFARecoveryCode = "http://auth0.com/oauth/grant-type/mfa-recovery-code"
+	// ClientGrantTypePasswordlessOTP constant.
+	ClientGrantTypePasswordlessOTP = "http://auth0.com/oauth/grant-type/passwordless/otp"
+	// ClientGrantTypeDeviceCode constant.
+	ClientGrantTypeDeviceCode = "urn:ietf:params:oauth:grant-type:device_code"
+)
+
+const (
+	// ClientTokenEndpointAuthMethodNone constant.
+	ClientTokenEndpointAuthMethodNone = "none"
+	// ClientTokenEndpointAuthMethodClientSecretPost constant.
+	ClientTokenEndpointAuthMethodClientSecretPost = "client_secret_post"
+	// ClientTokenEndpointAuthMethodClientSecretBasic constant.
+	ClientTokenEndpointAuthMethodClientSecretBasic = "client_secret_basic"
+)
+
+const (
+	// ClientJWTAlgorithmHS256 constant.
+	ClientJWTAlgorithmHS256 = "HS256"
+	// ClientJWTAlgorithmRS256 constant.
+	ClientJWTAlgorithmRS256 = "RS256"
+	// ClientJWTAlgorithmPS256 constant.
+	ClientJWTAlgorithmPS256 = "PS256"
+)
+
+const (
+	// ClientRefreshTokenRotationTypeRotating constant.
+	ClientRefreshTokenRotationTypeRotating = "rotating"
+	// ClientRefreshTokenRotationTypeNonRotating constant.
+	ClientRefreshTokenRotationTypeNonRotating = "non-rotating"
+	// ClientRefreshTokenExpirationTypeExpiring constant.
+	ClientRefreshTokenExpirationTypeExpiring = "expiring"
+	// ClientRefreshTokenExpirationTypeNonExpiring constant.
+	ClientRefreshTokenExpirationTypeNonExpiring = "non-expiring"
+)
+
+// clientReadOnlyExtras lists the read-only client fields unsupported by this SDK
+// that the API rejects when sent back.
+var clientReadOnlyExtras = []string{
+	"callback_url_template",
+	"config_route",
+	"cross_origin_auth",
+	"global",
+	"is_heroku_app",
+	"owners",
+	"tenant",
+}
+
+const (
+	clientDescriptionMaxLength = 140
+	clientMetadataMaxKeys      = 10
+)
+
+var (
+	validClientAppTypes = []string{
+		ClientAppTypeNative,
+		ClientAppTypeSPA,
+		ClientAppTypeRegularWeb,
+		ClientAppTypeNonInteractive,
+		ClientAppTypeSSOIntegration,
+		"rms", "box", "cloudbees", "concur", "dropbox", "mscrm", "echosign",
+		"egnyte", "newrelic", "office365", "salesforce", "sentry", "sharepoint",
+		"slack", "springcm", "zendesk", "zoom",
+	}
+	validClientGrantTypes = []string{
+		ClientGrantTypeAuthorizationCode,
+		ClientGrantTypeImplicit,
+		ClientGrantTypeRefreshToken,
+		ClientGrantTypeClientCredentials,
+		ClientGrantTypePassword,
+		ClientGrantTypePasswordRealm,
+		ClientGrantTypeMFAOOB,
+		ClientGrantTypeMFAOTP,
+		ClientGrantTypeMFARecoveryCode,
+		ClientGrantTypePasswordlessOTP,
+		ClientGrantTypeDeviceCode,
+	}
+	validClientTokenEndpointAuthMethods = []string{
+		ClientTokenEndpointAuthMethodNone,
+		ClientTokenEndpointAuthMethodClientSecretPost,
+		ClientTokenEndpointAuthMethodClientSecretBasic,
+	}
+	validClientJWTAlgorithms = []string{
+		ClientJWTAlgorithmHS256,
+		ClientJWTAlgorithmRS256,
+		ClientJWTAlgorithmPS256,
+	}
+	validClientRefreshTokenRotationTypes = []string{
+		ClientRefreshTokenRotationTypeRotating,
+		ClientRefreshTokenRotationTypeNonRotating,
+	}
+	validClientRefreshTokenExpirationTypes = []string{
+		ClientRefreshTokenExpirationTypeExpiring,
+		ClientRefreshTokenExpirationTypeNonExpiring,
+	}
 )
 
 // Client is an application or a sso integration.
@@ -115,6 +248,66 @@ type Client struct {
 	// URLs that are valid to call back from Auth0 for OIDC backchannel logout.
 	// This feature currently must be enabled for your tenant.
 	OIDCBackchannelLogout *OIDCBackchannelLogout `json:"oidc_backchannel_logout,omitempty"`
+
+	// Extras holds the fields returned by the Management API that this SDK
+	// doesn't support yet, so that they are preserved when the client is sent
+	// back to Auth0. Fields unknown to the API should not be added here.
+	Extras map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c *Client) MarshalJSON() ([]byte, error) {
+	type client Client
+	return marshalWithExtras((*client)(c), c.Extras)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *Client) UnmarshalJSON(b []byte) error {
+	type client Client
+	if err := json.Unmarshal(b, (*client)(c)); err != nil {
+		return err
+	}
+
+	extras, err := unmarshalExtras(b, (*client)(c), clientReadOnlyExtras...)
+	if err != nil {
+		return err
+	}
+	c.Extras = extras
+
+	return nil
+}
+
+// Validate checks the client against the constraints documented by the
+// Management API, such as the values accepted by well-known fields, the length
+// of the description, the amount of metadata keys and the syntax of URLs, so
+// that mistakes are caught before the client is sent to Auth0. A nil error is
+// returned if the client is valid, otherwise the returned error is of type
+// ValidationErrors.
+func (c *Client) Validate() error {
+	v := &validator{}
+
+	v.oneOf("app_type", c.AppType, validClientAppTypes)
+	v.eachOneOf("grant_types", c.GrantTypes, validClientGrantTypes)
+	v.oneOf("token_endpoint_auth_method", c.TokenEndpointAuthMethod, validClientTokenEndpointAuthMethods)
+
+	if c.JWTConfiguration != nil {
+		v.oneOf("jwt_configuration.alg", c.JWTConfiguration.Algorithm, validClientJWTAlgorithms)
+	}
+
+	if c.RefreshToken != nil {
+		v.oneOf("refresh_token.rotation_type", c.RefreshToken.RotationType, validClientRefreshTokenRotationTypes)
+		v.oneOf("refresh_token.expiration_type", c.RefreshToken.ExpirationType, validClientRefreshTokenExpirationTypes)
+	}
+
+	v.maxLength("description", c.Description, clientDescriptionMaxLength)
+	if c.ClientMetadata != nil && len(*c.ClientMetadata) > clientMetadataMaxKeys {
+		v.addError("client_metadata", "has %d keys, at most %d are allowed", len(*c.ClientMetadata), clientMetadataMaxKeys)
+	}
+	v.httpsURL("initiate_login_uri", c.InitiateLoginURI)
+	v.eachURL("callbacks", c.Callbacks)
+	v.eachURL("allowed_logout_urls", c.AllowedLogoutURLs)
+
+	return v.err()
 }
 
 // ClientJWTConfiguration is used to configure JWT settings for our Client.
@@ -207,11 +400,11 @@ type Credential struct {
 	// Parse expiry from x509 certificate. If `true`, attempts to parse the expiry date from the provided PEM.
 	ParseExpiryFromCert *bool `json:"parse_expiry_from_cert,omitempty"`
 	// The time that this credential was created.
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
 	// The time that this credential was last updated.
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
 	// The time that this credential will expire.
-	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	ExpiresAt *Timestamp `json:"expires_at,omitempty"`
 }
 
 // ClientAuthenticationMethods defines client authentication method settings for the client.
@@ -235,7 +428,71 @@ type ClientList struct {
 	Clients []*Client `json:"clients"`
 }
 
+// Steps of a graceful credential rotation, reported by
+// ClientManager.RotateSecretGracefully.
+const (
+	// CredentialRotationCreated is reported once the new credential is created.
+	CredentialRotationCreated = "created"
+	// CredentialRotationEnabled is reported once the new credential is
+	// enabled alongside the previous ones.
+	CredentialRotationEnabled = "enabled"
+	// CredentialRotationVerified is reported once a token is obtained with
+	// the new credential.
+	CredentialRotationVerified = "verified"
+	// CredentialRotationOverlap is reported before waiting for the overlap
+	// window, for each of the previous credentials.
+	CredentialRotationOverlap = "overlap"
+	// CredentialRotationDisabled is reported once a previous credential is
+	// disabled.
+	CredentialRotationDisabled = "disabled"
+	// CredentialRotationDeleted is reported once a previous credential is
+	// deleted.
+	CredentialRotationDeleted = "deleted"
+)
+
+// CredentialRotation configures ClientManager.RotateSecretGracefully.
+type CredentialRotation struct {
+	// The new credential, such as a public key, which is created when rotating.
+	Credential *Credential
+
+	// Overlap is how long the previous credentials remain enabled alongside
+	// the new one, so that every instance of the application can switch to
+	// the new credential before the previous ones are removed.
+	Overlap time.Duration
+
+	// Signer, if not nil, signs with the private key of the new credential.
+	// Once the new credential is enabled, a token is requested with a client
+	// assertion signed by Signer, and the rotation is rolled back if the
+	// request fails, so that the previous credentials are only removed once
+	// the new one is known to work.
+	Signer crypto.Signer
+
+	// Algorithm with which Signer signs the client assertion, such as RS256.
+	// Defaults to the algorithm of the new credential, or RS256.
+	Algorithm string
+
+	// Audience of the token requested to verify the new credential. Defaults
+	// to the Management API of the tenant.
+	Audience string
+
+	// OnProgress, if not nil, is called after each step of the rotation.
+	OnProgress func(CredentialRotationEvent)
+}
+
+// CredentialRotationEvent reports a step of a graceful credential rotation.
+type CredentialRotationEvent struct {
+	// Step of the rotation, such as CredentialRotationCreated.
+	Step string
+
+	// ID of the credential the step applies to.
+	CredentialID string
+}
+
 // ClientManager manages Auth0 Client resources.
+//
+// Its methods take the context of the requests they make as first argument,
+// so that they can be cancelled and bounded by a deadline. A Context option
+// passed to a method takes precedence over the context argument.
 type ClientManager struct {
 	*Management
 }
@@ -247,59 +504,269 @@ func newClientManager(m *Management) *ClientManager {
 // Create a new client application.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Clients/post_clients
-func (m *ClientManager) Create(c *Client, opts ...RequestOption) (err error) {
-	return m.Request("POST", m.URI("clients"), c, opts...)
+func (m *ClientManager) Create(ctx context.Context, c *Client, opts ...RequestOption) (err error) {
+	return m.Request("POST", m.URI("clients"), c, withContext(ctx, opts)...)
 }
 
 // Read a client by its ID.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Clients/get_clients_by_id
-func (m *ClientManager) Read(id string, opts ...RequestOption) (c *Client, err error) {
-	err = m.Request("GET", m.URI("clients", id), &c, opts...)
+func (m *ClientManager) Read(ctx context.Context, id string, opts ...RequestOption) (c *Client, err error) {
+	err = m.Request("GET", m.URI("clients", id), &c, withContext(ctx, opts)...)
 	return
 }
 
 // List all client applications.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Clients/get_clients
-func (m *ClientManager) List(opts ...RequestOption) (c *ClientList, err error) {
-	err = m.Request("GET", m.URI("clients"), &c, applyListDefaults(opts))
+func (m *ClientManager) List(ctx context.Context, opts ...RequestOption) (c *ClientList, err error) {
+	c, err = listPage[*ClientList](m.Management, m.URI("clients"), applyListDefaults(withContext(ctx, opts)))
 	return
 }
 
 // Update a client.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Clients/patch_clients_by_id
-func (m *ClientManager) Update(id string, c *Client, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("clients", id), c, opts...)
+func (m *ClientManager) Update(ctx context.Context, id string, c *Client, opts ...RequestOption) (err error) {
+	return m.Request("PATCH", m.URI("clients", id), c, withContext(ctx, opts)...)
+}
+
+// Patch updates the fields of the client set on the patch, which unlike Update
+// can set fields to their zero value or clear them with null, and returns the
+// updated client.
+func (m *ClientManager) Patch(ctx context.Context, id string, p *ClientPatch, opts ...RequestOption) (*Client, error) {
+	return patchResource[Client](m.Management, m.URI("clients", id), p, withContext(ctx, opts)...)
+}
+
+// UpdateWithRetry reads the client, applies mutate to it and updates the fields
+// mutate changed. When the update conflicts with a concurrent change, with a
+// 409 or 412 error, the client is read again and mutate applied again, up to 5
+// times, so that concurrent changes aren't overwritten. It returns the updated
+// client.
+//
+// Fields cleared by mutate are left out of the update.
+//
+// The options are applied to every request made.
+func (m *ClientManager) UpdateWithRetry(ctx context.Context, id string, mutate func(c *Client) error, opts ...RequestOption) (*Client, error) {
+	opts = withContext(ctx, opts)
+	return updateWithRetry(m.Management, m.URI("clients", id),
+		func() (*Client, error) { return m.Read(ctx, id, opts...) },
+		mutate,
+		opts...,
+	)
+}
+
+// Upsert creates the client application if none exists with the same name, or
+// updates the existing one otherwise. The client is looked up by its name,
+// which is why it must be set.
+//
+// The options are applied to every request made.
+func (m *ClientManager) Upsert(ctx context.Context, c *Client, opts ...RequestOption) error {
+	if c.GetName() == "" {
+		return &APIError{StatusCode: 400, Err: "Bad Request", Message: "Name cannot be empty"}
+	}
+
+	id, err := m.idByName(ctx, c.GetName(), opts...)
+	if IsStatusNotFound(err) {
+		return m.Create(ctx, c, opts...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return m.Update(ctx, id, c, opts...)
+}
+
+// Ensure converges the client application to the desired state. The client is
+// created if none exists with the same name, or patched if it has drifted.
+//
+// The options are applied to every request made.
+func (m *ClientManager) Ensure(ctx context.Context, c *Client, opts ...RequestOption) (*EnsureResult, error) {
+	if c.GetName() == "" {
+		return nil, &APIError{StatusCode: 400, Err: "Bad Request", Message: "Name cannot be empty"}
+	}
+
+	existing, result, err := ensure(c, c,
+		func() (*Client, error) {
+			id, err := m.idByName(ctx, c.GetName(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return m.Read(ctx, id, opts...)
+		},
+		func() error { return m.Create(ctx, c, opts...) },
+		func(existing *Client) error { return m.Update(ctx, existing.GetClientID(), c, opts...) },
+	)
+	if err != nil {
+		return nil, err
+	}
+	result.ID = existing.GetClientID()
+
+	return result, nil
+}
+
+// idByName retrieves the ID of the client application with the given name.
+func (m *ClientManager) idByName(ctx context.Context, name string, opts ...RequestOption) (string, error) {
+	clients, err := m.ListAll(ctx, withOptions(opts, IncludeFields("client_id", "name"))...)
+	if err != nil {
+		return "", err
+	}
+
+	for _, c := range clients {
+		if c.GetName() == name {
+			return c.GetClientID(), nil
+		}
+	}
+
+	return "", &APIError{StatusCode: 404, Err: "Not Found", Message: "Client not found"}
 }
 
 // RotateSecret rotates a client secret.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Clients/post_rotate_secret
-func (m *ClientManager) RotateSecret(id string, opts ...RequestOption) (c *Client, err error) {
-	err = m.Request("POST", m.URI("clients", id, "rotate-secret"), &c, opts...)
+func (m *ClientManager) RotateSecret(ctx context.Context, id string, opts ...RequestOption) (c *Client, err error) {
+	err = m.Request("POST", m.URI("clients", id, "rotate-secret"), &c, withContext(ctx, opts)...)
 	return
 }
 
+// RotateSecretGracefully rotates the credentials of a client application using
+// the private_key_jwt authentication method without downtime. The new
+// credential is created and enabled alongside the previous ones, which are
+// then disabled and deleted once the overlap window has passed.
+//
+// Client secrets can't be rotated gracefully, as RotateSecret revokes the
+// previous secret immediately, so an error is returned for client
+// applications that don't use private_key_jwt.
+//
+// If a Signer is set, the new credential is verified by requesting a token
+// with it before waiting for the overlap window. If the verification fails,
+// the new credential is disabled and deleted and the previous ones are left
+// untouched.
+//
+// If the context is done during the overlap window, both the new and the
+// previous credentials are left enabled.
+//
+// The options are applied to every request made.
+func (m *ClientManager) RotateSecretGracefully(ctx context.Context, id string, r *CredentialRotation, opts ...RequestOption) error {
+	progress := func(step, credentialID string) {
+		if r.OnProgress != nil {
+			r.OnProgress(CredentialRotationEvent{Step: step, CredentialID: credentialID})
+		}
+	}
+
+	c, err := m.Read(ctx, id, withOptions(opts, IncludeFields("client_id", "client_authentication_methods"))...)
+	if err != nil {
+		return err
+	}
+	if c.GetClientAuthenticationMethods().GetPrivateKeyJWT() == nil {
+		return fmt.Errorf("client %q doesn't use private_key_jwt, and its secret can't be rotated without revoking the previous one", id)
+	}
+	previous := c.GetClientAuthenticationMethods().GetPrivateKeyJWT().GetCredentials()
+
+	if err := m.CreateCredential(ctx, id, r.Credential, opts...); err != nil {
+		return err
+	}
+	progress(CredentialRotationCreated, r.Credential.GetID())
+
+	enabled := append([]Credential{{ID: r.Credential.ID}}, previous...)
+	if err := m.setPrivateKeyJWTCredentials(ctx, id, enabled, opts...); err != nil {
+		// Leave no trace of the rotation, as the new credential isn't used.
+		_ = m.DeleteCredential(ctx, id, r.Credential.GetID(), opts...)
+		return err
+	}
+	progress(CredentialRotationEnabled, r.Credential.GetID())
+
+	if r.Signer != nil {
+		if err := m.verifyCredential(ctx, id, r); err != nil {
+			// Roll back, as the previous credentials are still needed.
+			if rollbackErr := m.setPrivateKeyJWTCredentials(ctx, id, previous, opts...); rollbackErr == nil {
+				_ = m.DeleteCredential(ctx, id, r.Credential.GetID(), opts...)
+			}
+			return fmt.Errorf("failed to verify the new credential %q: %w", r.Credential.GetID(), err)
+		}
+		progress(CredentialRotationVerified, r.Credential.GetID())
+	}
+
+	for _, p := range previous {
+		progress(CredentialRotationOverlap, p.GetID())
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-m.clock.After(r.Overlap):
+	}
+
+	if err := m.setPrivateKeyJWTCredentials(ctx, id, enabled[:1], opts...); err != nil {
+		return err
+	}
+	for _, p := range previous {
+		progress(CredentialRotationDisabled, p.GetID())
+	}
+
+	for _, p := range previous {
+		if err := m.DeleteCredential(ctx, id, p.GetID(), opts...); err != nil {
+			return err
+		}
+		progress(CredentialRotationDeleted, p.GetID())
+	}
+
+	return nil
+}
+
+// verifyCredential requests a token for the client with a client assertion
+// signed by the signer of the rotation.
+func (m *ClientManager) verifyCredential(ctx context.Context, id string, r *CredentialRotation) error {
+	algorithm := r.Algorithm
+	if algorithm == "" {
+		algorithm = r.Credential.GetAlgorithm()
+	}
+	if algorithm == "" {
+		algorithm = "RS256"
+	}
+
+	uri := m.url.String()
+	audience := r.Audience
+	if audience == "" {
+		audience = uri + "/api/v2/"
+	}
+
+	tokenContext := context.WithValue(ctx, oauth2.HTTPClient, m.tokenContext().Value(oauth2.HTTPClient))
+	source := client.OAuth2ClientCredentialsPrivateKeyJWT(tokenContext, uri, id, r.Signer, algorithm, audience, m.clock, nil)
+	_, err := source.Token()
+	return err
+}
+
+func (m *ClientManager) setPrivateKeyJWTCredentials(ctx context.Context, id string, credentials []Credential, opts ...RequestOption) error {
+	refs := make([]Credential, len(credentials))
+	for i, c := range credentials {
+		refs[i] = Credential{ID: c.ID}
+	}
+
+	return m.Update(ctx, id, &Client{
+		ClientAuthenticationMethods: &ClientAuthenticationMethods{
+			PrivateKeyJWT: &PrivateKeyJWT{Credentials: &refs},
+		},
+	}, opts...)
+}
+
 // Delete a client and all its related assets (like rules, connections, etc)
 // given its ID.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Clients/delete_clients_by_id
-func (m *ClientManager) Delete(id string, opts ...RequestOption) error {
-	return m.Request("DELETE", m.URI("clients", id), nil, opts...)
+func (m *ClientManager) Delete(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Request("DELETE", m.URI("clients", id), nil, withContext(ctx, opts)...)
 }
 
 // CreateCredential creates a client application's client credential.
-func (m *ClientManager) CreateCredential(clientID string, credential *Credential, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("clients", clientID, "credentials"), credential, opts...)
+func (m *ClientManager) CreateCredential(ctx context.Context, clientID string, credential *Credential, opts ...RequestOption) error {
+	return m.Request("POST", m.URI("clients", clientID, "credentials"), credential, withContext(ctx, opts)...)
 }
 
 // UpdateCredential updates a client application's client credential expiry.
-func (m *ClientManager) UpdateCredential(clientID, credentialID string, credential *Credential, opts ...RequestOption) error {
+func (m *ClientManager) UpdateCredential(ctx context.Context, clientID, credentialID string, credential *Credential, opts ...RequestOption) error {
 	credentialClone := &Credential{ExpiresAt: credential.ExpiresAt} // The API only accepts the expires_at property.
 
-	err := m.Request("PATCH", m.URI("clients", clientID, "credentials", credentialID), credentialClone, opts...)
+	err := m.Request("PATCH", m.URI("clients", clientID, "credentials", credentialID), credentialClone, withContext(ctx, opts)...)
 	if err != nil {
 		return err
 	}
@@ -318,20 +785,20 @@ func (m *ClientManager) UpdateCredential(clientID, credentialID string, credenti
 }
 
 // ListCredentials lists all client credentials associated with the client application.
-func (m *ClientManager) ListCredentials(clientID string, opts ...RequestOption) (c []*Credential, err error) {
-	err = m.Request("GET", m.URI("clients", clientID, "credentials"), &c, applyListDefaults(opts))
+func (m *ClientManager) ListCredentials(ctx context.Context, clientID string, opts ...RequestOption) (c []*Credential, err error) {
+	err = m.Request("GET", m.URI("clients", clientID, "credentials"), &c, applyListDefaults(withContext(ctx, opts)))
 	return
 }
 
 // GetCredential gets a client credentials object.
-func (m *ClientManager) GetCredential(clientID string, credentialID string, opts ...RequestOption) (c *Credential, err error) {
-	err = m.Request("GET", m.URI("clients", clientID, "credentials", credentialID), &c, opts...)
+func (m *ClientManager) GetCredential(ctx context.Context, clientID string, credentialID string, opts ...RequestOption) (c *Credential, err error) {
+	err = m.Request("GET", m.URI("clients", clientID, "credentials", credentialID), &c, withContext(ctx, opts)...)
 	return
 }
 
 // DeleteCredential deletes a client credentials object.
-func (m *ClientManager) DeleteCredential(clientID string, credentialID string, opts ...RequestOption) error {
-	return m.Request("DELETE", m.URI("clients", clientID, "credentials", credentialID), nil, opts...)
+func (m *ClientManager) DeleteCredential(ctx context.Context, clientID string, credentialID string, opts ...RequestOption) error {
+	return m.Request("DELETE", m.URI("clients", clientID, "credentials", credentialID), nil, withContext(ctx, opts)...)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
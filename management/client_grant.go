@@ -1,5 +1,14 @@
 package management
 
+const (
+	// ClientGrantOrganizationUsageDeny constant.
+	ClientGrantOrganizationUsageDeny = "deny"
+	// ClientGrantOrganizationUsageAllow constant.
+	ClientGrantOrganizationUsageAllow = "allow"
+	// ClientGrantOrganizationUsageRequire constant.
+	ClientGrantOrganizationUsageRequire = "require"
+)
+
 // ClientGrant is a method through which applications can gain Access Tokens.
 //
 // See: https://auth0.com/docs/get-started/applications/application-grant-types
@@ -14,6 +23,14 @@ type ClientGrant struct {
 	Audience *string `json:"audience,omitempty"`
 
 	Scope []string `json:"scope"`
+
+	// Defines whether organizations can be used with client credentials
+	// exchanges for this grant ["deny", "allow" or "require"].
+	OrganizationUsage *string `json:"organization_usage,omitempty"`
+
+	// If enabled, any organization can be used with this grant. If disabled,
+	// the grant must be explicitly assigned to the desired organizations.
+	AllowAnyOrganization *bool `json:"allow_any_organization,omitempty"`
 }
 
 // ClientGrantList is a list of ClientGrants.
@@ -60,7 +77,7 @@ func (m *ClientGrantManager) Read(id string, opts ...RequestOption) (*ClientGran
 		}
 		page++
 	}
-	return nil, &managementError{
+	return nil, &APIError{
 		StatusCode: 404,
 		Err:        "Not Found",
 		Message:    "Client grant not found",
@@ -86,8 +103,13 @@ func (m *ClientGrantManager) Delete(id string, opts ...RequestOption) (err error
 // This method forces the `include_totals=true` and defaults to `per_page=50` if
 // not provided.
 //
+// Client grants can be filtered with Parameter, for example with
+// Parameter("allow_any_organization", "true"). Use
+// OrganizationManager.ClientGrants to list the client grants associated with
+// an organization.
+//
 // See: https://auth0.com/docs/api/management/v2#!/Client_Grants/get_client_grants
 func (m *ClientGrantManager) List(opts ...RequestOption) (gs *ClientGrantList, err error) {
-	err = m.Request("GET", m.URI("client-grants"), &gs, applyListDefaults(opts))
+	gs, err = listPage[*ClientGrantList](m.Management, m.URI("client-grants"), applyListDefaults(opts))
 	return
 }
@@ -1,7 +1,11 @@
 package management
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"encoding/json"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -20,7 +24,7 @@ func TestClient_Create(t *testing.T) {
 		Description: auth0.String("This is just a test client."),
 	}
 
-	err := api.Client.Create(expectedClient)
+	err := api.Client.Create(context.Background(), expectedClient)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, expectedClient.GetClientID())
 
@@ -34,7 +38,7 @@ func TestClient_Read(t *testing.T) {
 
 	expectedClient := givenAClient(t)
 
-	actualClient, err := api.Client.Read(expectedClient.GetClientID())
+	actualClient, err := api.Client.Read(context.Background(), expectedClient.GetClientID())
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedClient.GetName(), actualClient.GetName())
@@ -54,7 +58,7 @@ func TestClient_Update(t *testing.T) {
 	expectedClient.JWTConfiguration.SecretEncoded = nil // Read-Only: Additional properties not allowed.
 	expectedClient.ClientSecret = nil
 
-	err := api.Client.Update(clientID, expectedClient)
+	err := api.Client.Update(context.Background(), clientID, expectedClient)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedDescription, *expectedClient.Description)
@@ -65,10 +69,10 @@ func TestClient_Delete(t *testing.T) {
 
 	expectedClient := givenAClient(t)
 
-	err := api.Client.Delete(expectedClient.GetClientID())
+	err := api.Client.Delete(context.Background(), expectedClient.GetClientID())
 	assert.NoError(t, err)
 
-	actualClient, err := api.Client.Read(expectedClient.GetClientID())
+	actualClient, err := api.Client.Read(context.Background(), expectedClient.GetClientID())
 
 	assert.Empty(t, actualClient)
 	assert.Error(t, err)
@@ -81,7 +85,7 @@ func TestClient_List(t *testing.T) {
 
 	expectedClient := givenAClient(t)
 
-	clientList, err := api.Client.List(IncludeFields("client_id"))
+	clientList, err := api.Client.List(context.Background(), IncludeFields("client_id"))
 
 	assert.NoError(t, err)
 	assert.Contains(t, clientList.Clients, &Client{ClientID: expectedClient.ClientID})
@@ -93,7 +97,7 @@ func TestClient_RotateSecret(t *testing.T) {
 	expectedClient := givenAClient(t)
 
 	oldSecret := expectedClient.GetClientSecret()
-	actualClient, err := api.Client.RotateSecret(expectedClient.GetClientID())
+	actualClient, err := api.Client.RotateSecret(context.Background(), expectedClient.GetClientID())
 
 	assert.NoError(t, err)
 	assert.NotEqual(t, oldSecret, actualClient.GetClientSecret())
@@ -150,7 +154,7 @@ XzvXjHGyxWVu0jdvS9hyhJzP4165k1cYDgx8mmg0VxR7j79LmCUDsFcvvSrAOf6y
 -----END PUBLIC KEY-----`),
 	}
 
-	err := api.Client.CreateCredential(expectedClient.GetClientID(), credential)
+	err := api.Client.CreateCredential(context.Background(), expectedClient.GetClientID(), credential)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, credential.GetID())
 
@@ -167,7 +171,7 @@ func TestClient_ListCredentials(t *testing.T) {
 	createdCredential := createdCredentials[0]
 	expectedCredential := givenACredential(t, expectedClient)
 
-	credentials, err := api.Client.ListCredentials(expectedClient.GetClientID())
+	credentials, err := api.Client.ListCredentials(context.Background(), expectedClient.GetClientID())
 
 	assert.NoError(t, err)
 	assert.Equal(t, createdCredential.GetID(), credentials[0].GetID())
@@ -180,7 +184,7 @@ func TestClient_GetCredentials(t *testing.T) {
 	expectedClient := givenAClient(t)
 	expectedCredential := givenACredential(t, expectedClient)
 
-	credential, err := api.Client.GetCredential(expectedClient.GetClientID(), expectedCredential.GetID())
+	credential, err := api.Client.GetCredential(context.Background(), expectedClient.GetClientID(), expectedCredential.GetID())
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedCredential.GetID(), credential.GetID())
@@ -192,14 +196,14 @@ func TestClient_UpdateCredential(t *testing.T) {
 	expectedClient := givenAClient(t)
 	expectedCredential := givenACredential(t, expectedClient)
 
-	expiresAt := time.Now().Add(time.Minute * 10)
+	expiresAt := Timestamp{time.Now().Add(time.Minute * 10)}
 	expectedCredential.ExpiresAt = &expiresAt
 
 	pem := expectedCredential.GetPEM()
 	credentialID := expectedCredential.GetID()
 	expectedCredential.ID = nil
 
-	err := api.Client.UpdateCredential(expectedClient.GetClientID(), credentialID, expectedCredential)
+	err := api.Client.UpdateCredential(context.Background(), expectedClient.GetClientID(), credentialID, expectedCredential)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedCredential.GetExpiresAt(), expiresAt)
@@ -213,10 +217,10 @@ func TestClient_DeleteCredential(t *testing.T) {
 	expectedClient := givenAClient(t)
 	expectedCredential := givenACredential(t, expectedClient)
 
-	err := api.Client.DeleteCredential(expectedClient.GetClientID(), expectedCredential.GetID())
+	err := api.Client.DeleteCredential(context.Background(), expectedClient.GetClientID(), expectedCredential.GetID())
 	assert.NoError(t, err)
 
-	actualCredential, err := api.Client.Read(expectedCredential.GetID())
+	actualCredential, err := api.Client.Read(context.Background(), expectedCredential.GetID())
 
 	assert.Empty(t, actualCredential)
 	assert.Error(t, err)
@@ -260,7 +264,7 @@ aibASY5pIRiKENmbZELDtucCAwEAAQ==
 		},
 	}
 
-	err := api.Client.Create(client)
+	err := api.Client.Create(context.Background(), client)
 	require.NoError(t, err)
 
 	t.Cleanup(func() {
@@ -273,7 +277,7 @@ aibASY5pIRiKENmbZELDtucCAwEAAQ==
 func cleanupClient(t *testing.T, clientID string) {
 	t.Helper()
 
-	err := api.Client.Delete(clientID)
+	err := api.Client.Delete(context.Background(), clientID)
 	require.NoError(t, err)
 }
 
@@ -299,7 +303,7 @@ XzvXjHGyxWVu0jdvS9hyhJzP4165k1cYDgx8mmg0VxR7j79LmCUDsFcvvSrAOf6y
 -----END PUBLIC KEY-----`),
 	}
 
-	err := api.Client.CreateCredential(client.GetClientID(), credential)
+	err := api.Client.CreateCredential(context.Background(), client.GetClientID(), credential)
 	require.NoError(t, err)
 
 	t.Cleanup(func() {
@@ -312,6 +316,211 @@ XzvXjHGyxWVu0jdvS9hyhJzP4165k1cYDgx8mmg0VxR7j79LmCUDsFcvvSrAOf6y
 func cleanupCredential(t *testing.T, clientID string, credentialID string) {
 	t.Helper()
 
-	err := api.Client.DeleteCredential(clientID, credentialID)
+	err := api.Client.DeleteCredential(context.Background(), clientID, credentialID)
 	require.NoError(t, err)
 }
+
+func TestClient_Upsert(t *testing.T) {
+	var requests []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/clients":
+			assert.Equal(t, "client_id,name", r.URL.Query().Get("fields"))
+			w.Write([]byte(`{"start":0,"limit":50,"total":2,"clients":[{"client_id":"abc","name":"Other"},{"client_id":"def","name":"Existing"}]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v2/clients/def":
+			w.Write([]byte(`{"client_id":"def","name":"Existing","description":"Updated"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/clients":
+			w.Write([]byte(`{"client_id":"ghi","name":"New"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	existing := &Client{Name: auth0.String("Existing"), Description: auth0.String("Updated")}
+	err := m.Client.Upsert(context.Background(), existing)
+	require.NoError(t, err)
+	assert.Equal(t, "def", existing.GetClientID())
+
+	created := &Client{Name: auth0.String("New")}
+	err = m.Client.Upsert(context.Background(), created)
+	require.NoError(t, err)
+	assert.Equal(t, "ghi", created.GetClientID())
+
+	assert.Equal(t, []string{
+		"GET /api/v2/clients",
+		"PATCH /api/v2/clients/def",
+		"GET /api/v2/clients",
+		"POST /api/v2/clients",
+	}, requests)
+
+	err = m.Client.Upsert(context.Background(), &Client{})
+	assert.EqualError(t, err, "400 Bad Request: Name cannot be empty")
+}
+
+func TestClient_RotateSecretGracefully(t *testing.T) {
+	var requests []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := r.Method + " " + r.URL.Path
+		if r.Method != http.MethodGet {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			request += " " + string(body)
+		}
+		requests = append(requests, request)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/clients/abc":
+			w.Write([]byte(`{"client_id":"abc","client_authentication_methods":{"private_key_jwt":{"credentials":[{"id":"cred_old"}]}}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/clients/def":
+			w.Write([]byte(`{"client_id":"def"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/clients/abc/credentials":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"cred_new","credential_type":"public_key"}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v2/clients/abc":
+			w.Write([]byte(`{"client_id":"abc"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v2/clients/abc/credentials/cred_old":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	var events []CredentialRotationEvent
+	err := m.Client.RotateSecretGracefully(context.Background(), "abc", &CredentialRotation{
+		Credential: &Credential{CredentialType: auth0.String("public_key"), PEM: auth0.String("PEM")},
+		Overlap:    time.Millisecond,
+		OnProgress: func(e CredentialRotationEvent) {
+			events = append(events, e)
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []CredentialRotationEvent{
+		{Step: CredentialRotationCreated, CredentialID: "cred_new"},
+		{Step: CredentialRotationEnabled, CredentialID: "cred_new"},
+		{Step: CredentialRotationOverlap, CredentialID: "cred_old"},
+		{Step: CredentialRotationDisabled, CredentialID: "cred_old"},
+		{Step: CredentialRotationDeleted, CredentialID: "cred_old"},
+	}, events)
+	assert.Equal(t, []string{
+		"GET /api/v2/clients/abc",
+		`POST /api/v2/clients/abc/credentials {"credential_type":"public_key","pem":"PEM"}` + "\n",
+		`PATCH /api/v2/clients/abc {"client_authentication_methods":{"private_key_jwt":{"credentials":[{"id":"cred_new"},{"id":"cred_old"}]}}}` + "\n",
+		`PATCH /api/v2/clients/abc {"client_authentication_methods":{"private_key_jwt":{"credentials":[{"id":"cred_new"}]}}}` + "\n",
+		"DELETE /api/v2/clients/abc/credentials/cred_old ",
+	}, requests)
+
+	err = m.Client.RotateSecretGracefully(context.Background(), "def", &CredentialRotation{})
+	assert.EqualError(t, err, `client "def" doesn't use private_key_jwt, and its secret can't be rotated without revoking the previous one`)
+}
+
+func TestClient_RotateSecretGracefullyWithVerification(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name             string
+		tokenStatus      int
+		expectedErr      string
+		expectedRequests []string
+	}{
+		{
+			name:        "verified",
+			tokenStatus: http.StatusOK,
+			expectedRequests: []string{
+				"GET /api/v2/clients/abc",
+				"POST /api/v2/clients/abc/credentials",
+				"PATCH /api/v2/clients/abc",
+				"POST /oauth/token",
+				"PATCH /api/v2/clients/abc",
+				"DELETE /api/v2/clients/abc/credentials/cred_old",
+			},
+		},
+		{
+			name:        "rolled back",
+			tokenStatus: http.StatusUnauthorized,
+			expectedErr: `failed to verify the new credential "cred_new"`,
+			expectedRequests: []string{
+				"GET /api/v2/clients/abc",
+				"POST /api/v2/clients/abc/credentials",
+				"PATCH /api/v2/clients/abc",
+				"POST /oauth/token",
+				"PATCH /api/v2/clients/abc",
+				"DELETE /api/v2/clients/abc/credentials/cred_new",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var requests []string
+			h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+
+				switch {
+				case r.URL.Path == "/oauth/token":
+					require.NoError(t, r.ParseForm())
+					assert.Equal(t, "abc", r.Form.Get("client_id"))
+					assert.NotEmpty(t, r.Form.Get("client_assertion"))
+
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(test.tokenStatus)
+					if test.tokenStatus == http.StatusOK {
+						w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":86400}`))
+						return
+					}
+					w.Write([]byte(`{"error":"invalid_client","error_description":"Invalid client assertion"}`))
+				case r.Method == http.MethodGet:
+					w.Write([]byte(`{"client_id":"abc","client_authentication_methods":{"private_key_jwt":{"credentials":[{"id":"cred_old"}]}}}`))
+				case r.Method == http.MethodPost:
+					w.WriteHeader(http.StatusCreated)
+					w.Write([]byte(`{"id":"cred_new","credential_type":"public_key","alg":"RS256"}`))
+				case r.Method == http.MethodPatch:
+					w.Write([]byte(`{"client_id":"abc"}`))
+				case r.Method == http.MethodDelete:
+					w.WriteHeader(http.StatusNoContent)
+				}
+			})
+			m := newTestManagement(t, h)
+
+			err = m.Client.RotateSecretGracefully(context.Background(), "abc", &CredentialRotation{
+				Credential: &Credential{CredentialType: auth0.String("public_key"), PEM: auth0.String("PEM")},
+				Signer:     key,
+			})
+			if test.expectedErr != "" {
+				assert.ErrorContains(t, err, test.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedRequests, requests)
+		})
+	}
+}
+
+func TestClientManager_Context(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"clients":[{"client_id":"123"}],"start":0,"limit":1,"total":2}`))
+	})
+	m := newTestManagement(t, h)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := m.Client.Read(cancelled, "123")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	err = m.Client.Delete(cancelled, "123")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// A Context option takes precedence over the context argument.
+	_, err = m.Client.Read(cancelled, "123", Context(context.Background()))
+	assert.NoError(t, err)
+
+	// The next page is retrieved with the context passed to NextPage.
+	l, err := m.Client.List(context.Background(), PerPage(1))
+	require.NoError(t, err)
+	_, err = l.NextPage(cancelled)
+	assert.ErrorIs(t, err, context.Canceled)
+}
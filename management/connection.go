@@ -3,6 +3,7 @@ package management
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"sort"
 	"strings"
 
@@ -88,8 +89,146 @@ const (
 	ConnectionStrategyCustom = "custom"
 	// ConnectionStrategyPingFederate constant.
 	ConnectionStrategyPingFederate = "pingfederate"
+	// ConnectionStrategyAOL constant.
+	ConnectionStrategyAOL = "aol"
+	// ConnectionStrategyAuth0ADLDAP constant.
+	ConnectionStrategyAuth0ADLDAP = "auth0-adldap"
+	// ConnectionStrategyAuth0OIDC constant.
+	ConnectionStrategyAuth0OIDC = "auth0-oidc"
+	// ConnectionStrategyBaidu constant.
+	ConnectionStrategyBaidu = "baidu"
+	// ConnectionStrategyBitly constant.
+	ConnectionStrategyBitly = "bitly"
+	// ConnectionStrategyDAccount constant.
+	ConnectionStrategyDAccount = "daccount"
+	// ConnectionStrategyDwolla constant.
+	ConnectionStrategyDwolla = "dwolla"
+	// ConnectionStrategyEvernoteSandbox constant.
+	ConnectionStrategyEvernoteSandbox = "evernote-sandbox"
+	// ConnectionStrategyEvernote constant.
+	ConnectionStrategyEvernote = "evernote"
+	// ConnectionStrategyExact constant.
+	ConnectionStrategyExact = "exact"
+	// ConnectionStrategyFitbit constant.
+	ConnectionStrategyFitbit = "fitbit"
+	// ConnectionStrategyFlickr constant.
+	ConnectionStrategyFlickr = "flickr"
+	// ConnectionStrategyGuardian constant.
+	ConnectionStrategyGuardian = "guardian"
+	// ConnectionStrategyInstagram constant.
+	ConnectionStrategyInstagram = "instagram"
+	// ConnectionStrategyIP constant.
+	ConnectionStrategyIP = "ip"
+	// ConnectionStrategyLine constant.
+	ConnectionStrategyLine = "line"
+	// ConnectionStrategyMiiCard constant.
+	ConnectionStrategyMiiCard = "miicard"
+	// ConnectionStrategyOAuth1 constant.
+	ConnectionStrategyOAuth1 = "oauth1"
+	// ConnectionStrategyOffice365 constant.
+	ConnectionStrategyOffice365 = "office365"
+	// ConnectionStrategyPaypalSandbox constant.
+	ConnectionStrategyPaypalSandbox = "paypal-sandbox"
+	// ConnectionStrategyPlanningCenter constant.
+	ConnectionStrategyPlanningCenter = "planningcenter"
+	// ConnectionStrategyRenren constant.
+	ConnectionStrategyRenren = "renren"
+	// ConnectionStrategySharePoint constant.
+	ConnectionStrategySharePoint = "sharepoint"
+	// ConnectionStrategySoundCloud constant.
+	ConnectionStrategySoundCloud = "soundcloud"
+	// ConnectionStrategyTheCitySandbox constant.
+	ConnectionStrategyTheCitySandbox = "thecity-sandbox"
+	// ConnectionStrategyTheCity constant.
+	ConnectionStrategyTheCity = "thecity"
+	// ConnectionStrategyThirtySevenSignals constant.
+	ConnectionStrategyThirtySevenSignals = "thirtysevensignals"
+	// ConnectionStrategyUntappd constant.
+	ConnectionStrategyUntappd = "untappd"
+	// ConnectionStrategyVKontakte constant.
+	ConnectionStrategyVKontakte = "vkontakte"
+	// ConnectionStrategyWeibo constant.
+	ConnectionStrategyWeibo = "weibo"
+	// ConnectionStrategyYammer constant.
+	ConnectionStrategyYammer = "yammer"
+	// ConnectionStrategyYandex constant.
+	ConnectionStrategyYandex = "yandex"
 )
 
+var validConnectionStrategies = []string{
+	ConnectionStrategyAD,
+	ConnectionStrategyADFS,
+	ConnectionStrategyAmazon,
+	ConnectionStrategyApple,
+	ConnectionStrategyDropbox,
+	ConnectionStrategyBitBucket,
+	ConnectionStrategyAOL,
+	ConnectionStrategyAuth0ADLDAP,
+	ConnectionStrategyAuth0OIDC,
+	ConnectionStrategyAuth0,
+	ConnectionStrategyBaidu,
+	ConnectionStrategyBitly,
+	ConnectionStrategyBox,
+	ConnectionStrategyCustom,
+	ConnectionStrategyDAccount,
+	ConnectionStrategyDigitalOcean,
+	ConnectionStrategyDiscord,
+	ConnectionStrategyDwolla,
+	ConnectionStrategyEmail,
+	ConnectionStrategyEvernoteSandbox,
+	ConnectionStrategyEvernote,
+	ConnectionStrategyExact,
+	ConnectionStrategyFacebook,
+	ConnectionStrategyFigma,
+	ConnectionStrategyFitbit,
+	ConnectionStrategyFlickr,
+	ConnectionStrategyGitHub,
+	ConnectionStrategyGoogleApps,
+	ConnectionStrategyGoogleOAuth2,
+	ConnectionStrategyGuardian,
+	ConnectionStrategyImgur,
+	ConnectionStrategyInstagram,
+	ConnectionStrategyIP,
+	ConnectionStrategyLine,
+	ConnectionStrategyLinkedin,
+	ConnectionStrategyMiiCard,
+	ConnectionStrategyOAuth1,
+	ConnectionStrategyOAuth2,
+	ConnectionStrategyOffice365,
+	ConnectionStrategyOIDC,
+	ConnectionStrategyOkta,
+	ConnectionStrategyPaypal,
+	ConnectionStrategyPaypalSandbox,
+	ConnectionStrategyPingFederate,
+	ConnectionStrategyPlanningCenter,
+	ConnectionStrategyRenren,
+	ConnectionStrategySalesforceCommunity,
+	ConnectionStrategySalesforceSandbox,
+	ConnectionStrategySalesforce,
+	ConnectionStrategySAML,
+	ConnectionStrategySharePoint,
+	ConnectionStrategyShopify,
+	ConnectionStrategySlack,
+	ConnectionStrategySMS,
+	ConnectionStrategySoundCloud,
+	ConnectionStrategySpotify,
+	ConnectionStrategyTheCitySandbox,
+	ConnectionStrategyTheCity,
+	ConnectionStrategyThirtySevenSignals,
+	ConnectionStrategyTwitch,
+	ConnectionStrategyTwitter,
+	ConnectionStrategyUntappd,
+	ConnectionStrategyVimeo,
+	ConnectionStrategyVKontakte,
+	ConnectionStrategyAzureAD,
+	ConnectionStrategyWeibo,
+	ConnectionStrategyWindowsLive,
+	ConnectionStrategyWordpress,
+	ConnectionStrategyYahoo,
+	ConnectionStrategyYammer,
+	ConnectionStrategyYandex,
+}
+
 // Connection is the relationship between Auth0 and a source of users.
 //
 // See: https://auth0.com/docs/authenticate/identity-providers
@@ -142,6 +281,11 @@ type Connection struct {
 
 	// Display connection as a button.
 	ShowAsButton *bool `json:"show_as_button,omitempty"`
+
+	// Extras holds the fields returned by the Management API that this SDK
+	// doesn't support yet, so that they are preserved when the connection is sent
+	// back to Auth0. Fields unknown to the API should not be added here.
+	Extras map[string]json.RawMessage `json:"-"`
 }
 
 // MarshalJSON implements the json.Marshaler interface.
@@ -162,7 +306,7 @@ func (c *Connection) MarshalJSON() ([]byte, error) {
 		w.RawOptions = b
 	}
 
-	return json.Marshal(w)
+	return marshalWithExtras(w, c.Extras)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -180,6 +324,11 @@ func (c *Connection) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
+	c.Extras, err = unmarshalExtras(b, w, "deleted_at")
+	if err != nil {
+		return err
+	}
+
 	if c.Strategy != nil {
 		var v interface{}
 
@@ -259,6 +408,18 @@ func (c *Connection) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Validate checks the well-known fields of the connection against the values
+// accepted by the Management API, so that typos are caught before the
+// connection is sent to Auth0. A nil error is returned if the connection is
+// valid, otherwise the returned error is of type ValidationErrors.
+func (c *Connection) Validate() error {
+	v := &validator{}
+
+	v.oneOf("strategy", c.Strategy, validConnectionStrategies)
+
+	return v.err()
+}
+
 // ConnectionOptions is used to configure Connections.
 type ConnectionOptions struct {
 	// Options for multifactor authentication. Can be used to set active and
@@ -1090,14 +1251,99 @@ func (m *ConnectionManager) Read(id string, opts ...RequestOption) (c *Connectio
 	return
 }
 
+// ConnectionStrategy configures a request listing connections to only include
+// the connections using one of the given strategies, e.g.
+// ConnectionStrategyAuth0 or ConnectionStrategyGoogleOAuth2.
+//
+// For example:
+//
+//	List(ConnectionStrategy(ConnectionStrategySAML, ConnectionStrategyOIDC))
+func ConnectionStrategy(strategies ...string) RequestOption {
+	return newQueryOption(func(q url.Values) {
+		q["strategy"] = append([]string(nil), strategies...)
+	})
+}
+
+// ConnectionName configures a request listing connections to only include the
+// connection with the given name.
+func ConnectionName(name string) RequestOption {
+	return newQueryOption(func(q url.Values) {
+		q.Set("name", name)
+	})
+}
+
 // List all connections.
 //
+// The connections can be filtered with ConnectionStrategy and ConnectionName.
+//
 // See: https://auth0.com/docs/api/management/v2#!/Connections/get_connections
 func (m *ConnectionManager) List(opts ...RequestOption) (c *ConnectionList, err error) {
-	err = m.Request("GET", m.URI("connections"), &c, applyListDefaults(opts))
+	c, err = listPage[*ConnectionList](m.Management, m.URI("connections"), applyListDefaults(opts))
 	return
 }
 
+// ListForClient retrieves all connections enabled for a client, by going
+// through every page of connections and keeping the ones whose enabled clients
+// include clientID.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Connections/get_connections
+func (m *ConnectionManager) ListForClient(clientID string, opts ...RequestOption) ([]*Connection, error) {
+	connections, err := m.ListAll(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var enabled []*Connection
+	for _, c := range connections {
+		for _, id := range c.GetEnabledClients() {
+			if id == clientID {
+				enabled = append(enabled, c)
+				break
+			}
+		}
+	}
+
+	return enabled, nil
+}
+
+// EnabledClients retrieves all clients a connection is enabled for, by going
+// through every page of clients and keeping the ones in the enabled clients of
+// the connection.
+//
+// The options are applied to every request made.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Clients/get_clients
+func (m *ConnectionManager) EnabledClients(id string, opts ...RequestOption) ([]*Client, error) {
+	c, err := m.Read(id, withOptions(opts, IncludeFields("enabled_clients"))...)
+	if err != nil {
+		return nil, err
+	}
+
+	enabledClients := c.GetEnabledClients()
+	if len(enabledClients) == 0 {
+		return nil, nil
+	}
+
+	enabled := make(map[string]bool, len(enabledClients))
+	for _, clientID := range enabledClients {
+		enabled[clientID] = true
+	}
+
+	clients, err := m.Client.ListAll(requestContext(opts), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Client
+	for _, client := range clients {
+		if enabled[client.GetClientID()] {
+			result = append(result, client)
+		}
+	}
+
+	return result, nil
+}
+
 // Update a connection.
 //
 // Note: if you use the options' parameter, the whole options object will be
@@ -1108,6 +1354,86 @@ func (m *ConnectionManager) Update(id string, c *Connection, opts ...RequestOpti
 	return m.Request("PATCH", m.URI("connections", id), c, opts...)
 }
 
+// Patch updates the fields of the connection set on the patch, which unlike Update
+// can set fields to their zero value or clear them with null, and returns the
+// updated connection.
+func (m *ConnectionManager) Patch(id string, p *ConnectionPatch, opts ...RequestOption) (*Connection, error) {
+	return patchResource[Connection](m.Management, m.URI("connections", id), p, opts...)
+}
+
+// UpdateWithRetry reads the connection, applies mutate to it and updates the
+// fields mutate changed. When the update conflicts with a concurrent change,
+// with a 409 or 412 error, the connection is read again and mutate applied
+// again, up to 5 times, so that concurrent changes aren't overwritten. It
+// returns the updated connection.
+//
+// Fields cleared by mutate are left out of the update.
+//
+// As with Update, the whole options object is sent when mutate changes any
+// of the options.
+//
+// The options are applied to every request made.
+func (m *ConnectionManager) UpdateWithRetry(id string, mutate func(c *Connection) error, opts ...RequestOption) (*Connection, error) {
+	return updateWithRetry(m.Management, m.URI("connections", id),
+		func() (*Connection, error) { return m.Read(id, opts...) },
+		mutate,
+		opts...,
+	)
+}
+
+// Upsert creates the connection if none exists with the same name, or updates
+// the existing one otherwise. The connection is looked up by its name, which
+// is why it must be set.
+//
+// As the name and strategy of a connection can't be updated, they are left
+// out of the update.
+//
+// The options are applied to every request made.
+func (m *ConnectionManager) Upsert(c *Connection, opts ...RequestOption) error {
+	existing, err := m.ReadByName(c.GetName(), opts...)
+	if IsStatusNotFound(err) {
+		return m.Create(c, opts...)
+	}
+	if err != nil {
+		return err
+	}
+
+	update := *c
+	update.Name = nil
+	update.Strategy = nil
+	if err := m.Update(existing.GetID(), &update, opts...); err != nil {
+		return err
+	}
+
+	*c = update
+	return nil
+}
+
+// Ensure converges the connection to the desired state. The connection is
+// created if none exists with the same name, or patched if it has drifted.
+//
+// As the name and strategy of a connection can't be updated, they are left
+// out of the update.
+//
+// The options are applied to every request made.
+func (m *ConnectionManager) Ensure(c *Connection, opts ...RequestOption) (*EnsureResult, error) {
+	update := *c
+	update.Name = nil
+	update.Strategy = nil
+
+	existing, result, err := ensure(c, &update,
+		func() (*Connection, error) { return m.ReadByName(c.GetName(), opts...) },
+		func() error { return m.Create(c, opts...) },
+		func(existing *Connection) error { return m.Update(existing.GetID(), &update, opts...) },
+	)
+	if err != nil {
+		return nil, err
+	}
+	result.ID = existing.GetID()
+
+	return result, nil
+}
+
 // Delete a connection and all its users.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Connections/delete_connections_by_id
@@ -1119,14 +1445,14 @@ func (m *ConnectionManager) Delete(id string, opts ...RequestOption) (err error)
 // connection id is not readily available.
 func (m *ConnectionManager) ReadByName(name string, opts ...RequestOption) (*Connection, error) {
 	if name == "" {
-		return nil, &managementError{400, "Bad Request", "Name cannot be empty"}
+		return nil, &APIError{StatusCode: 400, Err: "Bad Request", Message: "Name cannot be empty"}
 	}
-	c, err := m.List(append(opts, Parameter("name", name))...)
+	c, err := m.List(withOptions(opts, ConnectionName(name))...)
 	if err != nil {
 		return nil, err
 	}
 	if len(c.Connections) > 0 {
 		return c.Connections[0], nil
 	}
-	return nil, &managementError{404, "Not Found", "Connection not found"}
+	return nil, &APIError{StatusCode: 404, Err: "Not Found", Message: "Connection not found"}
 }
@@ -573,6 +573,25 @@ func TestConnectionManager_List(t *testing.T) {
 	assert.Contains(t, connectionList.Connections, needle)
 }
 
+func TestConnectionManager_ListFiltered(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/connections", r.URL.Path)
+		assert.Equal(t, []string{"samlp", "oidc"}, r.URL.Query()["strategy"])
+		assert.Equal(t, "corporate", r.URL.Query().Get("name"))
+
+		w.Write([]byte(`{"connections":[{"id":"con_1","name":"corporate","strategy":"samlp"}]}`))
+	})
+	m := newTestManagement(t, h)
+
+	connections, err := m.Connection.List(
+		ConnectionStrategy(ConnectionStrategySAML, ConnectionStrategyOIDC),
+		ConnectionName("corporate"),
+	)
+	require.NoError(t, err)
+	require.Len(t, connections.Connections, 1)
+	assert.Equal(t, "con_1", connections.Connections[0].GetID())
+}
+
 func TestConnectionOptionsScopes(t *testing.T) {
 	t.Run("It can successfully set the scopes on the options of a OIDC connection", func(t *testing.T) {
 		options := &ConnectionOptionsOIDC{}
@@ -663,3 +682,90 @@ func givenAConnection(t *testing.T, testCase connectionTestCase) *Connection {
 
 	return &connection
 }
+
+func TestConnectionManager_Upsert(t *testing.T) {
+	var requests []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/connections":
+			if r.URL.Query().Get("name") == "existing" {
+				w.Write([]byte(`{"total":1,"connections":[{"id":"con_123","name":"existing","strategy":"auth0"}]}`))
+				return
+			}
+			w.Write([]byte(`{"total":0,"connections":[]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v2/connections/con_123":
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]interface{}{"display_name": "Existing"}, body)
+			w.Write([]byte(`{"id":"con_123","name":"existing","strategy":"auth0","display_name":"Existing"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/connections":
+			w.Write([]byte(`{"id":"con_456","name":"new","strategy":"auth0"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	existing := &Connection{
+		Name:        auth0.String("existing"),
+		Strategy:    auth0.String("auth0"),
+		DisplayName: auth0.String("Existing"),
+	}
+	err := m.Connection.Upsert(existing)
+	require.NoError(t, err)
+	assert.Equal(t, "con_123", existing.GetID())
+	assert.Equal(t, "existing", existing.GetName())
+
+	created := &Connection{Name: auth0.String("new"), Strategy: auth0.String("auth0")}
+	err = m.Connection.Upsert(created)
+	require.NoError(t, err)
+	assert.Equal(t, "con_456", created.GetID())
+
+	assert.Equal(t, []string{
+		"GET /api/v2/connections",
+		"PATCH /api/v2/connections/con_123",
+		"GET /api/v2/connections",
+		"POST /api/v2/connections",
+	}, requests)
+}
+
+func TestConnectionManager_ClientMatrix(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/connections":
+			w.Write([]byte(`{"connections":[
+				{"id":"con_1","name":"db","enabled_clients":["client_1","client_2"]},
+				{"id":"con_2","name":"google-oauth2","enabled_clients":["client_2"]},
+				{"id":"con_3","name":"github","enabled_clients":[]}
+			],"start":0,"limit":50,"total":3}`))
+		case "/api/v2/connections/con_2":
+			assert.Equal(t, "enabled_clients", r.URL.Query().Get("fields"))
+			assert.Equal(t, "enabled", r.Header.Get("X-Test"))
+			w.Write([]byte(`{"enabled_clients":["client_2","client_3"]}`))
+		case "/api/v2/clients":
+			w.Write([]byte(`{"clients":[
+				{"client_id":"client_1"},
+				{"client_id":"client_2"},
+				{"client_id":"client_3"}
+			],"start":0,"limit":50,"total":3}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	connections, err := m.Connection.ListForClient("client_2")
+	require.NoError(t, err)
+	require.Len(t, connections, 2)
+	assert.Equal(t, "con_1", connections[0].GetID())
+	assert.Equal(t, "con_2", connections[1].GetID())
+
+	clients, err := m.Connection.EnabledClients("con_2", Header("X-Test", "enabled"))
+	require.NoError(t, err)
+	require.Len(t, clients, 2)
+	assert.Equal(t, "client_2", clients[0].GetClientID())
+	assert.Equal(t, "client_3", clients[1].GetClientID())
+}
@@ -1,5 +1,14 @@
 package management
 
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"time"
+)
+
 // CustomDomain to be used on authentication pages.
 //
 // See: https://auth0.com/docs/customize/custom-domains
@@ -41,6 +50,86 @@ type CustomDomain struct {
 
 	// The HTTP header to fetch the client's IP address.
 	CustomClientIPHeader *string `json:"custom_client_ip_header,omitempty"`
+
+	// The certificate provisioned for the custom domain, when it's managed by
+	// Auth0.
+	Certificate *CustomDomainCertificate `json:"certificate,omitempty"`
+}
+
+// CustomDomainCertificate is the certificate provisioned by Auth0 for a
+// CustomDomain.
+type CustomDomainCertificate struct {
+	// The provisioning status of the certificate. Can be any of the following:
+	//
+	// "provisioning", "provisioning_failed", "provisioned" or "renewing_failed"
+	Status *string `json:"status,omitempty"`
+
+	// The reason why the provisioning or renewal of the certificate failed.
+	ErrorMsg *string `json:"error_msg,omitempty"`
+
+	// The certificate authority issuing the certificate. Can be either
+	// "letsencrypt" or "googletrust".
+	CertificateAuthority *string `json:"certificate_authority,omitempty"`
+
+	// The time before which the certificate will be renewed.
+	RenewsBefore *Timestamp `json:"renews_before,omitempty"`
+}
+
+// DefaultCertificateExpiryThreshold is the time before the expiry of their
+// certificate from which custom domains need attention.
+const DefaultCertificateExpiryThreshold = 14 * 24 * time.Hour
+
+// CustomDomainCertificateStatus is the status of the certificate served by a
+// custom domain, as reported by CertificateStatus.
+type CustomDomainCertificateStatus struct {
+	// The id of the custom domain.
+	ID string
+
+	// The custom domain.
+	Domain string
+
+	// The custom domain configuration status.
+	Status string
+
+	// The provisioning status of the certificate managed by Auth0, empty for
+	// self-managed certificates.
+	CertificateStatus string
+
+	// The reason why the provisioning or renewal of the certificate failed.
+	CertificateError string
+
+	// The time before which the certificate managed by Auth0 will be renewed.
+	RenewsBefore time.Time
+
+	// The expiry of the certificate served by the custom domain, zero if it
+	// couldn't be retrieved.
+	NotAfter time.Time
+
+	// The time left until the certificate served by the custom domain expires.
+	ExpiresIn time.Duration
+
+	// The error connecting to the custom domain or verifying the certificate
+	// it serves, if any.
+	TLSError error
+}
+
+// Failed reports whether the provisioning or the renewal of the certificate
+// managed by Auth0 failed.
+func (s *CustomDomainCertificateStatus) Failed() bool {
+	return s.CertificateStatus == "provisioning_failed" || s.CertificateStatus == "renewing_failed"
+}
+
+// NeedsAttention reports whether the custom domain is ready but its
+// certificate failed to be provisioned or renewed, couldn't be verified, or
+// expires within threshold.
+func (s *CustomDomainCertificateStatus) NeedsAttention(threshold time.Duration) bool {
+	if s.Failed() {
+		return true
+	}
+	if s.Status != "ready" {
+		return false
+	}
+	return s.TLSError != nil || s.ExpiresIn < threshold
 }
 
 // CustomDomainVerification is used to verify a CustomDomain.
@@ -105,3 +194,95 @@ func (m *CustomDomainManager) List(opts ...RequestOption) (c []*CustomDomain, er
 	err = m.Request("GET", m.URI("custom-domains"), &c, opts...)
 	return
 }
+
+// CertificateStatus reads the certificate status of a custom domain and, if
+// the domain is ready, connects to it to check the expiry of the certificate
+// it serves.
+//
+// Errors connecting to the custom domain are reported in the TLSError of the
+// status rather than returned, so that they can be alerted on.
+func (m *CustomDomainManager) CertificateStatus(ctx context.Context, id string, opts ...RequestOption) (*CustomDomainCertificateStatus, error) {
+	c, err := m.Read(id, withContext(ctx, opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return m.certificateStatus(ctx, c, net.JoinHostPort(c.GetDomain(), "443"), nil), nil
+}
+
+// CertificateStatuses reads the certificate status of all custom domains, as
+// CertificateStatus does.
+func (m *CustomDomainManager) CertificateStatuses(ctx context.Context, opts ...RequestOption) ([]*CustomDomainCertificateStatus, error) {
+	domains, err := m.List(withContext(ctx, opts)...)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*CustomDomainCertificateStatus, len(domains))
+	for i, c := range domains {
+		statuses[i] = m.certificateStatus(ctx, c, net.JoinHostPort(c.GetDomain(), "443"), nil)
+	}
+	return statuses, nil
+}
+
+// certificateStatus builds the certificate status of c, connecting to addr to
+// retrieve the certificate served for it and verifying it against roots, or
+// the system roots if nil.
+func (m *CustomDomainManager) certificateStatus(ctx context.Context, c *CustomDomain, addr string, roots *x509.CertPool) *CustomDomainCertificateStatus {
+	s := &CustomDomainCertificateStatus{
+		ID:                c.GetID(),
+		Domain:            c.GetDomain(),
+		Status:            c.GetStatus(),
+		CertificateStatus: c.GetCertificate().GetStatus(),
+		CertificateError:  c.GetCertificate().GetErrorMsg(),
+		RenewsBefore:      c.GetCertificate().GetRenewsBefore().Time,
+	}
+	if s.Status != "ready" {
+		return s
+	}
+
+	now := m.clock.Now()
+	cert, err := servedCertificate(ctx, s.Domain, addr, roots, now)
+	if cert != nil {
+		s.NotAfter = cert.NotAfter
+		s.ExpiresIn = cert.NotAfter.Sub(now)
+	}
+	s.TLSError = err
+
+	return s
+}
+
+// servedCertificate returns the leaf certificate served at addr for domain,
+// along with the error verifying it at now, if any.
+func servedCertificate(ctx context.Context, domain, addr string, roots *x509.CertPool, now time.Time) (*x509.Certificate, error) {
+	dialer := &tls.Dialer{
+		Config: &tls.Config{
+			ServerName: domain,
+			// The certificate is verified below, so that its expiry can be
+			// reported even if it's no longer valid.
+			InsecureSkipVerify: true, //nolint:gosec
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, errors.New("no certificate was served")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = certs[0].Verify(x509.VerifyOptions{
+		DNSName:       domain,
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+	})
+
+	return certs[0], err
+}
@@ -1,11 +1,15 @@
 package management
 
 import (
+	"context"
+	"crypto/x509"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/auth0/go-auth0"
 )
@@ -126,3 +130,64 @@ func assertNoCustomDomainErr(t *testing.T, err error) {
 		}
 	}
 }
+
+func TestCustomDomainManager_CertificateStatus(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/custom-domains":
+			w.Write([]byte(`[
+				{"custom_domain_id":"cd_1","domain":"login.example.com","status":"pending_verification"},
+				{"custom_domain_id":"cd_2","domain":"auth.example.com","status":"disabled","certificate":{"status":"renewing_failed","error_msg":"CAA record forbids issuance","renews_before":"2023-05-01T00:00:00.000Z"}}
+			]`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	statuses, err := m.CustomDomain.CertificateStatuses(context.Background())
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+
+	assert.Equal(t, "cd_1", statuses[0].ID)
+	assert.False(t, statuses[0].NeedsAttention(DefaultCertificateExpiryThreshold))
+
+	assert.True(t, statuses[1].Failed())
+	assert.True(t, statuses[1].NeedsAttention(DefaultCertificateExpiryThreshold))
+	assert.Equal(t, "CAA record forbids issuance", statuses[1].CertificateError)
+	assert.Equal(t, time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), statuses[1].RenewsBefore)
+	assert.Nil(t, statuses[1].TLSError)
+}
+
+func TestCustomDomainManager_CertificateStatus_Served(t *testing.T) {
+	s := httptest.NewTLSServer(http.NotFoundHandler())
+	defer s.Close()
+
+	m, err := New("example.auth0.com", WithStaticToken("token"))
+	require.NoError(t, err)
+
+	c := &CustomDomain{
+		ID:     auth0.String("cd_1"),
+		Domain: auth0.String("example.com"),
+		Status: auth0.String("ready"),
+		Certificate: &CustomDomainCertificate{
+			Status: auth0.String("provisioned"),
+		},
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(s.Certificate())
+
+	status := m.CustomDomain.certificateStatus(context.Background(), c, s.Listener.Addr().String(), roots)
+	require.NoError(t, status.TLSError)
+	assert.Equal(t, s.Certificate().NotAfter, status.NotAfter)
+	assert.Greater(t, status.ExpiresIn, DefaultCertificateExpiryThreshold)
+	assert.False(t, status.NeedsAttention(DefaultCertificateExpiryThreshold))
+	assert.True(t, status.NeedsAttention(status.ExpiresIn+time.Hour))
+
+	// The certificate isn't trusted without the roots of the test server.
+	status = m.CustomDomain.certificateStatus(context.Background(), c, s.Listener.Addr().String(), nil)
+	assert.Error(t, status.TLSError)
+	assert.Equal(t, s.Certificate().NotAfter, status.NotAfter)
+	assert.True(t, status.NeedsAttention(DefaultCertificateExpiryThreshold))
+}
@@ -4,10 +4,8 @@ package management
 //
 // See https://auth0.com/docs/customize/email/email-templates
 type EmailTemplate struct {
-	// The template name. Can be one of "verify_email", "reset_email",
-	// "welcome_email", "blocked_account", "stolen_credentials",
-	// "enrollment_email", "change_password", "password_reset" or
-	// "mfa_oob_code".
+	// The template name. Can be any of the EmailTemplateName constants, e.g.
+	// "verify_email" or "reset_email".
 	Template *string `json:"template,omitempty"`
 
 	// The body of the template.
@@ -37,6 +35,57 @@ type EmailTemplate struct {
 	IncludeEmailInRedirect *bool `json:"includeEmailInRedirect,omitempty"`
 }
 
+// EmailTemplateName is the name of an email template.
+type EmailTemplateName string
+
+// The names of the email templates.
+const (
+	EmailTemplateVerifyEmail       EmailTemplateName = "verify_email"
+	EmailTemplateVerifyEmailByCode EmailTemplateName = "verify_email_by_code"
+	EmailTemplateResetEmail        EmailTemplateName = "reset_email"
+	EmailTemplateWelcomeEmail      EmailTemplateName = "welcome_email"
+	EmailTemplateBlockedAccount    EmailTemplateName = "blocked_account"
+	EmailTemplateStolenCredentials EmailTemplateName = "stolen_credentials"
+	EmailTemplateEnrollmentEmail   EmailTemplateName = "enrollment_email"
+	EmailTemplateMFAOOBCode        EmailTemplateName = "mfa_oob_code"
+	EmailTemplateUserInvitation    EmailTemplateName = "user_invitation"
+	EmailTemplateChangePassword    EmailTemplateName = "change_password"
+	EmailTemplatePasswordReset     EmailTemplateName = "password_reset"
+)
+
+var validEmailTemplateNames = []string{
+	string(EmailTemplateVerifyEmail),
+	string(EmailTemplateVerifyEmailByCode),
+	string(EmailTemplateResetEmail),
+	string(EmailTemplateWelcomeEmail),
+	string(EmailTemplateBlockedAccount),
+	string(EmailTemplateStolenCredentials),
+	string(EmailTemplateEnrollmentEmail),
+	string(EmailTemplateMFAOOBCode),
+	string(EmailTemplateUserInvitation),
+	string(EmailTemplateChangePassword),
+	string(EmailTemplatePasswordReset),
+}
+
+// Validate checks that the name is one of the email templates supported by
+// the Management API, so that typos are caught before requesting a template
+// that doesn't exist. A nil error is returned if the name is valid, otherwise
+// the returned error is of type ValidationErrors.
+func (n EmailTemplateName) Validate() error {
+	v := &validator{}
+	v.checkOneOf("template", string(n), validEmailTemplateNames)
+	return v.err()
+}
+
+// Validate checks the name of the email template, if set. A nil error is
+// returned if the template is valid, otherwise the returned error is of type
+// ValidationErrors.
+func (e *EmailTemplate) Validate() error {
+	v := &validator{}
+	v.oneOf("template", e.Template, validEmailTemplateNames)
+	return v.err()
+}
+
 // EmailTemplateManager manages Auth0 EmailTemplate resources.
 type EmailTemplateManager struct {
 	*Management
@@ -48,36 +97,81 @@ func newEmailTemplateManager(m *Management) *EmailTemplateManager {
 
 // Create an email template.
 //
+// An error of type ValidationErrors is returned without sending the request
+// if the template name is unknown.
+//
 // See: https://auth0.com/docs/api/management/v2#!/Email_Templates/post_email_templates
 func (m *EmailTemplateManager) Create(e *EmailTemplate, opts ...RequestOption) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
 	return m.Request("POST", m.URI("email-templates"), e, opts...)
 }
 
 // Read an email template by pre-defined name.
 //
-// These names are `verify_email`, `reset_email`, `welcome_email`,
-// `blocked_account`, `stolen_credentials`, `enrollment_email`, and
-// `mfa_oob_code`.
-//
-// The names `change_password`, and `password_reset` are also supported for
-// legacy scenarios.
+// The names EmailTemplateChangePassword and EmailTemplatePasswordReset are
+// supported for legacy scenarios. An error of type ValidationErrors is
+// returned without sending the request if the name is unknown.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Email_Templates/get_email_templates_by_templateName
-func (m *EmailTemplateManager) Read(template string, opts ...RequestOption) (e *EmailTemplate, err error) {
-	err = m.Request("GET", m.URI("email-templates", template), &e, opts...)
+func (m *EmailTemplateManager) Read(template EmailTemplateName, opts ...RequestOption) (e *EmailTemplate, err error) {
+	if err = template.Validate(); err != nil {
+		return nil, err
+	}
+	err = m.Request("GET", m.URI("email-templates", string(template)), &e, opts...)
 	return
 }
 
 // Update an email template.
 //
+// An error of type ValidationErrors is returned without sending the request
+// if the name is unknown.
+//
 // See: https://auth0.com/docs/api/management/v2#!/Email_Templates/patch_email_templates_by_templateName
-func (m *EmailTemplateManager) Update(template string, e *EmailTemplate, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("email-templates", template), e, opts...)
+func (m *EmailTemplateManager) Update(template EmailTemplateName, e *EmailTemplate, opts ...RequestOption) (err error) {
+	if err = template.Validate(); err != nil {
+		return err
+	}
+	return m.Request("PATCH", m.URI("email-templates", string(template)), e, opts...)
+}
+
+// Upsert creates the email template if it isn't configured yet, or updates it
+// otherwise. The template is looked up by its name, which is why it must be
+// set.
+//
+// The template is updated as well when its creation fails because it was
+// configured in the meantime.
+//
+// The options are applied to every request made.
+func (m *EmailTemplateManager) Upsert(e *EmailTemplate, opts ...RequestOption) error {
+	if e.GetTemplate() == "" {
+		return &APIError{StatusCode: 400, Err: "Bad Request", Message: "Template cannot be empty"}
+	}
+
+	template := EmailTemplateName(e.GetTemplate())
+	_, err := m.Read(template, opts...)
+	if IsStatusNotFound(err) {
+		err = m.Create(e, opts...)
+		if !IsStatusConflict(err) {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	return m.Update(template, e, opts...)
 }
 
 // Replace an email template.
 //
+// An error of type ValidationErrors is returned without sending the request
+// if the name is unknown.
+//
 // See: https://auth0.com/docs/api/management/v2#!/Email_Templates/put_email_templates_by_templateName
-func (m *EmailTemplateManager) Replace(template string, e *EmailTemplate, opts ...RequestOption) (err error) {
-	return m.Request("PUT", m.URI("email-templates", template), e, opts...)
+func (m *EmailTemplateManager) Replace(template EmailTemplateName, e *EmailTemplate, opts ...RequestOption) (err error) {
+	if err = template.Validate(); err != nil {
+		return err
+	}
+	return m.Request("PUT", m.URI("email-templates", string(template)), e, opts...)
 }
@@ -1,6 +1,7 @@
 package management
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -32,7 +33,7 @@ func TestEmailTemplateManager_Create(t *testing.T) {
 	}
 
 	t.Cleanup(func() {
-		cleanupEmailTemplate(t, template.GetTemplate())
+		cleanupEmailTemplate(t, EmailTemplateName(template.GetTemplate()))
 	})
 }
 
@@ -41,7 +42,7 @@ func TestEmailTemplateManager_Read(t *testing.T) {
 
 	expectedTemplate := givenAnEmailTemplate(t)
 
-	actualTemplate, err := api.EmailTemplate.Read(expectedTemplate.GetTemplate())
+	actualTemplate, err := api.EmailTemplate.Read(EmailTemplateName(expectedTemplate.GetTemplate()))
 
 	assert.NoError(t, err)
 	assert.ObjectsAreEqual(expectedTemplate, actualTemplate)
@@ -55,7 +56,7 @@ func TestEmailTemplateManager_Update(t *testing.T) {
 	expectedBody := "<html><body><h1>Let's get you verified!</h1></body></html>"
 	expectedIncludeEmailInRedirect := false
 	err := api.EmailTemplate.Update(
-		template.GetTemplate(),
+		EmailTemplateName(template.GetTemplate()),
 		&EmailTemplate{
 			Body:                   &expectedBody,
 			IncludeEmailInRedirect: &expectedIncludeEmailInRedirect,
@@ -63,7 +64,7 @@ func TestEmailTemplateManager_Update(t *testing.T) {
 	)
 	assert.NoError(t, err)
 
-	actualTemplate, err := api.EmailTemplate.Read(template.GetTemplate())
+	actualTemplate, err := api.EmailTemplate.Read(EmailTemplateName(template.GetTemplate()))
 	assert.NoError(t, err)
 	assert.Equal(t, expectedBody, actualTemplate.GetBody())
 	assert.Equal(t, expectedIncludeEmailInRedirect, actualTemplate.GetIncludeEmailInRedirect())
@@ -81,10 +82,10 @@ func TestEmailTemplateManager_Replace(t *testing.T) {
 	template.From = auth0.String("someone@example.com")
 	template.IncludeEmailInRedirect = auth0.Bool(true)
 
-	err := api.EmailTemplate.Replace(template.GetTemplate(), template)
+	err := api.EmailTemplate.Replace(EmailTemplateName(template.GetTemplate()), template)
 	assert.NoError(t, err)
 
-	actualTemplate, err := api.EmailTemplate.Read(template.GetTemplate())
+	actualTemplate, err := api.EmailTemplate.Read(EmailTemplateName(template.GetTemplate()))
 	assert.NoError(t, err)
 
 	assert.Equal(t, actualTemplate.GetBody(), template.GetBody())
@@ -116,15 +117,88 @@ func givenAnEmailTemplate(t *testing.T) *EmailTemplate {
 	}
 
 	t.Cleanup(func() {
-		cleanupEmailTemplate(t, template.GetTemplate())
+		cleanupEmailTemplate(t, EmailTemplateName(template.GetTemplate()))
 	})
 
 	return template
 }
 
-func cleanupEmailTemplate(t *testing.T, templateName string) {
+func cleanupEmailTemplate(t *testing.T, templateName EmailTemplateName) {
 	t.Helper()
 
 	err := api.EmailTemplate.Update(templateName, &EmailTemplate{Enabled: auth0.Bool(false)})
 	require.NoError(t, err)
 }
+
+func TestEmailTemplateManager_Upsert(t *testing.T) {
+	var requests []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/email-templates/verify_email":
+			w.Write([]byte(`{"template":"verify_email","enabled":false}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v2/email-templates/verify_email":
+			w.Write([]byte(`{"template":"verify_email","enabled":true}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/email-templates":
+			var e EmailTemplate
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&e))
+			if e.GetTemplate() == "reset_email" {
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"statusCode":409,"error":"Conflict","message":"Template reset_email already exists."}`))
+				return
+			}
+			w.Write([]byte(`{"template":"welcome_email","enabled":true}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v2/email-templates/reset_email":
+			w.Write([]byte(`{"template":"reset_email","enabled":true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"error":"Not Found","message":"The template does not exist."}`))
+		}
+	})
+	m := newTestManagement(t, h)
+
+	err := m.EmailTemplate.Upsert(&EmailTemplate{Template: auth0.String("verify_email"), Enabled: auth0.Bool(true)})
+	require.NoError(t, err)
+
+	err = m.EmailTemplate.Upsert(&EmailTemplate{Template: auth0.String("welcome_email"), Enabled: auth0.Bool(true)})
+	require.NoError(t, err)
+
+	err = m.EmailTemplate.Upsert(&EmailTemplate{Template: auth0.String("reset_email"), Enabled: auth0.Bool(true)})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"GET /api/v2/email-templates/verify_email",
+		"PATCH /api/v2/email-templates/verify_email",
+		"GET /api/v2/email-templates/welcome_email",
+		"POST /api/v2/email-templates",
+		"GET /api/v2/email-templates/reset_email",
+		"POST /api/v2/email-templates",
+		"PATCH /api/v2/email-templates/reset_email",
+	}, requests)
+}
+
+func TestEmailTemplateManager_UnknownName(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	m := newTestManagement(t, h)
+
+	assert.NoError(t, EmailTemplateVerifyEmail.Validate())
+
+	_, err := m.EmailTemplate.Read("verify_emial")
+	assert.EqualError(t, err, `invalid template: "verify_emial" is not one of ["verify_email" "verify_email_by_code" "reset_email" "welcome_email" "blocked_account" "stolen_credentials" "enrollment_email" "mfa_oob_code" "user_invitation" "change_password" "password_reset"]`)
+
+	var validationErrs ValidationErrors
+	err = m.EmailTemplate.Update("welcome", &EmailTemplate{Enabled: auth0.Bool(true)})
+	assert.ErrorAs(t, err, &validationErrs)
+
+	err = m.EmailTemplate.Replace("welcome", &EmailTemplate{Enabled: auth0.Bool(true)})
+	assert.ErrorAs(t, err, &validationErrs)
+
+	err = m.EmailTemplate.Create(&EmailTemplate{Template: auth0.String("welcome")})
+	assert.ErrorAs(t, err, &validationErrs)
+
+	err = m.EmailTemplate.Upsert(&EmailTemplate{Template: auth0.String("welcome")})
+	assert.ErrorAs(t, err, &validationErrs)
+}
@@ -0,0 +1,115 @@
+package management
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// knownFieldsCache caches the JSON keys known by a struct type.
+var knownFieldsCache sync.Map
+
+// knownFields returns the set of JSON keys which are mapped to a field of the
+// struct type t, including the fields of embedded structs.
+func knownFields(t reflect.Type) map[string]bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if fields, ok := knownFieldsCache.Load(t); ok {
+		return fields.(map[string]bool)
+	}
+
+	fields := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for key := range knownFields(embedded) {
+					fields[key] = true
+				}
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = true
+	}
+
+	knownFieldsCache.Store(t, fields)
+
+	return fields
+}
+
+// unmarshalExtras returns the fields of the JSON object b which are not mapped
+// to any field of v, so they can be preserved when the resource is sent back.
+//
+// Read-only fields which are known to be returned by the API, but that it
+// would reject if sent back, can be listed in ignored.
+func unmarshalExtras(b []byte, v interface{}, ignored ...string) (map[string]json.RawMessage, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	known := knownFields(reflect.TypeOf(v))
+
+	for _, key := range ignored {
+		delete(raw, key)
+	}
+
+	var extras map[string]json.RawMessage
+	for key, value := range raw {
+		if known[key] {
+			continue
+		}
+		if extras == nil {
+			extras = map[string]json.RawMessage{}
+		}
+		extras[key] = value
+	}
+
+	return extras, nil
+}
+
+// marshalWithExtras encodes v as JSON and adds the extras whose keys are not
+// mapped to any field of v.
+func marshalWithExtras(v interface{}, extras map[string]json.RawMessage) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(extras) == 0 {
+		return b, err
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(b, &object); err != nil {
+		return nil, err
+	}
+
+	known := knownFields(reflect.TypeOf(v))
+	for key, value := range extras {
+		if known[key] {
+			continue
+		}
+		object[key] = value
+	}
+
+	return json.Marshal(object)
+}
@@ -0,0 +1,100 @@
+package management
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/go-auth0"
+)
+
+func TestExtras_RoundTrip(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		resource interface{}
+		given    string
+	}{
+		{
+			name:     "Client",
+			resource: &Client{},
+			given:    `{"client_id":"abc","name":"Test","brand_new_field":{"enabled":true}}`,
+		},
+		{
+			name:     "Connection",
+			resource: &Connection{},
+			given:    `{"name":"Test","strategy":"auth0","options":{"mfa":{"active":true}},"brand_new_field":[1,2]}`,
+		},
+		{
+			name:     "ResourceServer",
+			resource: &ResourceServer{},
+			given:    `{"identifier":"https://api.example.com","brand_new_field":"value"}`,
+		},
+		{
+			name:     "Organization",
+			resource: &Organization{},
+			given:    `{"name":"test-org","brand_new_field":"value"}`,
+		},
+		{
+			name:     "Role",
+			resource: &Role{},
+			given:    `{"name":"admin","brand_new_field":"value"}`,
+		},
+		{
+			name:     "Tenant",
+			resource: &Tenant{},
+			given:    `{"friendly_name":"Test","brand_new_field":"value"}`,
+		},
+		{
+			name:     "User",
+			resource: &User{},
+			given:    `{"user_id":"auth0|123","email_verified":true,"brand_new_field":"value"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := json.Unmarshal([]byte(testCase.given), testCase.resource)
+			require.NoError(t, err)
+
+			actual, err := json.Marshal(testCase.resource)
+			require.NoError(t, err)
+			assert.JSONEq(t, testCase.given, string(actual))
+		})
+	}
+}
+
+func TestExtras_OnlyHoldsUnknownFields(t *testing.T) {
+	var c Client
+	err := json.Unmarshal([]byte(`{
+		"client_id": "abc",
+		"tenant": "my-tenant",
+		"global": false,
+		"brand_new_field": "value"
+	}`), &c)
+	require.NoError(t, err)
+
+	assert.Equal(t, "abc", c.GetClientID())
+	assert.Equal(t, map[string]json.RawMessage{"brand_new_field": json.RawMessage(`"value"`)}, c.Extras)
+
+	var u User
+	err = json.Unmarshal([]byte(`{"user_id":"auth0|123","email_verified":"true"}`), &u)
+	require.NoError(t, err)
+	assert.Nil(t, u.Extras)
+}
+
+func TestExtras_DoNotOverrideKnownFields(t *testing.T) {
+	c := &Client{
+		Name: auth0.String("Test"),
+		Extras: map[string]json.RawMessage{
+			"name":        json.RawMessage(`"Overridden"`),
+			"description": json.RawMessage(`"Overridden"`),
+			"new_field":   json.RawMessage(`true`),
+		},
+	}
+
+	actual, err := json.Marshal(c)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Test","new_field":true}`, string(actual))
+}
@@ -36,7 +36,7 @@ const (
 var (
 	verbose = flag.Bool("v", false, "Print verbose log messages")
 
-	sourceTmpl = template.Must(template.New("source").Parse(source))
+	sourceTmpl = template.Must(template.Must(template.New("source").Parse(source)).Parse(listTmpl))
 	testTmpl   = template.Must(template.New("test").Parse(test))
 
 	// skipStructMethods lists "struct.method" combos to skip.
@@ -45,6 +45,91 @@ var (
 	skipStructs = []string{
 		"Management",
 		".*Manager",
+		"^CredentialRotation$",
+		"^OrganizationMemberImport$",
+		"LogExport",
+		"^Nullable$",
+		"^Paginator$",
+		"^Pool$",
+		"^SigningKeyRotation$",
+		"Timestamp",
+		"UserImporter",
+	}
+	// redactStructs lists structs holding secrets, whose String and GoString
+	// methods redact those secrets.
+	redactStructs = map[string]bool{
+		"ActionMigration":                 true,
+		"Captcha":                         true,
+		"CaptchaArkose":                   true,
+		"CaptchaProvider":                 true,
+		"CaptchaRecaptchaEnterprise":      true,
+		"Client":                          true,
+		"Config":                          true,
+		"Connection":                      true,
+		"ConnectionGatewayAuthentication": true,
+		"ConnectionOptionsApple":          true,
+		"ConnectionOptionsAzureAD":        true,
+		"ConnectionOptionsFacebook":       true,
+		"ConnectionOptionsGitHub":         true,
+		"ConnectionOptionsGoogleApps":     true,
+		"ConnectionOptionsGoogleOAuth2":   true,
+		"ConnectionOptionsLinkedin":       true,
+		"ConnectionOptionsOAuth2":         true,
+		"ConnectionOptionsOIDC":           true,
+		"ConnectionOptionsOkta":           true,
+		"ConnectionOptionsPingFederate":   true,
+		"ConnectionOptionsSAML":           true,
+		"ConnectionOptionsSAMLSigningKey": true,
+		"ConnectionOptionsSMS":            true,
+		"ConnectionOptionsSalesforce":     true,
+		"ConnectionOptionsWindowsLive":    true,
+		"Credential":                      true,
+		"TenantConfig":                    true,
+	}
+	// listManagers lists the managers whose ListAll and Paginate methods are
+	// generated from their List method, so that every manager goes through
+	// its pages the same way.
+	listManagers = []*listManager{
+		{
+			Manager:  "ClientManager",
+			List:     "ClientList",
+			ItemType: "*Client",
+			Plural:   "client applications",
+			Docs:     "https://auth0.com/docs/api/management/v2#!/Clients/get_clients",
+			Context:  true,
+		},
+		{
+			Manager:  "ConnectionManager",
+			List:     "ConnectionList",
+			ItemType: "*Connection",
+			Plural:   "connections",
+			Docs:     "https://auth0.com/docs/api/management/v2#!/Connections/get_connections",
+		},
+		{
+			Manager:  "ResourceServerManager",
+			List:     "ResourceServerList",
+			ItemType: "*ResourceServer",
+			Plural:   "resource servers",
+			Docs:     "https://auth0.com/docs/api/management/v2#!/Resource_Servers/get_resource_servers",
+		},
+		{
+			Manager:  "RoleManager",
+			List:     "RoleList",
+			ItemType: "*Role",
+			Plural:   "roles",
+			Docs:     "https://auth0.com/docs/api/management/v2#!/Roles/get_roles",
+		},
+		{
+			Manager:  "UserManager",
+			List:     "UserList",
+			ItemType: "*User",
+			Plural:   "users",
+			Docs:     "https://auth0.com/docs/api/management/v2#!/Users/get_users",
+			Note: []string{
+				"Note that the Management API returns at most the first 1000 users of a",
+				"search, use a user export job to retrieve more than that.",
+			},
+		},
 	}
 )
 
@@ -77,6 +162,11 @@ func main() {
 				log.Fatal(err)
 			}
 		}
+		if pkgName == "management" {
+			for _, lm := range listManagers {
+				t.addListManager(lm)
+			}
+		}
 		if err := t.dump(); err != nil {
 			log.Fatal(err)
 		}
@@ -120,8 +210,14 @@ func (t *templateData) processAST(f *ast.File) error {
 			// Add stringer method
 			t.addStringer(ts.Name.String())
 
+			isList := false
 			for _, field := range st.Fields.List {
 				if len(field.Names) == 0 {
+					// Add the NextPage method of lists embedding List.
+					if x, ok := field.Type.(*ast.Ident); ok && x.Name == "List" {
+						t.addNextPage(ts.Name.String())
+						isList = true
+					}
 					continue
 				}
 
@@ -143,6 +239,12 @@ func (t *templateData) processAST(f *ast.File) error {
 					case *ast.MapType:
 						t.addMapType(x, ts.Name.String(), fieldName.String(), false)
 						continue
+					case *ast.ArrayType:
+						// Add the items method returning the resources of lists.
+						if isList {
+							t.addItems(x, ts.Name.String(), fieldName.String())
+							continue
+						}
 					}
 
 					logf("Skipping field type %T, fieldName=%v", field.Type, fieldName)
@@ -230,6 +332,55 @@ func (t *templateData) addStringer(receiverType string) {
 		ReceiverVar:  strings.ToLower(receiverType[:1]),
 		ReceiverType: receiverType,
 		Stringer:     true,
+		Redacted:     redactStructs[receiverType],
+	})
+}
+
+func (t *templateData) addNextPage(receiverType string) {
+	t.Imports["context"] = "context"
+	t.Getters = append(t.Getters, &getter{
+		sortVal:      strings.ToLower(receiverType) + ".nextpage",
+		ReceiverVar:  strings.ToLower(receiverType[:1]),
+		ReceiverType: receiverType,
+		NextPage:     true,
+	})
+}
+
+func (t *templateData) addItems(x *ast.ArrayType, receiverType, fieldName string) {
+	var eltType string
+	switch elt := x.Elt.(type) {
+	case *ast.Ident:
+		eltType = elt.String()
+	case *ast.StarExpr:
+		ident, ok := elt.X.(*ast.Ident)
+		if !ok {
+			logf("addItems: type %q, field %q: unknown elt type: %T %+v; skipping.", receiverType, fieldName, elt.X, elt.X)
+			return
+		}
+		eltType = "*" + ident.String()
+	default:
+		logf("addItems: type %q, field %q: unknown elt type: %T %+v; skipping.", receiverType, fieldName, elt, elt)
+		return
+	}
+
+	t.Getters = append(t.Getters, &getter{
+		sortVal:      strings.ToLower(receiverType) + ".items",
+		ReceiverVar:  strings.ToLower(receiverType[:1]),
+		ReceiverType: receiverType,
+		FieldName:    fieldName,
+		FieldType:    "[]" + eltType,
+		Items:        true,
+	})
+}
+
+func (t *templateData) addListManager(lm *listManager) {
+	if lm.Context {
+		t.Imports["context"] = "context"
+	}
+	t.Getters = append(t.Getters, &getter{
+		sortVal:      strings.ToLower(lm.Manager) + ".listall",
+		ReceiverType: lm.Manager,
+		ListAll:      lm,
 	})
 }
 
@@ -340,6 +491,22 @@ type getter struct {
 	NamedStruct  bool // Getter for named struct.
 	MapType      bool
 	Stringer     bool // Used for the structs String method.
+	Redacted     bool // Used for structs whose String method redacts secrets.
+	NextPage     bool // Used for the NextPage method of lists.
+	Items        bool // Used for the items method of lists.
+	ListAll      *listManager
+}
+
+// listManager describes the List method of a manager, from which its ListAll
+// and Paginate methods are generated.
+type listManager struct {
+	Manager  string
+	List     string   // The type of the pages returned by List.
+	ItemType string   // The type of the resources of the pages.
+	Plural   string   // The resources, as named in doc comments.
+	Docs     string   // The documentation of the endpoint.
+	Context  bool     // Whether List takes a context as first argument.
+	Note     []string // Lines added to the doc comment of ListAll.
 }
 
 type byName []*getter
@@ -348,6 +515,13 @@ func (b byName) Len() int           { return len(b) }
 func (b byName) Less(i, j int) bool { return b[i].sortVal < b[j].sortVal }
 func (b byName) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
 
+// listTmpl is the List method of a manager, as passed to listPages and
+// NewPaginator.
+const listTmpl = `{{define "list"}}
+{{- if .Context}}func(opts ...RequestOption) (*{{.List}}, error) { return m.List(ctx, opts...) }
+{{- else}}m.List{{end}}
+{{- end}}`
+
 const source = `// Code generated by gen-methods; DO NOT EDIT.
 // Please run "go generate ./..." instead.
 
@@ -376,6 +550,55 @@ func ({{.ReceiverVar}} *{{.ReceiverType}}) Get{{.FieldName}}() {{.FieldType}} {
   }
   return {{.ReceiverVar}}.{{.FieldName}}
 }
+{{else if .NextPage}}
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) NextPage(ctx context.Context) (*{{.ReceiverType}}, error) {
+  if {{.ReceiverVar}} == nil {
+    return nil, nil
+  }
+  return nextPage[*{{.ReceiverType}}](ctx, {{.ReceiverVar}}.List)
+}
+{{else if .Items}}
+// items returns the {{.FieldName}} field, which holds the resources of the page.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) items() {{.FieldType}} {
+  if {{.ReceiverVar}} == nil {
+    return nil
+  }
+  return {{.ReceiverVar}}.{{.FieldName}}
+}
+{{else if .ListAll}}{{with .ListAll}}
+// ListAll retrieves all {{.Plural}} by going through every page of results.
+//
+// Pages are retrieved concurrently when WithListConcurrency is used.
+//
+{{- range .Note}}
+// {{.}}
+{{- end}}{{if .Note}}
+//{{end}}
+// See: {{.Docs}}
+func (m *{{.Manager}}) ListAll({{if .Context}}ctx context.Context, {{end}}opts ...RequestOption) ([]{{.ItemType}}, error) {
+  return listAll(m.Management, listPages({{template "list" .}}, (*{{.List}}).items), opts...)
+}
+
+// Paginate returns a Paginator over all {{.Plural}}, retrieving the pages of
+// results as they're needed.
+//
+// See: {{.Docs}}
+func (m *{{.Manager}}) Paginate({{if .Context}}ctx context.Context, {{end}}opts ...RequestOption) *Paginator[{{.ItemType}}] {
+  return NewPaginator({{template "list" .}}, (*{{.List}}).items, opts...)
+}
+{{end}}
+{{else if and .Stringer .Redacted}}
+// String returns a string representation of {{.ReceiverType}} with any secrets redacted.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) String() string {
+  return stringifyRedacted({{.ReceiverVar}})
+}
+
+// GoString returns a string representation of {{.ReceiverType}} with any secrets redacted.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) GoString() string {
+  return {{.ReceiverVar}}.String()
+}
 {{else if .Stringer}}
 // String returns a string representation of {{.ReceiverType}}.
 func ({{.ReceiverVar}} *{{.ReceiverType}}) String() string {
@@ -397,15 +620,15 @@ const test = `// Code generated by gen-methods; DO NOT EDIT.
 // Please run "go generate ./..." instead.
 
 package {{.Package}}
-{{with .Imports}}
+
 import (
   "encoding/json"
   "testing"
-  {{range . -}}
+  {{range .Imports -}}
   "{{.}}"
   {{end -}}
 )
-{{end}}
+
 {{range .Getters}}
 {{if .NamedStruct}}
 func Test{{.ReceiverType}}_Get{{.FieldName}}(tt *testing.T) {
@@ -424,6 +647,36 @@ func Test{{.ReceiverType}}_Get{{.FieldName}}(tt *testing.T) {
   {{.ReceiverVar}} = nil
   {{.ReceiverVar}}.Get{{.FieldName}}()
 }
+{{else if .Items}}
+func Test{{.ReceiverType}}_items(tt *testing.T) {
+  {{.ReceiverVar}} := &{{.ReceiverType}}{}
+  {{.ReceiverVar}}.items()
+  {{.ReceiverVar}} = nil
+  {{.ReceiverVar}}.items()
+}
+{{else if .ListAll}}
+{{else if .NextPage}}
+func Test{{.ReceiverType}}_NextPage(tt *testing.T) {
+  {{.ReceiverVar}} := &{{.ReceiverType}}{}
+  if next, err := {{.ReceiverVar}}.NextPage(context.Background()); next != nil || err != nil {
+    tt.Errorf("expected no next page, got %v, %v", next, err)
+  }
+  {{.ReceiverVar}} = nil
+  if next, err := {{.ReceiverVar}}.NextPage(context.Background()); next != nil || err != nil {
+    tt.Errorf("expected no next page, got %v, %v", next, err)
+  }
+}
+{{else if and .Stringer .Redacted}}
+func Test{{ .ReceiverType }}_String(t *testing.T) {
+  var rawJSON json.RawMessage
+  v := &{{ .ReceiverType }}{}
+  if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+    t.Errorf("failed to produce a valid json")
+  }
+  if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+    t.Errorf("failed to produce a valid json")
+  }
+}
 {{else if .Stringer}}
 func Test{{ .ReceiverType }}_String(t *testing.T) {
   var rawJSON json.RawMessage
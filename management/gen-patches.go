@@ -0,0 +1,262 @@
+//go:build ignore
+// +build ignore
+
+// gen-patches generates patch types for the resources which can be updated,
+// with setters for their fields tracking which fields were set.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const patchesFilename = "management_patch.gen.go"
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	patchesTmpl = template.Must(template.New("patches").Parse(patchesSource))
+
+	// patchStructs lists the structs to generate patch types for.
+	patchStructs = map[string]bool{
+		"Client":         true,
+		"Connection":     true,
+		"Organization":   true,
+		"ResourceServer": true,
+		"Role":           true,
+		"User":           true,
+	}
+
+	// skipPatchFields lists "struct.field" combos which can't be updated.
+	skipPatchFields = map[string]bool{
+		"Client.ClientID":           true,
+		"Client.SigningKeys":        true,
+		"Connection.ID":             true,
+		"Connection.Name":           true,
+		"Connection.Strategy":       true,
+		"Organization.ID":           true,
+		"ResourceServer.ID":         true,
+		"ResourceServer.Identifier": true,
+		"Role.ID":                   true,
+		"User.ID":                   true,
+		"User.CreatedAt":            true,
+		"User.UpdatedAt":            true,
+		"User.LastLogin":            true,
+		"User.LastPasswordReset":    true,
+		"User.Identities":           true,
+		"User.LastIP":               true,
+		"User.LoginsCount":          true,
+		"User.Multifactor":          true,
+	}
+
+	// patchFieldNames lists the JSON names of the "struct.field" combos which
+	// are encoded by a custom MarshalJSON method.
+	patchFieldNames = map[string]string{
+		"Connection.Options": "options",
+		"User.EmailVerified": "email_verified",
+	}
+)
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", sourceFilter, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for pkgName, pkg := range pkgs {
+		t := &patchesData{Package: pkgName}
+		for filename, f := range pkg.Files {
+			logf("Processing %v...", filename)
+			t.processAST(f)
+		}
+		if err := t.dump(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	logf("Done.")
+}
+
+func sourceFilter(fi os.FileInfo) bool {
+	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), ".gen.go")
+}
+
+func (t *patchesData) processAST(f *ast.File) {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok || !patchStructs[ts.Name.String()] {
+				continue
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+
+			p := &patch{Type: ts.Name.String()}
+			for _, field := range st.Fields.List {
+				if len(field.Names) == 0 || !field.Names[0].IsExported() {
+					continue
+				}
+				if f := newPatchField(p.Type, field); f != nil {
+					p.Fields = append(p.Fields, f)
+				}
+			}
+			t.Patches = append(t.Patches, p)
+		}
+	}
+}
+
+func newPatchField(structName string, field *ast.Field) *patchField {
+	name := field.Names[0].String()
+	key := structName + "." + name
+	if skipPatchFields[key] {
+		logf("Field %v can't be updated; skipping.", key)
+		return nil
+	}
+
+	jsonName, ok := patchFieldNames[key]
+	if !ok {
+		if field.Tag == nil {
+			return nil
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			log.Fatal(err)
+		}
+		jsonName = strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+		if jsonName == "" || jsonName == "-" {
+			logf("Field %v isn't encoded; skipping.", key)
+			return nil
+		}
+	}
+
+	// Pointers to values and nullable values are dereferenced, while pointers
+	// to structs are kept as is.
+	fieldType := field.Type
+	if se, ok := fieldType.(*ast.StarExpr); ok {
+		switch x := se.X.(type) {
+		case *ast.IndexExpr:
+			if ident, ok := x.X.(*ast.Ident); ok && ident.Name == "Nullable" {
+				fieldType = x.Index
+			}
+		case *ast.ArrayType, *ast.MapType:
+			fieldType = x
+		case *ast.Ident:
+			switch x.Name {
+			case "string", "bool", "int", "int64", "float64", "Timestamp":
+				fieldType = x
+			}
+		}
+	}
+
+	return &patchField{
+		Name:     name,
+		JSONName: jsonName,
+		Type:     types.ExprString(fieldType),
+	}
+}
+
+func (t *patchesData) dump() error {
+	if len(t.Patches) == 0 {
+		logf("No patches for package %v; skipping.", t.Package)
+		return nil
+	}
+
+	sort.Slice(t.Patches, func(i, j int) bool { return t.Patches[i].Type < t.Patches[j].Type })
+
+	var buf bytes.Buffer
+	if err := patchesTmpl.Execute(&buf, t); err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("format.Source:\n%v\n%v", buf.String(), err)
+	}
+
+	logf("Writing %v...", patchesFilename)
+	if err := os.Chmod(patchesFilename, 0644); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("os.Chmod(%q, 0644): %v", patchesFilename, err)
+	}
+	if err := os.WriteFile(patchesFilename, clean, 0444); err != nil {
+		return err
+	}
+	return os.Chmod(patchesFilename, 0444)
+}
+
+type patchesData struct {
+	Package string
+	Patches []*patch
+}
+
+type patch struct {
+	Type   string
+	Fields []*patchField
+}
+
+type patchField struct {
+	Name     string
+	JSONName string
+	Type     string
+}
+
+const patchesSource = `// Code generated by gen-patches; DO NOT EDIT.
+// Please run "go generate ./..." instead.
+
+package {{.Package}}
+
+import "encoding/json"
+{{range .Patches}}{{$patch := .}}
+// {{.Type}}Patch is a partial update of a {{.Type}}, which only holds the
+// fields explicitly set, including to their zero value or to null.
+type {{.Type}}Patch struct {
+  fields patchFields
+}
+
+// MarshalJSON encodes the fields set on the patch.
+func (p *{{.Type}}Patch) MarshalJSON() ([]byte, error) {
+  return json.Marshal(p.fields)
+}
+
+// Fields returns the JSON names of the fields set on the patch, sorted.
+func (p *{{.Type}}Patch) Fields() []string {
+  return p.fields.names()
+}
+{{range .Fields}}
+// Set{{.Name}} sets the {{.JSONName}} field.
+func (p *{{$patch.Type}}Patch) Set{{.Name}}(v {{.Type}}) *{{$patch.Type}}Patch {
+  p.fields = p.fields.set("{{.JSONName}}", v)
+  return p
+}
+
+// Clear{{.Name}} sets the {{.JSONName}} field to null.
+func (p *{{$patch.Type}}Patch) Clear{{.Name}}() *{{$patch.Type}}Patch {
+  p.fields = p.fields.set("{{.JSONName}}", nil)
+  return p
+}
+{{end}}{{end}}`
@@ -38,7 +38,7 @@ func newGrantManager(m *Management) *GrantManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Grants/get_grants
 func (m *GrantManager) List(opts ...RequestOption) (g *GrantList, err error) {
-	err = m.Request("GET", m.URI("grants"), &g, applyListDefaults(opts))
+	g, err = listPage[*GrantList](m.Management, m.URI("grants"), applyListDefaults(opts))
 	return
 }
 
@@ -3,7 +3,6 @@ package management
 import (
 	"encoding/json"
 	"net/http"
-	"time"
 )
 
 // Enrollment is used for MultiFactor Authentication.
@@ -19,9 +18,9 @@ type Enrollment struct {
 	// Phone number.
 	PhoneNumber *string `json:"phone_number,omitempty"`
 	// Enrollment date and time.
-	EnrolledAt *time.Time `json:"enrolled_at,omitempty"`
+	EnrolledAt *Timestamp `json:"enrolled_at,omitempty"`
 	// Last authentication date and time.
-	LastAuth *time.Time `json:"last_auth,omitempty"`
+	LastAuth *Timestamp `json:"last_auth,omitempty"`
 }
 
 // MultiFactor Authentication method.
@@ -154,6 +153,16 @@ type CreateEnrollmentTicket struct {
 	// SendMail indicates whether to send an email to the user to start the
 	// multi-factor authentication enrollment process.
 	SendMail bool `json:"send_mail,omitempty"`
+	// EmailLocale is the locale of the enrollment email. Defaults to the
+	// locale of the tenant.
+	EmailLocale string `json:"email_locale,omitempty"`
+	// Factor is the factor the user is asked to enroll with, such as
+	// "push-notification", "phone", "email", "otp", "webauthn-roaming" or
+	// "webauthn-platform". If empty, the user can pick any enabled factor.
+	Factor string `json:"factor,omitempty"`
+	// AllowMultipleEnrollments allows the user to enroll with more factors
+	// when they're already enrolled with one.
+	AllowMultipleEnrollments bool `json:"allow_multiple_enrollments,omitempty"`
 }
 
 // EnrollmentTicket holds information on the ticket ID and URL.
@@ -187,6 +196,42 @@ func (m *EnrollmentManager) CreateTicket(t *CreateEnrollmentTicket, opts ...Requ
 	return out, err
 }
 
+// SendTicket creates a multi-factor authentication enrollment ticket for a
+// user and has Auth0 send them the enrollment email. The email is sent to the
+// Email of t if set, or to the user's email address otherwise.
+//
+// The other ticket options are taken from t, which can be nil.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Guardian/post_ticket
+func (m *EnrollmentManager) SendTicket(userID string, t *CreateEnrollmentTicket, opts ...RequestOption) (EnrollmentTicket, error) {
+	ticket := enrollmentTicketFor(userID, t)
+	ticket.SendMail = true
+	return m.CreateTicket(ticket, opts...)
+}
+
+// TicketURL creates a multi-factor authentication enrollment ticket for a
+// user without sending the enrollment email, and returns the URL of the
+// ticket so that it can be delivered to the user by other means.
+//
+// The other ticket options are taken from t, which can be nil.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Guardian/post_ticket
+func (m *EnrollmentManager) TicketURL(userID string, t *CreateEnrollmentTicket, opts ...RequestOption) (string, error) {
+	ticket := enrollmentTicketFor(userID, t)
+	ticket.SendMail = false
+	created, err := m.CreateTicket(ticket, opts...)
+	return created.TicketURL, err
+}
+
+func enrollmentTicketFor(userID string, t *CreateEnrollmentTicket) *CreateEnrollmentTicket {
+	var ticket CreateEnrollmentTicket
+	if t != nil {
+		ticket = *t
+	}
+	ticket.UserID = userID
+	return &ticket
+}
+
 // Get retrieves an enrollment (including its status and type).
 //
 // See: https://auth0.com/docs/api/management/v2#!/Guardian/get_enrollments_by_id
@@ -453,12 +498,54 @@ func (m *MultiFactorDUO) Update(s *MultiFactorDUOSettings, opts ...RequestOption
 	return m.Request("PUT", m.URI("guardian", "factors", "duo", "settings"), &s, opts...)
 }
 
+// The user verification requirements of WebAuthn factors.
+const (
+	// WebAuthnUserVerificationDiscouraged doesn't ask authenticators to
+	// verify the user.
+	WebAuthnUserVerificationDiscouraged = "discouraged"
+	// WebAuthnUserVerificationPreferred asks authenticators to verify the
+	// user if they can.
+	WebAuthnUserVerificationPreferred = "preferred"
+	// WebAuthnUserVerificationRequired only accepts authenticators verifying
+	// the user, such as security keys with a PIN or biometrics.
+	WebAuthnUserVerificationRequired = "required"
+)
+
+var validWebAuthnUserVerifications = []string{
+	WebAuthnUserVerificationDiscouraged,
+	WebAuthnUserVerificationPreferred,
+	WebAuthnUserVerificationRequired,
+}
+
 // MultiFactorWebAuthnSettings holds settings for
 // configuring WebAuthn Roaming or Platform.
 type MultiFactorWebAuthnSettings struct {
-	OverrideRelyingParty   *bool   `json:"overrideRelyingParty,omitempty"`
+	// Whether RelyingPartyIdentifier is used as the relying party, instead of
+	// the domain of the tenant.
+	OverrideRelyingParty *bool `json:"overrideRelyingParty,omitempty"`
+
+	// The relying party the credentials are scoped to, such as the
+	// registrable domain of a custom domain. Only used if
+	// OverrideRelyingParty is true.
 	RelyingPartyIdentifier *string `json:"relyingPartyIdentifier,omitempty"`
-	UserVerification       *string `json:"userVerification,omitempty"`
+
+	// Whether authenticators must verify the user. Can be one of
+	// "discouraged", "preferred" or "required".
+	UserVerification *string `json:"userVerification,omitempty"`
+}
+
+// Validate checks the settings against the values accepted by the Management
+// API. A nil error is returned if the settings are valid, otherwise the
+// returned error is of type ValidationErrors.
+func (s *MultiFactorWebAuthnSettings) Validate() error {
+	v := &validator{}
+
+	v.oneOf("userVerification", s.UserVerification, validWebAuthnUserVerifications)
+	if s.GetOverrideRelyingParty() && s.GetRelyingPartyIdentifier() == "" {
+		v.addError("relyingPartyIdentifier", "must be set when overrideRelyingParty is true")
+	}
+
+	return v.err()
 }
 
 // MultiFactorWebAuthnRoaming is used for WebAuthnRoaming MFA.
@@ -485,7 +572,7 @@ func (m *MultiFactorWebAuthnRoaming) Read(opts ...RequestOption) (s *MultiFactor
 //
 // See: https://auth0.com/docs/secure/multi-factor-authentication/fido-authentication-with-webauthn/configure-webauthn-security-keys-for-mfa
 func (m *MultiFactorWebAuthnRoaming) Update(s *MultiFactorWebAuthnSettings, opts ...RequestOption) error {
-	return m.Request("PUT", m.URI("guardian", "factors", "webauthn-roaming", "settings"), &s, opts...)
+	return m.Request("PUT", m.URI("guardian", "factors", "webauthn-roaming", "settings"), s, opts...)
 }
 
 // MultiFactorWebAuthnPlatform is used for WebAuthnPlatform MFA.
@@ -512,7 +599,7 @@ func (m *MultiFactorWebAuthnPlatform) Read(opts ...RequestOption) (s *MultiFacto
 //
 // See: https://auth0.com/docs/secure/multi-factor-authentication/fido-authentication-with-webauthn/configure-webauthn-device-biometrics-for-mfa
 func (m *MultiFactorWebAuthnPlatform) Update(s *MultiFactorWebAuthnSettings, opts ...RequestOption) error {
-	return m.Request("PUT", m.URI("guardian", "factors", "webauthn-platform", "settings"), &s, opts...)
+	return m.Request("PUT", m.URI("guardian", "factors", "webauthn-platform", "settings"), s, opts...)
 }
 
 // MultiFactorOTP is used for OTP MFA.
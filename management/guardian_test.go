@@ -1,6 +1,7 @@
 package management
 
 import (
+	"encoding/json"
 	"net/http"
 	"os"
 	"testing"
@@ -513,6 +514,35 @@ func TestGuardian(t *testing.T) {
 	})
 }
 
+func TestEnrollmentManager_SendTicketAndTicketURL(t *testing.T) {
+	var tickets []map[string]interface{}
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v2/guardian/enrollments/ticket", r.URL.Path)
+
+		var ticket map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&ticket))
+		tickets = append(tickets, ticket)
+
+		w.Write([]byte(`{"ticket_id":"ticket_1","ticket_url":"https://example.auth0.com/guardian/enroll?ticket=ticket_1"}`))
+	})
+	m := newTestManagement(t, h)
+
+	options := &CreateEnrollmentTicket{Email: "jane@example.com", EmailLocale: "fr", Factor: "otp"}
+	ticket, err := m.Guardian.Enrollment.SendTicket("auth0|1", options)
+	require.NoError(t, err)
+	assert.Equal(t, "ticket_1", ticket.TicketID)
+
+	url, err := m.Guardian.Enrollment.TicketURL("auth0|2", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.auth0.com/guardian/enroll?ticket=ticket_1", url)
+
+	assert.Equal(t, []map[string]interface{}{
+		{"user_id": "auth0|1", "email": "jane@example.com", "email_locale": "fr", "factor": "otp", "send_mail": true},
+		{"user_id": "auth0|2"},
+	}, tickets)
+	assert.Empty(t, options.UserID)
+}
+
 func getInitialMFAStatus(mfaName string) (bool, error) {
 	mfaList, err := api.Guardian.MultiFactor.List()
 	if err != nil {
@@ -542,3 +572,25 @@ func assertMFAIsEnabled(t *testing.T, mfaName string) {
 	}
 	assert.True(t, enabled)
 }
+
+func TestMultiFactorWebAuthnSettings_Validate(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	m := newTestManagement(t, h, WithValidation())
+
+	assert.NoError(t, (&MultiFactorWebAuthnSettings{
+		OverrideRelyingParty:   auth0.Bool(true),
+		RelyingPartyIdentifier: auth0.String("example.com"),
+		UserVerification:       auth0.String(WebAuthnUserVerificationRequired),
+	}).Validate())
+
+	err := m.Guardian.MultiFactor.WebAuthnRoaming.Update(&MultiFactorWebAuthnSettings{
+		OverrideRelyingParty: auth0.Bool(true),
+		UserVerification:     auth0.String("always"),
+	})
+	var validationErrs ValidationErrors
+	require.ErrorAs(t, err, &validationErrs)
+	assert.EqualError(t, validationErrs, `invalid userVerification: "always" is not one of ["discouraged" "preferred" "required"]; `+
+		`invalid relyingPartyIdentifier: must be set when overrideRelyingParty is true`)
+}
@@ -152,7 +152,7 @@ func redactSensitiveDataInSigningKey(t *testing.T, i *cassette.Interaction) {
 	if isSigningKeysURL && strings.Contains(i.Request.URL, "/revoke") && i.Request.Method == http.MethodPut {
 		i.Request.URL = "https://" + domain + "/api/v2/keys/signing/111111111111111111111/revoke"
 
-		signingKey.RevokedAt = auth0.Time(time.Now())
+		signingKey.RevokedAt = &Timestamp{time.Now()}
 		signingKeyBody, err := json.Marshal(signingKey)
 		require.NoError(t, err)
 
@@ -2,12 +2,18 @@ package management
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
 	"strconv"
+	"sync"
 	"time"
+
+	"github.com/auth0/go-auth0/internal/client"
 )
 
 // Job is used for importing/exporting users or for
@@ -22,7 +28,7 @@ type Job struct {
 	// The type of job.
 	Type *string `json:"type,omitempty"`
 	// The date when the job was created.
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
 	// The user_id of the user to whom the email will be sent.
 	UserID *string `json:"user_id,omitempty"`
 	// The ID of the client, if not provided the global one will be used.
@@ -157,6 +163,105 @@ func (m *JobManager) ImportUsers(j *Job, opts ...RequestOption) error {
 	var payload bytes.Buffer
 	mp := multipart.NewWriter(&payload)
 
+	var writeUsers func(io.Writer) error
+	if j.Users != nil {
+		usersJSON, err := json.Marshal(j.Users)
+		if err != nil {
+			return err
+		}
+
+		writeUsers = func(w io.Writer) error {
+			_, err := w.Write(usersJSON)
+			return err
+		}
+	}
+
+	if err := writeImportPayload(mp, j, writeUsers); err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest("POST", m.URI("jobs", "users-imports"), &payload)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Content-Type", mp.FormDataContentType())
+
+	return m.sendImport(request, j, opts...)
+}
+
+// ImportUsersFromReader imports users into a connection via a long-running
+// job like ImportUsers, with the users file read from users rather than
+// marshaled from j.Users.
+//
+// The users file is streamed while it's uploaded rather than held in memory,
+// so that large files can be imported. When the request is rate limited it is
+// only sent again if users is an io.Seeker, such as an *os.File, whose
+// position is reset to read the file again. Otherwise the error is returned.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Jobs/post_users_imports
+func (m *JobManager) ImportUsersFromReader(j *Job, users io.Reader, opts ...RequestOption) error {
+	writeUsers := func(w io.Writer) error {
+		_, err := io.Copy(w, users)
+		return err
+	}
+
+	// Every copy of the payload uses the same boundary, so that they all
+	// match the content type of the request.
+	contentType := multipart.NewWriter(io.Discard)
+	boundary := contentType.Boundary()
+
+	var (
+		current io.Closer
+		written chan struct{}
+	)
+	newBody := func() io.ReadCloser {
+		pr, pw := io.Pipe()
+		current, written = pr, make(chan struct{})
+		go func(written chan struct{}) {
+			defer close(written)
+
+			mp := multipart.NewWriter(pw)
+			if err := mp.SetBoundary(boundary); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			pw.CloseWithError(writeImportPayload(mp, j, writeUsers))
+		}(written)
+		return pr
+	}
+
+	var getBody func() (io.ReadCloser, error)
+	if seeker, ok := users.(io.Seeker); ok {
+		offset, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+
+		getBody = func() (io.ReadCloser, error) {
+			// Stop writing the previous copy before reading the file again.
+			current.Close()
+			<-written
+
+			if _, err := seeker.Seek(offset, io.SeekStart); err != nil {
+				return nil, err
+			}
+			return newBody(), nil
+		}
+	}
+
+	request, err := http.NewRequest("POST", m.URI("jobs", "users-imports"), client.StreamingBody(newBody()))
+	if err != nil {
+		return err
+	}
+	request.GetBody = getBody
+	request.Header.Add("Content-Type", contentType.FormDataContentType())
+
+	return m.sendImport(request, j, opts...)
+}
+
+// writeImportPayload writes the multipart payload of an import job, with the
+// users file written by writeUsers, if not nil.
+func writeImportPayload(mp *multipart.Writer, j *Job, writeUsers func(io.Writer) error) error {
 	if err := mp.WriteField("connection_id", j.GetConnectionID()); err != nil {
 		return err
 	}
@@ -170,12 +275,7 @@ func (m *JobManager) ImportUsers(j *Job, opts ...RequestOption) error {
 		return err
 	}
 
-	if j.Users != nil {
-		usersJSON, err := json.Marshal(j.Users)
-		if err != nil {
-			return err
-		}
-
+	if writeUsers != nil {
 		header := textproto.MIMEHeader{}
 		header.Set("Content-Disposition", `form-data; name="users"; filename="users.json"`)
 		header.Set("Content-Type", "application/json")
@@ -185,20 +285,17 @@ func (m *JobManager) ImportUsers(j *Job, opts ...RequestOption) error {
 			return err
 		}
 
-		if _, err := writer.Write(usersJSON); err != nil {
+		if err := writeUsers(writer); err != nil {
 			return err
 		}
 	}
-	if err := mp.Close(); err != nil {
-		return err
-	}
 
-	request, err := http.NewRequest("POST", m.URI("jobs", "users-imports"), &payload)
-	if err != nil {
-		return err
-	}
-	request.Header.Add("Content-Type", mp.FormDataContentType())
+	return mp.Close()
+}
 
+// sendImport sends the request creating an import job, decoding the created
+// job into j.
+func (m *JobManager) sendImport(request *http.Request, j *Job, opts ...RequestOption) error {
 	for _, option := range opts {
 		option.apply(request)
 	}
@@ -219,3 +316,320 @@ func (m *JobManager) ImportUsers(j *Job, opts ...RequestOption) error {
 
 	return nil
 }
+
+const (
+	// DefaultUserImportMaxFileSize is the maximum size in bytes of the users
+	// file of an import job accepted by the Management API.
+	DefaultUserImportMaxFileSize = 500 * 1024
+
+	// DefaultUserImportPollInterval is the time waited between two checks of
+	// the status of an import job.
+	DefaultUserImportPollInterval = 5 * time.Second
+)
+
+// UserImporter imports users in bulk into a connection, splitting them into
+// as many import jobs as needed for the users file of each job to stay under
+// the size limit of the Management API.
+//
+// A UserImporter is not safe for concurrent use.
+type UserImporter struct {
+	// MaxFileSize is the maximum size in bytes of the users file of a job.
+	// Defaults to DefaultUserImportMaxFileSize.
+	MaxFileSize int
+
+	// PollInterval is the time waited between two checks of the status of
+	// the jobs in Wait. Defaults to DefaultUserImportPollInterval.
+	PollInterval time.Duration
+
+	// Concurrency is the maximum number of jobs being created at the same
+	// time, the users files being uploaded in the background while more
+	// users are added. Defaults to 1, jobs being created as users are added.
+	//
+	// Note that the Management API limits the number of import jobs running
+	// at the same time in a tenant.
+	Concurrency int
+
+	manager *JobManager
+	job     Job
+	opts    []RequestOption
+	users   []map[string]interface{}
+	size    int
+
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	slots   chan struct{}
+	jobs    []*Job
+	created int
+	err     error
+}
+
+// NewUserImporter returns a UserImporter creating jobs with the same settings
+// as j, such as its ConnectionID and Upsert fields. The users of j are
+// ignored.
+//
+// The options are applied to every request made.
+func (m *JobManager) NewUserImporter(j *Job, opts ...RequestOption) *UserImporter {
+	job := *j
+	job.Users = nil
+
+	return &UserImporter{
+		MaxFileSize:  DefaultUserImportMaxFileSize,
+		PollInterval: DefaultUserImportPollInterval,
+		manager:      m,
+		job:          job,
+		opts:         opts,
+	}
+}
+
+// Add adds a user to import. The users added so far are imported in a new job
+// when adding this one would exceed the size limit of the users file.
+func (i *UserImporter) Add(user map[string]interface{}) error {
+	b, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+
+	// The users file is a JSON array, so each user is followed by either a
+	// comma or the closing bracket, and the file starts with an opening one.
+	size := len(b) + 1
+	if 1+size > i.MaxFileSize {
+		return fmt.Errorf("user is %d bytes, which exceeds the maximum file size of %d bytes", len(b), i.MaxFileSize)
+	}
+
+	if 1+i.size+size > i.MaxFileSize {
+		if err := i.Flush(); err != nil {
+			return err
+		}
+	}
+
+	i.users = append(i.users, user)
+	i.size += size
+
+	return nil
+}
+
+// AddFromChannel adds every user received from the channel until it is
+// closed, then imports the remaining users.
+func (i *UserImporter) AddFromChannel(users <-chan map[string]interface{}) error {
+	for user := range users {
+		if err := i.Add(user); err != nil {
+			return err
+		}
+	}
+
+	return i.Flush()
+}
+
+// AddFromReader adds every user of the users file read from r, which is a
+// JSON array of users, then imports the remaining users. The file is decoded
+// as it's read, so that files exceeding the size limit of the Management API
+// can be imported in several jobs without being held in memory.
+func (i *UserImporter) AddFromReader(r io.Reader) error {
+	decoder := json.NewDecoder(r)
+
+	if token, err := decoder.Token(); err != nil {
+		return fmt.Errorf("failed to decode the users file: %w", err)
+	} else if token != json.Delim('[') {
+		return fmt.Errorf("failed to decode the users file: expected an array of users")
+	}
+
+	for decoder.More() {
+		var user map[string]interface{}
+		if err := decoder.Decode(&user); err != nil {
+			return fmt.Errorf("failed to decode the users file: %w", err)
+		}
+		if err := i.Add(user); err != nil {
+			return err
+		}
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return fmt.Errorf("failed to decode the users file: %w", err)
+	}
+
+	return i.Flush()
+}
+
+// Flush imports the users added since the last job was created, if any.
+//
+// When Concurrency is greater than 1, the job is created in the background
+// and an error creating a previous job is returned, if any.
+func (i *UserImporter) Flush() error {
+	if err := i.firstErr(); err != nil {
+		return err
+	}
+	if len(i.users) == 0 {
+		return nil
+	}
+
+	job := i.job
+	job.Users = i.users
+	i.users = nil
+	i.size = 0
+
+	i.mu.Lock()
+	n := len(i.jobs)
+	i.jobs = append(i.jobs, nil)
+	i.mu.Unlock()
+
+	if i.Concurrency <= 1 {
+		return i.create(n, &job)
+	}
+
+	if i.slots == nil {
+		i.slots = make(chan struct{}, i.Concurrency)
+	}
+	i.slots <- struct{}{}
+
+	i.wg.Add(1)
+	go func() {
+		defer func() {
+			<-i.slots
+			i.wg.Done()
+		}()
+		_ = i.create(n, &job)
+	}()
+
+	return nil
+}
+
+// create creates the nth import job, recording the error if it fails.
+func (i *UserImporter) create(n int, job *Job) error {
+	err := i.manager.ImportUsers(job, i.opts...)
+	job.Users = nil
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if err != nil {
+		if i.err == nil {
+			i.err = err
+		}
+		return err
+	}
+
+	i.jobs[n] = job
+	i.created++
+
+	return nil
+}
+
+func (i *UserImporter) firstErr() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.err
+}
+
+// Jobs returns the import jobs created so far.
+func (i *UserImporter) Jobs() []*Job {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	jobs := make([]*Job, 0, i.created)
+	for _, job := range i.jobs {
+		if job != nil {
+			jobs = append(jobs, job)
+		}
+	}
+
+	return jobs
+}
+
+// Wait imports the remaining users, then waits for every import job to
+// either complete or fail and returns their final state. The jobs are read
+// with ctx, unless a Context option was passed to NewUserImporter.
+func (i *UserImporter) Wait(ctx context.Context) ([]*Job, error) {
+	if err := i.Flush(); err != nil {
+		return nil, err
+	}
+
+	i.wg.Wait()
+	if err := i.firstErr(); err != nil {
+		return nil, err
+	}
+
+	for n, job := range i.jobs {
+		for job.GetStatus() == "pending" || job.GetStatus() == "processing" {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-i.manager.clock.After(i.PollInterval):
+			}
+
+			var err error
+			job, err = i.manager.Read(job.GetID(), withContext(ctx, i.opts)...)
+			if err != nil {
+				return nil, err
+			}
+		}
+		i.jobs[n] = job
+	}
+
+	return i.jobs, nil
+}
+
+// UserImportResult aggregates the outcome of the import jobs of a
+// UserImporter.
+type UserImportResult struct {
+	// Jobs are the import jobs in their final state.
+	Jobs []*Job
+
+	// Summary totals the summaries of the jobs.
+	Summary JobSummary
+
+	// Errors are the users which failed to be imported by any of the jobs,
+	// with the reasons why.
+	Errors []JobError
+}
+
+// Succeeded returns true if every job completed and imported every user.
+func (r *UserImportResult) Succeeded() bool {
+	for _, job := range r.Jobs {
+		if job.GetStatus() != "completed" {
+			return false
+		}
+	}
+
+	return r.Summary.GetFailed() == 0 && len(r.Errors) == 0
+}
+
+// Result imports the remaining users, waits for every import job to either
+// complete or fail like Wait, then aggregates their summaries and the users
+// they failed to import.
+func (i *UserImporter) Result(ctx context.Context) (*UserImportResult, error) {
+	jobs, err := i.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UserImportResult{Jobs: jobs}
+
+	var failed, updated, inserted, total int
+	for _, job := range jobs {
+		summary := job.GetSummary()
+		failed += summary.GetFailed()
+		updated += summary.GetUpdated()
+		inserted += summary.GetInserted()
+		total += summary.GetTotal()
+
+		if job.GetStatus() != "failed" && summary.GetFailed() == 0 {
+			continue
+		}
+
+		jobErrors, err := i.manager.ReadErrors(job.GetID(), withContext(ctx, i.opts)...)
+		if err != nil {
+			return nil, err
+		}
+		result.Errors = append(result.Errors, jobErrors...)
+	}
+
+	result.Summary = JobSummary{
+		Failed:   &failed,
+		Updated:  &updated,
+		Inserted: &inserted,
+		Total:    &total,
+	}
+
+	return result, nil
+}
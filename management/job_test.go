@@ -1,6 +1,15 @@
 package management
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -130,3 +139,199 @@ func TestJobManager_ReadErrors(t *testing.T) {
 	assert.Len(t, actualJobErrors, 1)
 	assert.Equal(t, expectedJobErrors, actualJobErrors[0])
 }
+
+func TestUserImporter(t *testing.T) {
+	var files [][]map[string]interface{}
+	var reads int
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/jobs/users-imports":
+			assert.Equal(t, "con_123", r.FormValue("connection_id"))
+			assert.Equal(t, "true", r.FormValue("upsert"))
+
+			f, _, err := r.FormFile("users")
+			require.NoError(t, err)
+			b, err := io.ReadAll(f)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(b), 100)
+
+			var users []map[string]interface{}
+			require.NoError(t, json.Unmarshal(b, &users))
+			files = append(files, users)
+
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id":"job_%d","status":"pending","type":"users_import"}`, len(files))
+		case r.Method == http.MethodGet:
+			reads++
+			status := "processing"
+			if reads > 1 {
+				status = "completed"
+			}
+			fmt.Fprintf(w, `{"id":%q,"status":%q,"type":"users_import"}`, r.URL.Path[len("/api/v2/jobs/"):], status)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	// The options of the caller are left untouched, even with spare capacity.
+	opts := make([]RequestOption, 1, 4)
+	opts[0] = Header("X-Test", "import")
+
+	importer := m.Job.NewUserImporter(&Job{
+		ConnectionID: auth0.String("con_123"),
+		Upsert:       auth0.Bool(true),
+	}, opts...)
+	importer.MaxFileSize = 100
+	importer.PollInterval = time.Millisecond
+
+	users := make(chan map[string]interface{})
+	go func() {
+		defer close(users)
+		for i := 0; i < 5; i++ {
+			users <- map[string]interface{}{"email": fmt.Sprintf("user%d@example.com", i)}
+		}
+	}()
+
+	// Each user is 29 bytes, so 3 users fit in a file of 100 bytes.
+	err := importer.AddFromChannel(users)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	assert.Len(t, files[0], 3)
+	assert.Len(t, files[1], 2)
+
+	jobs, err := importer.Wait(context.Background())
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, "job_1", jobs[0].GetID())
+	assert.Equal(t, "completed", jobs[0].GetStatus())
+	assert.Equal(t, "job_2", jobs[1].GetID())
+	assert.Equal(t, "completed", jobs[1].GetStatus())
+	assert.Equal(t, []RequestOption{nil, nil, nil}, opts[1:cap(opts)])
+
+	err = importer.Add(map[string]interface{}{"email": fmt.Sprintf("%0100d@example.com", 0)})
+	assert.EqualError(t, err, "user is 124 bytes, which exceeds the maximum file size of 100 bytes")
+}
+
+func TestJobManager_ImportUsersFromReader(t *testing.T) {
+	const usersFile = `[{"email":"user1@example.com"},{"email":"user2@example.com"}]`
+
+	newHandler := func(rateLimited int) (http.Handler, *[]string) {
+		var uploads []string
+		h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v2/jobs/users-imports", r.URL.Path)
+			assert.Equal(t, int64(-1), r.ContentLength)
+
+			file, _, err := r.FormFile("users")
+			require.NoError(t, err)
+			users, err := io.ReadAll(file)
+			require.NoError(t, err)
+			uploads = append(uploads, string(users))
+			assert.Equal(t, "con_123", r.FormValue("connection_id"))
+
+			if len(uploads) <= rateLimited {
+				w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Unix(), 10))
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"statusCode":429,"error":"Too Many Requests","message":"Global limit has been reached"}`))
+				return
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"job_123","status":"pending","type":"users_import"}`))
+		})
+		return h, &uploads
+	}
+
+	t.Run("seekable", func(t *testing.T) {
+		h, uploads := newHandler(1)
+		m := newTestManagement(t, h)
+
+		job := &Job{ConnectionID: auth0.String("con_123")}
+		err := m.Job.ImportUsersFromReader(job, strings.NewReader(usersFile))
+		require.NoError(t, err)
+
+		assert.Equal(t, "job_123", job.GetID())
+		assert.Equal(t, []string{usersFile, usersFile}, *uploads)
+	})
+
+	t.Run("not seekable", func(t *testing.T) {
+		h, uploads := newHandler(1)
+		m := newTestManagement(t, h)
+
+		job := &Job{ConnectionID: auth0.String("con_123")}
+		err := m.Job.ImportUsersFromReader(job, io.MultiReader(strings.NewReader(usersFile)))
+
+		var managementErr Error
+		require.True(t, errors.As(err, &managementErr))
+		assert.Equal(t, http.StatusTooManyRequests, managementErr.Status())
+		assert.Equal(t, []string{usersFile}, *uploads)
+	})
+}
+
+func TestUserImporter_Result(t *testing.T) {
+	var inFlight, maxInFlight int32
+	var files int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/jobs/users-imports":
+			current := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				highest := atomic.LoadInt32(&maxInFlight)
+				if current <= highest || atomic.CompareAndSwapInt32(&maxInFlight, highest, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id":"job_%d","status":"pending","type":"users_import"}`, atomic.AddInt32(&files, 1))
+		case r.URL.Path == "/api/v2/jobs/job_1/errors":
+			w.Write([]byte(`[{"user":{"email":"user0@example.com"},"errors":[{"code":"DUPLICATED_USER","message":"The user already exists."}]}]`))
+		case r.Method == http.MethodGet:
+			id := strings.TrimPrefix(r.URL.Path, "/api/v2/jobs/")
+			failed := 0
+			if id == "job_1" {
+				failed = 1
+			}
+			fmt.Fprintf(w, `{"id":%q,"status":"completed","type":"users_import","summary":{"failed":%d,"updated":0,"inserted":%d,"total":3}}`, id, failed, 3-failed)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	importer := m.Job.NewUserImporter(&Job{ConnectionID: auth0.String("con_123")})
+	importer.MaxFileSize = 100
+	importer.PollInterval = time.Millisecond
+	importer.Concurrency = 2
+
+	var usersFile strings.Builder
+	usersFile.WriteString("[")
+	for i := 0; i < 9; i++ {
+		if i > 0 {
+			usersFile.WriteString(",")
+		}
+		fmt.Fprintf(&usersFile, `{"email":"user%d@example.com"}`, i)
+	}
+	usersFile.WriteString("]")
+
+	// Each user is 29 bytes, so the 9 users are split into 3 jobs.
+	err := importer.AddFromReader(strings.NewReader(usersFile.String()))
+	require.NoError(t, err)
+
+	result, err := importer.Result(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, result.Jobs, 3)
+	assert.Equal(t, int32(2), maxInFlight)
+	assert.Equal(t, 9, result.Summary.GetTotal())
+	assert.Equal(t, 8, result.Summary.GetInserted())
+	assert.Equal(t, 1, result.Summary.GetFailed())
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "DUPLICATED_USER", result.Errors[0].Errors[0].Code)
+	assert.False(t, result.Succeeded())
+
+	err = importer.AddFromReader(strings.NewReader(`{"email":"user@example.com"}`))
+	assert.EqualError(t, err, "failed to decode the users file: expected an array of users")
+}
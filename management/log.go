@@ -4,62 +4,127 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
+)
+
+// Types of log events.
+//
+// See: https://auth0.com/docs/deploy-monitor/logs/log-event-type-codes
+const (
+	LogTypeSuccessLogin                     = "s"
+	LogTypeSuccessSilentAuth                = "ssa"
+	LogTypeFailedSilentAuth                 = "fsa"
+	LogTypeSuccessExchangeAuthorizationCode = "seacft"
+	LogTypeFailedExchangeAuthorizationCode  = "feacft"
+	LogTypeSuccessExchangeClientCredentials = "seccft"
+	LogTypeFailedExchangeClientCredentials  = "feccft"
+	LogTypeSuccessExchangePassword          = "sepft"
+	LogTypeFailedExchangePassword           = "fepft"
+	LogTypeFailedLogin                      = "f"
+	LogTypeWarningsDuringLogin              = "w"
+	LogTypeDeletedUser                      = "du"
+	LogTypeFailedLoginInvalidUsername       = "fu"
+	LogTypeFailedLoginWrongPassword         = "fp"
+	LogTypeFailedByConnector                = "fc"
+	LogTypeFailedByCORS                     = "fco"
+	LogTypeConnectorOnline                  = "con"
+	LogTypeConnectorOffline                 = "coff"
+	LogTypeFailedConnectorProvisioning      = "fcpro"
+	LogTypeSuccessSignup                    = "ss"
+	LogTypeFailedSignup                     = "fs"
+	LogTypeCodeSent                         = "cs"
+	LogTypeCodeLinkSent                     = "cls"
+	LogTypeSuccessVerificationEmail         = "sv"
+	LogTypeFailedVerificationEmail          = "fv"
+	LogTypeSuccessChangePassword            = "scp"
+	LogTypeFailedChangePassword             = "fcp"
+	LogTypeSuccessChangeEmail               = "sce"
+	LogTypeFailedChangeEmail                = "fce"
+	LogTypeSuccessChangeUsername            = "scu"
+	LogTypeFailedChangeUsername             = "fcu"
+	LogTypeSuccessChangePhoneNumber         = "scpn"
+	LogTypeFailedChangePhoneNumber          = "fcpn"
+	LogTypeSuccessVerificationEmailRequest  = "svr"
+	LogTypeFailedVerificationEmailRequest   = "fvr"
+	LogTypeSuccessChangePasswordRequest     = "scpr"
+	LogTypeFailedChangePasswordRequest      = "fcpr"
+	LogTypeFailedSendingNotification        = "fn"
+	LogTypeAPIOperation                     = "sapi"
+	LogTypeFailedAPIOperation               = "fapi"
+	LogTypeManagementAPIReadOperation       = "mgmt_api_read"
+	LogTypeBlockedAccount                   = "limit_wc"
+	LogTypeBlockedIPAddress                 = "limit_mu"
+	LogTypeTooManyCallsToUserinfo           = "limit_ui"
+	LogTypeRateLimitOnAPI                   = "api_limit"
+	LogTypeBreachedPassword                 = "pwd_leak"
+	LogTypeUserLoginBlockReleased           = "ublkdu"
+	LogTypeSuccessUserDeletion              = "sdu"
+	LogTypeFailedUserDeletion               = "fdu"
+	LogTypeSuccessLogout                    = "slo"
+	LogTypeFailedLogout                     = "flo"
+	LogTypeSuccessDelegation                = "sd"
+	LogTypeFailedDelegation                 = "fd"
+	LogTypeFailedCrossOriginAuthentication  = "fcoa"
+	LogTypeSuccessCrossOriginAuthentication = "scoa"
+	LogTypeDeprecationNotice                = "depnote"
 )
 
 var logTypeName = map[string]string{
-	"s":         "Success Login",
-	"ssa":       "Success Silent Auth",
-	"fsa":       "Failed Silent Auth",
-	"seacft":    "Success Exchange (Authorization Code for Access Token)",
-	"feacft":    "Failed Exchange (Authorization Code for Access Token)",
-	"seccft":    "Success Exchange (Client Credentials for Access Token)",
-	"feccft":    "Failed Exchange (Client Credentials for Access Token)",
-	"sepft":     "Success Exchange (Password for Access Token)",
-	"fepft":     "Failed Exchange (Password for Access Token)",
-	"f":         "Failed Login",
-	"w":         "Warnings During Login",
-	"du":        "Deleted User",
-	"fu":        "Failed Login (invalid email/username)",
-	"fp":        "Failed Login (wrong password)",
-	"fc":        "Failed by Connector",
-	"fco":       "Failed by CORS",
-	"con":       "Connector Online",
-	"coff":      "Connector Offline",
-	"fcpro":     "Failed Connector Provisioning",
-	"ss":        "Success Signup",
-	"fs":        "Failed Signup",
-	"cs":        "Code Sent",
-	"cls":       "Code/Link Sent",
-	"sv":        "Success Verification Email",
-	"fv":        "Failed Verification Email",
-	"scp":       "Success Change Password",
-	"fcp":       "Failed Change Password",
-	"sce":       "Success Change Email",
-	"fce":       "Failed Change Email",
-	"scu":       "Success Change Username",
-	"fcu":       "Failed Change Username",
-	"scpn":      "Success Change Phone Number",
-	"fcpn":      "Failed Change Phone Number",
-	"svr":       "Success Verification Email Request",
-	"fvr":       "Failed Verification Email Request",
-	"scpr":      "Success Change Password Request",
-	"fcpr":      "Failed Change Password Request",
-	"fn":        "Failed Sending Notification",
-	"sapi":      "API Operation",
-	"fapi":      "Failed API Operation",
-	"limit_wc":  "Blocked Account",
-	"limit_mu":  "Blocked IP Address",
-	"limit_ui":  "Too Many Calls to /userinfo",
-	"api_limit": "Rate Limit On API",
-	"sdu":       "Successful User Deletion",
-	"fdu":       "Failed User Deletion",
-	"slo":       "Success Logout",
-	"flo":       "Failed Logout",
-	"sd":        "Success Delegation",
-	"fd":        "Failed Delegation",
-	"fcoa":      "Failed Cross Origin Authentication",
-	"scoa":      "Success Cross Origin Authentication",
+	LogTypeSuccessLogin:                     "Success Login",
+	LogTypeSuccessSilentAuth:                "Success Silent Auth",
+	LogTypeFailedSilentAuth:                 "Failed Silent Auth",
+	LogTypeSuccessExchangeAuthorizationCode: "Success Exchange (Authorization Code for Access Token)",
+	LogTypeFailedExchangeAuthorizationCode:  "Failed Exchange (Authorization Code for Access Token)",
+	LogTypeSuccessExchangeClientCredentials: "Success Exchange (Client Credentials for Access Token)",
+	LogTypeFailedExchangeClientCredentials:  "Failed Exchange (Client Credentials for Access Token)",
+	LogTypeSuccessExchangePassword:          "Success Exchange (Password for Access Token)",
+	LogTypeFailedExchangePassword:           "Failed Exchange (Password for Access Token)",
+	LogTypeFailedLogin:                      "Failed Login",
+	LogTypeWarningsDuringLogin:              "Warnings During Login",
+	LogTypeDeletedUser:                      "Deleted User",
+	LogTypeFailedLoginInvalidUsername:       "Failed Login (invalid email/username)",
+	LogTypeFailedLoginWrongPassword:         "Failed Login (wrong password)",
+	LogTypeFailedByConnector:                "Failed by Connector",
+	LogTypeFailedByCORS:                     "Failed by CORS",
+	LogTypeConnectorOnline:                  "Connector Online",
+	LogTypeConnectorOffline:                 "Connector Offline",
+	LogTypeFailedConnectorProvisioning:      "Failed Connector Provisioning",
+	LogTypeSuccessSignup:                    "Success Signup",
+	LogTypeFailedSignup:                     "Failed Signup",
+	LogTypeCodeSent:                         "Code Sent",
+	LogTypeCodeLinkSent:                     "Code/Link Sent",
+	LogTypeSuccessVerificationEmail:         "Success Verification Email",
+	LogTypeFailedVerificationEmail:          "Failed Verification Email",
+	LogTypeSuccessChangePassword:            "Success Change Password",
+	LogTypeFailedChangePassword:             "Failed Change Password",
+	LogTypeSuccessChangeEmail:               "Success Change Email",
+	LogTypeFailedChangeEmail:                "Failed Change Email",
+	LogTypeSuccessChangeUsername:            "Success Change Username",
+	LogTypeFailedChangeUsername:             "Failed Change Username",
+	LogTypeSuccessChangePhoneNumber:         "Success Change Phone Number",
+	LogTypeFailedChangePhoneNumber:          "Failed Change Phone Number",
+	LogTypeSuccessVerificationEmailRequest:  "Success Verification Email Request",
+	LogTypeFailedVerificationEmailRequest:   "Failed Verification Email Request",
+	LogTypeSuccessChangePasswordRequest:     "Success Change Password Request",
+	LogTypeFailedChangePasswordRequest:      "Failed Change Password Request",
+	LogTypeFailedSendingNotification:        "Failed Sending Notification",
+	LogTypeAPIOperation:                     "API Operation",
+	LogTypeFailedAPIOperation:               "Failed API Operation",
+	LogTypeManagementAPIReadOperation:       "Management API Read Operation",
+	LogTypeBlockedAccount:                   "Blocked Account",
+	LogTypeBlockedIPAddress:                 "Blocked IP Address",
+	LogTypeTooManyCallsToUserinfo:           "Too Many Calls to /userinfo",
+	LogTypeRateLimitOnAPI:                   "Rate Limit On API",
+	LogTypeBreachedPassword:                 "Breached Password",
+	LogTypeUserLoginBlockReleased:           "User Login Block Released",
+	LogTypeSuccessUserDeletion:              "Successful User Deletion",
+	LogTypeFailedUserDeletion:               "Failed User Deletion",
+	LogTypeSuccessLogout:                    "Success Logout",
+	LogTypeFailedLogout:                     "Failed Logout",
+	LogTypeSuccessDelegation:                "Success Delegation",
+	LogTypeFailedDelegation:                 "Failed Delegation",
+	LogTypeFailedCrossOriginAuthentication:  "Failed Cross Origin Authentication",
+	LogTypeSuccessCrossOriginAuthentication: "Success Cross Origin Authentication",
+	LogTypeDeprecationNotice:                "Deprecation Notice",
 }
 
 // Log for analyzing business needs.
@@ -72,7 +137,7 @@ type Log struct {
 	LogID *string `json:"log_id"`
 
 	// The date when the log event was created.
-	Date *time.Time `json:"date"`
+	Date *Timestamp `json:"date"`
 
 	// The log event type.
 	Type *string `json:"type"`
@@ -133,17 +198,201 @@ type Log struct {
 
 	// Information about the location that triggered this event based on the `ip`.
 	LocationInfo map[string]interface{} `json:"location_info"`
+
+	// Location holds the same information as LocationInfo, decoded into a
+	// LogLocationInfo.
+	Location *LogLocationInfo `json:"-"`
+}
+
+// LogLocationInfo holds information about the location that triggered a log
+// event, based on its IP address.
+type LogLocationInfo struct {
+	// Two-letter country code.
+	CountryCode *string `json:"country_code,omitempty"`
+
+	// Three-letter country code.
+	CountryCode3 *string `json:"country_code3,omitempty"`
+
+	// Full country name in English.
+	CountryName *string `json:"country_name,omitempty"`
+
+	// Full city name in English.
+	CityName *string `json:"city_name,omitempty"`
+
+	// Global latitude (horizontal) position.
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Global longitude (vertical) position.
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Time zone name of the location.
+	TimeZone *string `json:"time_zone,omitempty"`
+
+	// Continent the country is located within.
+	ContinentCode *string `json:"continent_code,omitempty"`
+}
+
+// LogLoginDetails holds the details of the login events, such as "s", "ssa"
+// or "sepft", which can be decoded with Log.DecodeDetails.
+type LogLoginDetails struct {
+	// The prompts shown to the user during the login transaction.
+	Prompts []*LogPrompt `json:"prompts,omitempty"`
+
+	// Time the login transaction started, in milliseconds since the epoch.
+	InitiatedAt *int64 `json:"initiatedAt,omitempty"`
+
+	// Time the login transaction completed, in milliseconds since the epoch.
+	CompletedAt *int64 `json:"completedAt,omitempty"`
+
+	// Duration of the login transaction, in milliseconds.
+	ElapsedTime *int64 `json:"elapsedTime,omitempty"`
+
+	// ID of the session the user logged into.
+	SessionID *string `json:"session_id,omitempty"`
+
+	// Statistics about the user at the time of the login.
+	Stats *LogLoginStats `json:"stats,omitempty"`
+}
+
+// LogPrompt is a prompt shown to the user during a login transaction.
+type LogPrompt struct {
+	// Name of the prompt, such as "lock-password-authenticate" or "login".
+	Name *string `json:"name,omitempty"`
+
+	// Flow the prompt is part of, such as "login" or "signup".
+	Flow *string `json:"flow,omitempty"`
+
+	// Time the prompt was shown, in milliseconds since the epoch.
+	InitiatedAt *int64 `json:"initiatedAt,omitempty"`
+
+	// Time the prompt was completed, in milliseconds since the epoch.
+	CompletedAt *int64 `json:"completedAt,omitempty"`
+
+	// Duration of the prompt, in milliseconds.
+	ElapsedTime *int64 `json:"elapsedTime,omitempty"`
+
+	// ID of the connection the user authenticated with.
+	ConnectionID *string `json:"connection_id,omitempty"`
+
+	// Strategy of the connection the user authenticated with.
+	Strategy *string `json:"strategy,omitempty"`
+
+	// Identity of the user the prompt was completed for.
+	Identity *string `json:"identity,omitempty"`
+
+	// ID of the user of the session, when one already existed.
+	SessionUser *string `json:"session_user,omitempty"`
+}
+
+// LogLoginStats holds statistics about a user at the time of a login.
+type LogLoginStats struct {
+	// Number of times the user has logged in.
+	LoginsCount *int `json:"loginsCount,omitempty"`
+}
+
+// LogErrorDetails holds the details of the failure events, such as "f",
+// "fp" or "feacft", which can be decoded with Log.DecodeDetails.
+type LogErrorDetails struct {
+	// The error that caused the failure.
+	Error *LogError `json:"error,omitempty"`
+}
+
+// LogError is the error that caused a failure event.
+type LogError struct {
+	// Description of the error.
+	Message *string `json:"message,omitempty"`
+
+	// OAuth error code, such as "access_denied".
+	OAuthError *string `json:"oauthError,omitempty"`
+
+	// Type of the error, such as "request-error".
+	Type *string `json:"type,omitempty"`
+}
+
+// LogAPIOperationDetails holds the details of the Management API operation
+// events, such as "sapi" or "fapi", which can be decoded with
+// Log.DecodeDetails.
+type LogAPIOperationDetails struct {
+	// The request made to the Management API.
+	Request *LogAPIRequest `json:"request,omitempty"`
+
+	// The response sent by the Management API.
+	Response *LogAPIResponse `json:"response,omitempty"`
+}
+
+// LogAPIRequest is a request made to the Management API.
+type LogAPIRequest struct {
+	// HTTP method of the request.
+	Method *string `json:"method,omitempty"`
+
+	// Path of the request.
+	Path *string `json:"path,omitempty"`
+
+	// Query parameters of the request.
+	Query map[string]interface{} `json:"query,omitempty"`
+
+	// Body of the request.
+	Body interface{} `json:"body,omitempty"`
+
+	// Channel the request was made from, such as "api" or "https://manage.auth0.com/".
+	Channel *string `json:"channel,omitempty"`
+
+	// IP address the request was made from.
+	IP *string `json:"ip,omitempty"`
+
+	// Authentication used for the request.
+	Auth *LogAPIRequestAuth `json:"auth,omitempty"`
+}
+
+// LogAPIRequestAuth is the authentication used for a request made to the
+// Management API.
+type LogAPIRequestAuth struct {
+	// Strategy used to authenticate the request, such as "jwt".
+	Strategy *string `json:"strategy,omitempty"`
+
+	// User that made the request, when made from the Dashboard.
+	User *LogAPIRequestUser `json:"user,omitempty"`
+
+	// Credentials used to authenticate the request.
+	Credentials *LogAPIRequestCredentials `json:"credentials,omitempty"`
+}
+
+// LogAPIRequestUser is the user that made a request to the Management API.
+type LogAPIRequestUser struct {
+	UserID *string `json:"user_id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	Email  *string `json:"email,omitempty"`
+}
+
+// LogAPIRequestCredentials holds the credentials used to authenticate a
+// request made to the Management API.
+type LogAPIRequestCredentials struct {
+	// ID of the access token.
+	JTI *string `json:"jti,omitempty"`
+
+	// Scopes granted to the access token.
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// LogAPIResponse is a response sent by the Management API.
+type LogAPIResponse struct {
+	// HTTP status code of the response.
+	StatusCode *int `json:"statusCode,omitempty"`
+
+	// Body of the response.
+	Body interface{} `json:"body,omitempty"`
 }
 
 // TypeName returns the type name of an Event Log.
 func (l *Log) TypeName() string {
-	if l.Type == nil {
-		return ""
-	}
-	if name, ok := logTypeName[*l.Type]; ok {
-		return name
-	}
-	return ""
+	return LogTypeName(l.GetType())
+}
+
+// LogTypeName returns the description of a log event type, such as
+// "Success Login" for LogTypeSuccessLogin, or an empty string if the type is
+// unknown.
+func LogTypeName(code string) string {
+	return logTypeName[code]
 }
 
 // UnmarshalJSON is a custom deserializer for the Log type.
@@ -164,6 +413,12 @@ func (l *Log) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	if l.LocationInfo != nil {
+		if err := decodeLogMap(l.LocationInfo, &l.Location); err != nil {
+			return err
+		}
+	}
+
 	if alias.RawScope != nil {
 		switch rawScope := alias.RawScope.(type) {
 		case []interface{}:
@@ -183,6 +438,21 @@ func (l *Log) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// DecodeDetails decodes the details of the log event into v, whose type
+// depends on the type of the event, such as LogLoginDetails,
+// LogErrorDetails or LogAPIOperationDetails.
+func (l *Log) DecodeDetails(v interface{}) error {
+	return decodeLogMap(l.Details, v)
+}
+
+func decodeLogMap(m map[string]interface{}, v interface{}) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
 // LogManager manages Auth0 Log resources.
 type LogManager struct {
 	*Management
@@ -214,6 +484,37 @@ func (m *LogManager) List(opts ...RequestOption) (l []*Log, err error) {
 	return
 }
 
+// Paginate returns a Paginator over the log entries which follow the log entry
+// identified by from, using checkpoint pagination to retrieve the entries
+// more recent than the checkpoint in chronological order. Pages of 50 entries
+// are retrieved, unless the options use Take.
+//
+// Unlike page based pagination, which is limited to the first 1000 results,
+// it can go through every log entry kept by the tenant.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Logs/get_logs
+func (m *LogManager) Paginate(from string, opts ...RequestOption) *Paginator[*Log] {
+	return NewPaginator(func(opts ...RequestOption) (*logPage, error) {
+		logs, err := m.List(opts...)
+		if err != nil {
+			return nil, err
+		}
+		page := &logPage{Logs: logs}
+		if len(logs) > 0 {
+			page.Next = logs[len(logs)-1].GetID()
+		}
+		return page, nil
+	}, func(p *logPage) []*Log { return p.Logs }, withOptions(append([]RequestOption{Take(50)}, opts...), From(from))...)
+}
+
+// logPage is a page of log entries retrieved with checkpoint pagination,
+// whose next checkpoint is the ID of its last entry, as the Management API
+// returns log entries as a plain array.
+type logPage struct {
+	List
+	Logs []*Log
+}
+
 // Search is an alias for List.
 func (m *LogManager) Search(opts ...RequestOption) ([]*Log, error) {
 	return m.List(opts...)
@@ -0,0 +1,193 @@
+package management
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	// LogExportFormatNDJSON exports one JSON log event per line.
+	LogExportFormatNDJSON = "ndjson"
+
+	// LogExportFormatCSV exports one log event per CSV record, with the
+	// fields listed in LogExportCSVHeader.
+	LogExportFormatCSV = "csv"
+)
+
+// LogExportCSVHeader holds the fields of the log events exported as CSV, in
+// the order of the columns. Details and location info are exported as JSON.
+var LogExportCSVHeader = []string{
+	"log_id", "date", "type", "description", "client_id", "client_name",
+	"connection", "connection_id", "organization_id", "organization_name",
+	"ip", "hostname", "user_id", "user_name", "user_agent", "audience", "scope",
+	"strategy", "strategy_type", "details", "location_info",
+}
+
+// LogExport configures the logs exported by LogManager.Export.
+type LogExport struct {
+	// Format of the export, either LogExportFormatNDJSON or LogExportFormatCSV.
+	// Defaults to LogExportFormatNDJSON.
+	Format string
+
+	// Start of the time range of the logs exported, which defaults to the
+	// oldest log available.
+	From time.Time
+
+	// End of the time range of the logs exported, excluded. Defaults to the
+	// time the export reaches the most recent log.
+	To time.Time
+
+	// Checkpoint is the ID of the last log exported by a previous export, to
+	// resume after it. From is ignored when it's set, and the CSV header isn't
+	// written again.
+	Checkpoint string
+
+	// PageSize is the number of logs retrieved by each request, at most 100.
+	// Defaults to 100.
+	PageSize int
+
+	// OnCheckpoint, if not nil, is called with the ID of the last log
+	// exported after each page of logs is written, so that it can be saved to
+	// resume the export later.
+	OnCheckpoint func(checkpoint string)
+}
+
+// Export writes every log in the time range of e to w, going through the logs
+// with checkpoint pagination. It returns the ID of the last log exported, or
+// the checkpoint of e if no log was exported, which is set even when an error
+// is returned.
+//
+// The options are applied to every request made.
+//
+// See: https://auth0.com/docs/logs/retrieve-log-events-using-mgmt-api
+func (m *LogManager) Export(w io.Writer, e *LogExport, opts ...RequestOption) (string, error) {
+	enc, err := newLogEncoder(w, e.Format, e.Checkpoint == "")
+	if err != nil {
+		return e.Checkpoint, err
+	}
+
+	pageSize := e.PageSize
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 100
+	}
+
+	checkpoint := e.Checkpoint
+	for {
+		var logs []*Log
+		if checkpoint == "" {
+			logs, err = m.first(e.From, opts...)
+		} else {
+			logs, err = m.List(withOptions(opts, From(checkpoint), Take(pageSize))...)
+		}
+		if err != nil {
+			return checkpoint, err
+		}
+
+		var done bool
+		for i, l := range logs {
+			if !e.To.IsZero() && !l.GetDate().Before(e.To) {
+				logs, done = logs[:i], true
+				break
+			}
+		}
+		if len(logs) == 0 {
+			return checkpoint, nil
+		}
+
+		for _, l := range logs {
+			if err := enc.encode(l); err != nil {
+				return checkpoint, err
+			}
+		}
+		if err := enc.flush(); err != nil {
+			return checkpoint, err
+		}
+
+		checkpoint = logs[len(logs)-1].GetLogID()
+		if e.OnCheckpoint != nil {
+			e.OnCheckpoint(checkpoint)
+		}
+		if done {
+			return checkpoint, nil
+		}
+	}
+}
+
+// first retrieves the oldest log created since from, as checkpoint
+// pagination needs the ID of a log to start from.
+func (m *LogManager) first(from time.Time, opts ...RequestOption) ([]*Log, error) {
+	firstOpts := withOptions(opts, Parameter("sort", "date:1"), PerPage(1), Page(0))
+	if !from.IsZero() {
+		firstOpts = append(firstOpts, Query(fmt.Sprintf("date:[%s TO *]", from.UTC().Format("2006-01-02T15:04:05.000Z"))))
+	}
+
+	return m.List(firstOpts...)
+}
+
+type logEncoder struct {
+	json *json.Encoder
+	csv  *csv.Writer
+}
+
+func newLogEncoder(w io.Writer, format string, header bool) (*logEncoder, error) {
+	switch format {
+	case "", LogExportFormatNDJSON:
+		return &logEncoder{json: json.NewEncoder(w)}, nil
+	case LogExportFormatCSV:
+		enc := &logEncoder{csv: csv.NewWriter(w)}
+		if header {
+			if err := enc.csv.Write(LogExportCSVHeader); err != nil {
+				return nil, err
+			}
+		}
+		return enc, nil
+	default:
+		return nil, fmt.Errorf("unsupported log export format %q", format)
+	}
+}
+
+func (e *logEncoder) encode(l *Log) error {
+	if e.json != nil {
+		// The scope isn't marshaled with the other fields, as it can be
+		// received either as a string or as a list of strings.
+		type log Log
+		return e.json.Encode(&struct {
+			*log
+			Scope *string `json:"scope"`
+		}{(*log)(l), l.Scope})
+	}
+
+	details, err := json.Marshal(l.Details)
+	if err != nil {
+		return err
+	}
+
+	locationInfo, err := json.Marshal(l.LocationInfo)
+	if err != nil {
+		return err
+	}
+
+	var date string
+	if l.Date != nil {
+		date = l.GetDate().UTC().Format(time.RFC3339Nano)
+	}
+
+	return e.csv.Write([]string{
+		l.GetLogID(), date, l.GetType(), l.GetDescription(), l.GetClientID(), l.GetClientName(),
+		l.GetConnection(), l.GetConnectionID(), l.GetOrganizationID(), l.GetOrganizationName(),
+		l.GetIP(), l.GetHostname(), l.GetUserID(), l.GetUserName(), l.GetUserAgent(), l.GetAudience(), l.GetScope(),
+		l.GetStrategy(), l.GetStrategyType(), string(details), string(locationInfo),
+	})
+}
+
+func (e *logEncoder) flush() error {
+	if e.csv == nil {
+		return nil
+	}
+
+	e.csv.Flush()
+	return e.csv.Error()
+}
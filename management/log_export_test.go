@@ -0,0 +1,108 @@
+package management
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLogsHandler(t *testing.T, count int) http.Handler {
+	t.Helper()
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	logAt := func(i int) string {
+		return fmt.Sprintf(`{"log_id":"log_%d","date":%q,"type":"s","user_id":"auth0|%d","scope":["openid"]}`,
+			i, start.Add(time.Duration(i)*time.Hour).Format(time.RFC3339), i)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		if from := q.Get("from"); from != "" {
+			after, err := strconv.Atoi(strings.TrimPrefix(from, "log_"))
+			require.NoError(t, err)
+			take, err := strconv.Atoi(q.Get("take"))
+			require.NoError(t, err)
+
+			var logs []string
+			for i := after + 1; i < count && len(logs) < take; i++ {
+				logs = append(logs, logAt(i))
+			}
+			fmt.Fprintf(w, `[%s]`, strings.Join(logs, ","))
+			return
+		}
+
+		assert.Equal(t, "date:1", q.Get("sort"))
+		assert.Equal(t, "1", q.Get("per_page"))
+		assert.Equal(t, "date:[2023-01-01T02:00:00.000Z TO *]", q.Get("q"))
+		fmt.Fprintf(w, `[%s]`, logAt(2))
+	})
+}
+
+func TestLogManager_Export(t *testing.T) {
+	m := newTestManagement(t, newLogsHandler(t, 10))
+
+	// The options of the caller are left untouched, even with spare capacity.
+	opts := make([]RequestOption, 1, 4)
+	opts[0] = Header("X-Test", "export")
+
+	var buf bytes.Buffer
+	var checkpoints []string
+	checkpoint, err := m.Log.Export(&buf, &LogExport{
+		From:     time.Date(2023, 1, 1, 2, 0, 0, 0, time.UTC),
+		To:       time.Date(2023, 1, 1, 7, 0, 0, 0, time.UTC),
+		PageSize: 2,
+		OnCheckpoint: func(checkpoint string) {
+			checkpoints = append(checkpoints, checkpoint)
+		},
+	}, opts...)
+	require.NoError(t, err)
+	assert.Equal(t, []RequestOption{nil, nil, nil}, opts[1:cap(opts)])
+	assert.Equal(t, "log_6", checkpoint)
+	assert.Equal(t, []string{"log_2", "log_4", "log_6"}, checkpoints)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 5)
+	for i, line := range lines {
+		var l map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &l))
+		assert.Equal(t, fmt.Sprintf("log_%d", i+2), l["log_id"])
+		assert.Equal(t, "openid", l["scope"])
+	}
+}
+
+func TestLogManager_ExportCSV(t *testing.T) {
+	m := newTestManagement(t, newLogsHandler(t, 4))
+
+	var buf bytes.Buffer
+	checkpoint, err := m.Log.Export(&buf, &LogExport{
+		Format:     LogExportFormatCSV,
+		Checkpoint: "log_1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "log_3", checkpoint)
+
+	// The header isn't written again when resuming an export.
+	assert.Equal(t, ""+
+		"log_2,2023-01-01T02:00:00Z,s,,,,,,,,,,auth0|2,,,,openid,,,null,null\n"+
+		"log_3,2023-01-01T03:00:00Z,s,,,,,,,,,,auth0|3,,,,openid,,,null,null\n",
+		buf.String(),
+	)
+
+	buf.Reset()
+	checkpoint, err = m.Log.Export(&buf, &LogExport{Format: LogExportFormatCSV, Checkpoint: "log_3"})
+	require.NoError(t, err)
+	assert.Equal(t, "log_3", checkpoint)
+	assert.Empty(t, buf.String())
+
+	_, err = m.Log.Export(&buf, &LogExport{Format: "xml"})
+	assert.EqualError(t, err, `unsupported log export format "xml"`)
+}
@@ -2,6 +2,8 @@ package management
 
 import (
 	"encoding/json"
+
+	"github.com/auth0/go-auth0"
 )
 
 const (
@@ -23,6 +25,19 @@ const (
 	LogStreamTypeSegment = "segment"
 )
 
+const (
+	// LogStreamStatusActive is the status of log streams delivering events.
+	LogStreamStatusActive = "active"
+	// LogStreamStatusPaused is the status of log streams paused on purpose.
+	LogStreamStatusPaused = "paused"
+	// LogStreamStatusSuspended is the status of log streams suspended after
+	// failing to deliver events for too long.
+	LogStreamStatusSuspended = "suspended"
+	// LogStreamStatusDegraded is the status of log streams failing to deliver
+	// some of the events.
+	LogStreamStatusDegraded = "degraded"
+)
+
 // LogStream is used to export tenant log
 // events to a log event analysis service.
 //
@@ -39,9 +54,14 @@ type LogStream struct {
 	// "eventgrid", "datadog", "splunk", "sumo", "mixpanel", "segment.
 	Type *string `json:"type,omitempty"`
 
-	// The status of the log-stream. Can be one of "active", "paused", or "suspended".
+	// The status of the log-stream. Can be one of "active", "paused",
+	// "suspended" or "degraded".
 	Status *string `json:"status,omitempty"`
 
+	// Whether the log-stream was paused on purpose, as opposed to suspended
+	// or degraded because of delivery issues. Read-only.
+	IsPaused *bool `json:"is_paused,omitempty"`
+
 	// Only logs events matching these filters will be delivered by the stream.
 	// If omitted or empty, all events will be delivered.
 	Filters *[]map[string]string `json:"filters,omitempty"`
@@ -121,6 +141,20 @@ func (ls *LogStream) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Paused reports whether the log stream was paused on purpose.
+func (ls *LogStream) Paused() bool {
+	return ls.GetStatus() == LogStreamStatusPaused || ls.GetIsPaused()
+}
+
+// Degraded reports whether the log stream is suspended or degraded because of
+// delivery issues, rather than paused on purpose.
+func (ls *LogStream) Degraded() bool {
+	if ls.Paused() {
+		return false
+	}
+	return ls.GetStatus() == LogStreamStatusSuspended || ls.GetStatus() == LogStreamStatusDegraded
+}
+
 // LogStreamSinkAmazonEventBridge is used to export logs to Amazon EventBridge.
 type LogStreamSinkAmazonEventBridge struct {
 	// AWS Account Id
@@ -247,3 +281,41 @@ func (m *LogStreamManager) Update(id string, l *LogStream, opts ...RequestOption
 func (m *LogStreamManager) Delete(id string, opts ...RequestOption) (err error) {
 	return m.Request("DELETE", m.URI("log-streams", id), nil, opts...)
 }
+
+// Reactivate sets the status of a log stream back to active, so that it
+// resumes delivering events, and returns the updated log stream.
+//
+// See: https://auth0.com/docs/api/management/v2#!/log-streams
+func (m *LogStreamManager) Reactivate(id string, opts ...RequestOption) (*LogStream, error) {
+	l := &LogStream{Status: auth0.String(LogStreamStatusActive)}
+	if err := m.Update(id, l, opts...); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// ReactivateDegraded reactivates every log stream suspended or degraded
+// because of delivery issues, and returns the reactivated log streams. Log
+// streams paused on purpose are left paused.
+//
+// The options are applied to every request made.
+func (m *LogStreamManager) ReactivateDegraded(opts ...RequestOption) ([]*LogStream, error) {
+	streams, err := m.List(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var reactivated []*LogStream
+	for _, ls := range streams {
+		if !ls.Degraded() {
+			continue
+		}
+		l, err := m.Reactivate(ls.GetID(), opts...)
+		if err != nil {
+			return reactivated, err
+		}
+		reactivated = append(reactivated, l)
+	}
+
+	return reactivated, nil
+}
@@ -0,0 +1,108 @@
+package management
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// logStreamMaxBodySize is the maximum size of a batch of log events accepted
+// by the handler returned by NewLogStreamHandler, well above the size of the
+// batches of up to 100 log events sent by Auth0.
+const logStreamMaxBodySize = 10 << 20
+
+// LogStreamEvent is a log event sent by a log stream to a Custom Webhook.
+type LogStreamEvent struct {
+	// Unique ID of the log event.
+	LogID *string `json:"log_id,omitempty"`
+
+	// The log event.
+	Data *Log `json:"data,omitempty"`
+}
+
+// NewLogStreamHandler returns an http.Handler receiving the batches of log
+// events sent by a log stream to a Custom Webhook, and passing their logs to
+// handle. Batches can be sent in any of the content formats of the
+// LogStreamSinkHTTP.
+//
+// Requests whose Authorization header doesn't match authorization, as
+// configured on the LogStreamSinkHTTP, are rejected. The header isn't checked
+// when authorization is empty.
+//
+// Batches larger than 10MB are rejected with a 413 status code, so that
+// unauthenticated requests can't make the server allocate unbounded memory.
+//
+// When handle returns an error, the handler responds with a server error so
+// that the batch is sent again by Auth0.
+//
+// See: https://auth0.com/docs/customize/log-streams/custom-log-streams
+func NewLogStreamHandler(authorization string, handle func(logs []*Log) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		if authorization != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(authorization)) != 1 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		events, err := decodeLogStreamEvents(http.MaxBytesReader(w, r.Body, logStreamMaxBodySize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		logs := make([]*Log, 0, len(events))
+		for _, e := range events {
+			if e.Data != nil {
+				logs = append(logs, e.Data)
+			}
+		}
+
+		if err := handle(logs); err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// decodeLogStreamEvents decodes a batch of log events, which is either a JSON
+// array of events, or one or more events separated by new lines.
+func decodeLogStreamEvents(r io.Reader) ([]*LogStreamEvent, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*LogStreamEvent
+	if b = bytes.TrimSpace(b); bytes.HasPrefix(b, []byte("[")) {
+		err := json.Unmarshal(b, &events)
+		return events, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	for {
+		var e LogStreamEvent
+		err := dec.Decode(&e)
+		if err == io.EOF {
+			return events, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &e)
+	}
+}
@@ -0,0 +1,88 @@
+package management
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogStreamHandler(t *testing.T) {
+	for name, body := range map[string]string{
+		"JSONARRAY": `[
+			{"log_id":"90020230101","data":{"log_id":"90020230101","type":"s","user_id":"auth0|1"}},
+			{"log_id":"90020230102","data":{"log_id":"90020230102","type":"f","scope":["openid","profile"]}}
+		]`,
+		"JSONLINES": `{"log_id":"90020230101","data":{"log_id":"90020230101","type":"s","user_id":"auth0|1"}}
+{"log_id":"90020230102","data":{"log_id":"90020230102","type":"f","scope":["openid","profile"]}}
+`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var received []*Log
+			h := NewLogStreamHandler("Bearer secret", func(logs []*Log) error {
+				received = logs
+				return nil
+			})
+
+			r := httptest.NewRequest(http.MethodPost, "/logs", strings.NewReader(body))
+			r.Header.Set("Authorization", "Bearer secret")
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			require.Len(t, received, 2)
+			assert.Equal(t, "90020230101", received[0].GetLogID())
+			assert.Equal(t, "auth0|1", received[0].GetUserID())
+			assert.Equal(t, "Success Login", received[0].TypeName())
+			assert.Equal(t, "openid profile", received[1].GetScope())
+		})
+	}
+
+	t.Run("JSONOBJECT", func(t *testing.T) {
+		var received []*Log
+		h := NewLogStreamHandler("", func(logs []*Log) error {
+			received = logs
+			return nil
+		})
+
+		r := httptest.NewRequest(http.MethodPost, "/logs", strings.NewReader(`{"log_id":"90020230101","data":{"type":"s"}}`))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, received, 1)
+		assert.Equal(t, "s", received[0].GetType())
+	})
+
+	t.Run("rejected requests", func(t *testing.T) {
+		h := NewLogStreamHandler("Bearer secret", func(logs []*Log) error {
+			if len(logs) == 0 {
+				return errors.New("no logs")
+			}
+			return nil
+		})
+
+		for _, test := range []struct {
+			method, authorization, body string
+			status                      int
+		}{
+			{http.MethodGet, "Bearer secret", "", http.StatusMethodNotAllowed},
+			{http.MethodPost, "", `[]`, http.StatusUnauthorized},
+			{http.MethodPost, "Bearer other", `[]`, http.StatusUnauthorized},
+			{http.MethodPost, "Bearer secret", `{"log_id":`, http.StatusBadRequest},
+			{http.MethodPost, "Bearer secret", `[]`, http.StatusInternalServerError},
+			{http.MethodPost, "Bearer secret", `[` + strings.Repeat(" ", logStreamMaxBodySize) + `]`, http.StatusRequestEntityTooLarge},
+		} {
+			r := httptest.NewRequest(test.method, "/logs", strings.NewReader(test.body))
+			r.Header.Set("Authorization", test.authorization)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			assert.Equalf(t, test.status, w.Code, "%s with %q", test.method, test.authorization)
+		}
+	})
+}
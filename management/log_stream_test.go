@@ -1,6 +1,7 @@
 package management
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 	"time"
@@ -219,3 +220,37 @@ func cleanupLogStream(t *testing.T, logStreamID string) {
 	err := api.LogStream.Delete(logStreamID)
 	require.NoError(t, err)
 }
+
+func TestLogStreamManager_ReactivateDegraded(t *testing.T) {
+	var reactivated []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/log-streams":
+			w.Write([]byte(`[
+				{"id":"lst_1","type":"http","sink":{},"status":"active"},
+				{"id":"lst_2","type":"http","sink":{},"status":"suspended"},
+				{"id":"lst_3","type":"http","sink":{},"status":"degraded"},
+				{"id":"lst_4","type":"http","sink":{},"status":"paused","is_paused":true},
+				{"id":"lst_5","type":"http","sink":{},"status":"suspended","is_paused":true}
+			]`))
+		case r.Method == http.MethodPatch:
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"status": "active"}, body)
+
+			id := r.URL.Path[len("/api/v2/log-streams/"):]
+			reactivated = append(reactivated, id)
+			w.Write([]byte(`{"id":"` + id + `","type":"http","sink":{},"status":"active"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	streams, err := m.LogStream.ReactivateDegraded()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"lst_2", "lst_3"}, reactivated)
+	require.Len(t, streams, 2)
+	assert.Equal(t, LogStreamStatusActive, streams[0].GetStatus())
+	assert.False(t, streams[0].Degraded())
+}
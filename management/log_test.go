@@ -3,9 +3,11 @@ package management
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/auth0/go-auth0"
 )
@@ -78,3 +80,96 @@ func TestLogManagerScope_UnmarshalJSON(t *testing.T) {
 		assert.EqualError(t, err, "unexpected type for field scope: float64")
 	})
 }
+
+func TestLog_LocationAndDetails(t *testing.T) {
+	var l *Log
+	err := json.Unmarshal([]byte(`{
+		"type": "sapi",
+		"location_info": {
+			"country_code": "NL",
+			"city_name": "Amsterdam",
+			"latitude": 52.37,
+			"longitude": 4.89,
+			"time_zone": "Europe/Amsterdam"
+		},
+		"details": {
+			"request": {
+				"method": "patch",
+				"path": "/api/v2/clients/abc",
+				"channel": "api",
+				"auth": {
+					"strategy": "jwt",
+					"credentials": {"jti": "123", "scopes": ["update:clients"]}
+				}
+			},
+			"response": {"statusCode": 200}
+		}
+	}`), &l)
+	require.NoError(t, err)
+
+	assert.Equal(t, &LogLocationInfo{
+		CountryCode: auth0.String("NL"),
+		CityName:    auth0.String("Amsterdam"),
+		Latitude:    auth0.Float64(52.37),
+		Longitude:   auth0.Float64(4.89),
+		TimeZone:    auth0.String("Europe/Amsterdam"),
+	}, l.GetLocation())
+
+	var details LogAPIOperationDetails
+	err = l.DecodeDetails(&details)
+	require.NoError(t, err)
+	assert.Equal(t, "patch", details.GetRequest().GetMethod())
+	assert.Equal(t, "/api/v2/clients/abc", details.GetRequest().GetPath())
+	assert.Equal(t, []string{"update:clients"}, details.GetRequest().GetAuth().GetCredentials().Scopes)
+	assert.Equal(t, 200, details.GetResponse().GetStatusCode())
+
+	var login LogLoginDetails
+	err = (&Log{Details: map[string]interface{}{
+		"session_id": "abc",
+		"stats":      map[string]interface{}{"loginsCount": 3},
+		"prompts":    []interface{}{map[string]interface{}{"name": "login", "elapsedTime": 1200}},
+	}}).DecodeDetails(&login)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", login.GetSessionID())
+	assert.Equal(t, 3, login.GetStats().GetLoginsCount())
+	require.Len(t, login.Prompts, 1)
+	assert.Equal(t, "login", login.Prompts[0].GetName())
+	assert.Equal(t, int64(1200), login.Prompts[0].GetElapsedTime())
+}
+
+func TestLogTypeName(t *testing.T) {
+	assert.Equal(t, "Success Login", LogTypeName(LogTypeSuccessLogin))
+	assert.Equal(t, "Blocked Account", LogTypeName(LogTypeBlockedAccount))
+	assert.Equal(t, "Success Exchange (Client Credentials for Access Token)", LogTypeName(LogTypeSuccessExchangeClientCredentials))
+	assert.Equal(t, "", LogTypeName("unknown"))
+
+	assert.Equal(t, "Failed Login (wrong password)", (&Log{Type: auth0.String(LogTypeFailedLoginWrongPassword)}).TypeName())
+	assert.Equal(t, "", (&Log{}).TypeName())
+}
+
+func TestLogManager_Paginate(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/logs", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("take"))
+
+		switch r.URL.Query().Get("from") {
+		case "log_1":
+			w.Write([]byte(`[{"_id":"log_2"},{"_id":"log_3"}]`))
+		case "log_3":
+			w.Write([]byte(`[{"_id":"log_4"}]`))
+		case "log_4":
+			w.Write([]byte(`[]`))
+		default:
+			t.Errorf("unexpected checkpoint %q", r.URL.Query().Get("from"))
+		}
+	})
+	m := newTestManagement(t, h)
+
+	var ids []string
+	p := m.Log.Paginate("log_1", Take(2))
+	for p.Next() {
+		ids = append(ids, p.Item().GetID())
+	}
+	require.NoError(t, p.Err())
+	assert.Equal(t, []string{"log_2", "log_3", "log_4"}, ids)
+}
@@ -4,13 +4,13 @@
 package management
 
 import (
-	"time"
+	"context"
 )
 
 // GetBuiltAt returns the BuiltAt field if it's non-nil, zero value otherwise.
-func (a *Action) GetBuiltAt() time.Time {
+func (a *Action) GetBuiltAt() Timestamp {
 	if a == nil || a.BuiltAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.BuiltAt
 }
@@ -24,9 +24,9 @@ func (a *Action) GetCode() string {
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (a *Action) GetCreatedAt() time.Time {
+func (a *Action) GetCreatedAt() Timestamp {
 	if a == nil || a.CreatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.CreatedAt
 }
@@ -88,9 +88,9 @@ func (a *Action) GetStatus() string {
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (a *Action) GetUpdatedAt() time.Time {
+func (a *Action) GetUpdatedAt() Timestamp {
 	if a == nil || a.UpdatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.UpdatedAt
 }
@@ -109,9 +109,9 @@ func (a *ActionBinding) GetAction() *Action {
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (a *ActionBinding) GetCreatedAt() time.Time {
+func (a *ActionBinding) GetCreatedAt() Timestamp {
 	if a == nil || a.CreatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.CreatedAt
 }
@@ -149,9 +149,9 @@ func (a *ActionBinding) GetTriggerID() string {
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (a *ActionBinding) GetUpdatedAt() time.Time {
+func (a *ActionBinding) GetUpdatedAt() Timestamp {
 	if a == nil || a.UpdatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.UpdatedAt
 }
@@ -161,6 +161,23 @@ func (a *ActionBinding) String() string {
 	return Stringify(a)
 }
 
+// items returns the Bindings field, which holds the resources of the page.
+func (a *ActionBindingList) items() []*ActionBinding {
+	if a == nil {
+		return nil
+	}
+	return a.Bindings
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (a *ActionBindingList) NextPage(ctx context.Context) (*ActionBindingList, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return nextPage[*ActionBindingList](ctx, a.List)
+}
+
 // String returns a string representation of ActionBindingList.
 func (a *ActionBindingList) String() string {
 	return Stringify(a)
@@ -217,9 +234,9 @@ func (a *ActionDependency) String() string {
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (a *ActionExecution) GetCreatedAt() time.Time {
+func (a *ActionExecution) GetCreatedAt() Timestamp {
 	if a == nil || a.CreatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.CreatedAt
 }
@@ -249,9 +266,9 @@ func (a *ActionExecution) GetTriggerID() string {
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (a *ActionExecution) GetUpdatedAt() time.Time {
+func (a *ActionExecution) GetUpdatedAt() Timestamp {
 	if a == nil || a.UpdatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.UpdatedAt
 }
@@ -270,17 +287,17 @@ func (a *ActionExecutionResult) GetActionName() string {
 }
 
 // GetEndedAt returns the EndedAt field if it's non-nil, zero value otherwise.
-func (a *ActionExecutionResult) GetEndedAt() time.Time {
+func (a *ActionExecutionResult) GetEndedAt() Timestamp {
 	if a == nil || a.EndedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.EndedAt
 }
 
 // GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
-func (a *ActionExecutionResult) GetStartedAt() time.Time {
+func (a *ActionExecutionResult) GetStartedAt() Timestamp {
 	if a == nil || a.StartedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.StartedAt
 }
@@ -290,15 +307,32 @@ func (a *ActionExecutionResult) String() string {
 	return Stringify(a)
 }
 
+// items returns the Actions field, which holds the resources of the page.
+func (a *ActionList) items() []*Action {
+	if a == nil {
+		return nil
+	}
+	return a.Actions
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (a *ActionList) NextPage(ctx context.Context) (*ActionList, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return nextPage[*ActionList](ctx, a.List)
+}
+
 // String returns a string representation of ActionList.
 func (a *ActionList) String() string {
 	return Stringify(a)
 }
 
 // GetExpires returns the Expires field if it's non-nil, zero value otherwise.
-func (a *ActionLogSession) GetExpires() time.Time {
+func (a *ActionLogSession) GetExpires() Timestamp {
 	if a == nil || a.Expires == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.Expires
 }
@@ -321,6 +355,32 @@ func (a *ActionLogSessionFilter) String() string {
 	return Stringify(a)
 }
 
+// GetAction returns the Action field.
+func (a *ActionMigration) GetAction() *Action {
+	if a == nil {
+		return nil
+	}
+	return a.Action
+}
+
+// GetBinding returns the Binding field.
+func (a *ActionMigration) GetBinding() *ActionBinding {
+	if a == nil {
+		return nil
+	}
+	return a.Binding
+}
+
+// String returns a string representation of ActionMigration with any secrets redacted.
+func (a *ActionMigration) String() string {
+	return stringifyRedacted(a)
+}
+
+// GoString returns a string representation of ActionMigration with any secrets redacted.
+func (a *ActionMigration) GoString() string {
+	return a.String()
+}
+
 // GetName returns the Name field if it's non-nil, zero value otherwise.
 func (a *ActionSecret) GetName() string {
 	if a == nil || a.Name == nil {
@@ -330,9 +390,9 @@ func (a *ActionSecret) GetName() string {
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (a *ActionSecret) GetUpdatedAt() time.Time {
+func (a *ActionSecret) GetUpdatedAt() Timestamp {
 	if a == nil || a.UpdatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.UpdatedAt
 }
@@ -393,9 +453,9 @@ func (a *ActionVersion) GetAction() *Action {
 }
 
 // GetBuiltAt returns the BuiltAt field if it's non-nil, zero value otherwise.
-func (a *ActionVersion) GetBuiltAt() time.Time {
+func (a *ActionVersion) GetBuiltAt() Timestamp {
 	if a == nil || a.BuiltAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.BuiltAt
 }
@@ -409,9 +469,9 @@ func (a *ActionVersion) GetCode() string {
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (a *ActionVersion) GetCreatedAt() time.Time {
+func (a *ActionVersion) GetCreatedAt() Timestamp {
 	if a == nil || a.CreatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.CreatedAt
 }
@@ -433,9 +493,9 @@ func (a *ActionVersion) GetStatus() string {
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (a *ActionVersion) GetUpdatedAt() time.Time {
+func (a *ActionVersion) GetUpdatedAt() Timestamp {
 	if a == nil || a.UpdatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.UpdatedAt
 }
@@ -474,11 +534,43 @@ func (a *ActionVersionError) String() string {
 	return Stringify(a)
 }
 
+// items returns the Versions field, which holds the resources of the page.
+func (a *ActionVersionList) items() []*ActionVersion {
+	if a == nil {
+		return nil
+	}
+	return a.Versions
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (a *ActionVersionList) NextPage(ctx context.Context) (*ActionVersionList, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return nextPage[*ActionVersionList](ctx, a.List)
+}
+
 // String returns a string representation of ActionVersionList.
 func (a *ActionVersionList) String() string {
 	return Stringify(a)
 }
 
+// String returns a string representation of AmbiguousNameError.
+func (a *AmbiguousNameError) String() string {
+	return Stringify(a)
+}
+
+// String returns a string representation of APIError.
+func (a *APIError) String() string {
+	return Stringify(a)
+}
+
+// String returns a string representation of AuditEvent.
+func (a *AuditEvent) String() string {
+	return Stringify(a)
+}
+
 // GetAuthenticationMethods returns the AuthenticationMethods field if it's non-nil, zero value otherwise.
 func (a *AuthenticationMethod) GetAuthenticationMethods() []AuthenticationMethodReference {
 	if a == nil || a.AuthenticationMethods == nil {
@@ -496,9 +588,9 @@ func (a *AuthenticationMethod) GetConfirmed() bool {
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (a *AuthenticationMethod) GetCreatedAt() time.Time {
+func (a *AuthenticationMethod) GetCreatedAt() Timestamp {
 	if a == nil || a.CreatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.CreatedAt
 }
@@ -512,9 +604,9 @@ func (a *AuthenticationMethod) GetEmail() string {
 }
 
 // GetEnrolledAt returns the EnrolledAt field if it's non-nil, zero value otherwise.
-func (a *AuthenticationMethod) GetEnrolledAt() time.Time {
+func (a *AuthenticationMethod) GetEnrolledAt() Timestamp {
 	if a == nil || a.EnrolledAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.EnrolledAt
 }
@@ -536,9 +628,9 @@ func (a *AuthenticationMethod) GetKeyID() string {
 }
 
 // GetLastAuthedAt returns the LastAuthedAt field if it's non-nil, zero value otherwise.
-func (a *AuthenticationMethod) GetLastAuthedAt() time.Time {
+func (a *AuthenticationMethod) GetLastAuthedAt() Timestamp {
 	if a == nil || a.LastAuthedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *a.LastAuthedAt
 }
@@ -612,6 +704,23 @@ func (a *AuthenticationMethod) String() string {
 	return Stringify(a)
 }
 
+// items returns the Authenticators field, which holds the resources of the page.
+func (a *AuthenticationMethodList) items() []*AuthenticationMethod {
+	if a == nil {
+		return nil
+	}
+	return a.Authenticators
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (a *AuthenticationMethodList) NextPage(ctx context.Context) (*AuthenticationMethodList, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return nextPage[*AuthenticationMethodList](ctx, a.List)
+}
+
 // String returns a string representation of AuthenticationMethodList.
 func (a *AuthenticationMethodList) String() string {
 	return Stringify(a)
@@ -643,6 +752,59 @@ func (b *BlacklistToken) String() string {
 	return Stringify(b)
 }
 
+// GetAllowList returns the AllowList field if it's non-nil, zero value otherwise.
+func (b *BotDetection) GetAllowList() []string {
+	if b == nil || b.AllowList == nil {
+		return nil
+	}
+	return *b.AllowList
+}
+
+// GetBotDetectionLevel returns the BotDetectionLevel field if it's non-nil, zero value otherwise.
+func (b *BotDetection) GetBotDetectionLevel() string {
+	if b == nil || b.BotDetectionLevel == nil {
+		return ""
+	}
+	return *b.BotDetectionLevel
+}
+
+// GetChallengePasswordlessPolicy returns the ChallengePasswordlessPolicy field if it's non-nil, zero value otherwise.
+func (b *BotDetection) GetChallengePasswordlessPolicy() string {
+	if b == nil || b.ChallengePasswordlessPolicy == nil {
+		return ""
+	}
+	return *b.ChallengePasswordlessPolicy
+}
+
+// GetChallengePasswordPolicy returns the ChallengePasswordPolicy field if it's non-nil, zero value otherwise.
+func (b *BotDetection) GetChallengePasswordPolicy() string {
+	if b == nil || b.ChallengePasswordPolicy == nil {
+		return ""
+	}
+	return *b.ChallengePasswordPolicy
+}
+
+// GetChallengePasswordResetPolicy returns the ChallengePasswordResetPolicy field if it's non-nil, zero value otherwise.
+func (b *BotDetection) GetChallengePasswordResetPolicy() string {
+	if b == nil || b.ChallengePasswordResetPolicy == nil {
+		return ""
+	}
+	return *b.ChallengePasswordResetPolicy
+}
+
+// GetMonitoringModeEnabled returns the MonitoringModeEnabled field if it's non-nil, zero value otherwise.
+func (b *BotDetection) GetMonitoringModeEnabled() bool {
+	if b == nil || b.MonitoringModeEnabled == nil {
+		return false
+	}
+	return *b.MonitoringModeEnabled
+}
+
+// String returns a string representation of BotDetection.
+func (b *BotDetection) String() string {
+	return Stringify(b)
+}
+
 // GetColors returns the Colors field.
 func (b *Branding) GetColors() *BrandingColors {
 	if b == nil {
@@ -955,6 +1117,195 @@ func (b *BruteForceProtection) String() string {
 	return Stringify(b)
 }
 
+// GetActiveProviderID returns the ActiveProviderID field if it's non-nil, zero value otherwise.
+func (c *Captcha) GetActiveProviderID() string {
+	if c == nil || c.ActiveProviderID == nil {
+		return ""
+	}
+	return *c.ActiveProviderID
+}
+
+// GetArkose returns the Arkose field.
+func (c *Captcha) GetArkose() *CaptchaArkose {
+	if c == nil {
+		return nil
+	}
+	return c.Arkose
+}
+
+// GetAuthChallenge returns the AuthChallenge field.
+func (c *Captcha) GetAuthChallenge() *CaptchaAuthChallenge {
+	if c == nil {
+		return nil
+	}
+	return c.AuthChallenge
+}
+
+// GetFriendlyCaptcha returns the FriendlyCaptcha field.
+func (c *Captcha) GetFriendlyCaptcha() *CaptchaProvider {
+	if c == nil {
+		return nil
+	}
+	return c.FriendlyCaptcha
+}
+
+// GetHCaptcha returns the HCaptcha field.
+func (c *Captcha) GetHCaptcha() *CaptchaProvider {
+	if c == nil {
+		return nil
+	}
+	return c.HCaptcha
+}
+
+// GetRecaptchaEnterprise returns the RecaptchaEnterprise field.
+func (c *Captcha) GetRecaptchaEnterprise() *CaptchaRecaptchaEnterprise {
+	if c == nil {
+		return nil
+	}
+	return c.RecaptchaEnterprise
+}
+
+// GetRecaptchaV2 returns the RecaptchaV2 field.
+func (c *Captcha) GetRecaptchaV2() *CaptchaProvider {
+	if c == nil {
+		return nil
+	}
+	return c.RecaptchaV2
+}
+
+// String returns a string representation of Captcha with any secrets redacted.
+func (c *Captcha) String() string {
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of Captcha with any secrets redacted.
+func (c *Captcha) GoString() string {
+	return c.String()
+}
+
+// GetClientSubdomain returns the ClientSubdomain field if it's non-nil, zero value otherwise.
+func (c *CaptchaArkose) GetClientSubdomain() string {
+	if c == nil || c.ClientSubdomain == nil {
+		return ""
+	}
+	return *c.ClientSubdomain
+}
+
+// GetFailOpen returns the FailOpen field if it's non-nil, zero value otherwise.
+func (c *CaptchaArkose) GetFailOpen() bool {
+	if c == nil || c.FailOpen == nil {
+		return false
+	}
+	return *c.FailOpen
+}
+
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (c *CaptchaArkose) GetSecret() string {
+	if c == nil || c.Secret == nil {
+		return ""
+	}
+	return *c.Secret
+}
+
+// GetSiteKey returns the SiteKey field if it's non-nil, zero value otherwise.
+func (c *CaptchaArkose) GetSiteKey() string {
+	if c == nil || c.SiteKey == nil {
+		return ""
+	}
+	return *c.SiteKey
+}
+
+// GetVerifySubdomain returns the VerifySubdomain field if it's non-nil, zero value otherwise.
+func (c *CaptchaArkose) GetVerifySubdomain() string {
+	if c == nil || c.VerifySubdomain == nil {
+		return ""
+	}
+	return *c.VerifySubdomain
+}
+
+// String returns a string representation of CaptchaArkose with any secrets redacted.
+func (c *CaptchaArkose) String() string {
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of CaptchaArkose with any secrets redacted.
+func (c *CaptchaArkose) GoString() string {
+	return c.String()
+}
+
+// GetFailOpen returns the FailOpen field if it's non-nil, zero value otherwise.
+func (c *CaptchaAuthChallenge) GetFailOpen() bool {
+	if c == nil || c.FailOpen == nil {
+		return false
+	}
+	return *c.FailOpen
+}
+
+// String returns a string representation of CaptchaAuthChallenge.
+func (c *CaptchaAuthChallenge) String() string {
+	return Stringify(c)
+}
+
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (c *CaptchaProvider) GetSecret() string {
+	if c == nil || c.Secret == nil {
+		return ""
+	}
+	return *c.Secret
+}
+
+// GetSiteKey returns the SiteKey field if it's non-nil, zero value otherwise.
+func (c *CaptchaProvider) GetSiteKey() string {
+	if c == nil || c.SiteKey == nil {
+		return ""
+	}
+	return *c.SiteKey
+}
+
+// String returns a string representation of CaptchaProvider with any secrets redacted.
+func (c *CaptchaProvider) String() string {
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of CaptchaProvider with any secrets redacted.
+func (c *CaptchaProvider) GoString() string {
+	return c.String()
+}
+
+// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
+func (c *CaptchaRecaptchaEnterprise) GetAPIKey() string {
+	if c == nil || c.APIKey == nil {
+		return ""
+	}
+	return *c.APIKey
+}
+
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (c *CaptchaRecaptchaEnterprise) GetProjectID() string {
+	if c == nil || c.ProjectID == nil {
+		return ""
+	}
+	return *c.ProjectID
+}
+
+// GetSiteKey returns the SiteKey field if it's non-nil, zero value otherwise.
+func (c *CaptchaRecaptchaEnterprise) GetSiteKey() string {
+	if c == nil || c.SiteKey == nil {
+		return ""
+	}
+	return *c.SiteKey
+}
+
+// String returns a string representation of CaptchaRecaptchaEnterprise with any secrets redacted.
+func (c *CaptchaRecaptchaEnterprise) String() string {
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of CaptchaRecaptchaEnterprise with any secrets redacted.
+func (c *CaptchaRecaptchaEnterprise) GoString() string {
+	return c.String()
+}
+
 // GetAllowedClients returns the AllowedClients field if it's non-nil, zero value otherwise.
 func (c *Client) GetAllowedClients() []string {
 	if c == nil || c.AllowedClients == nil {
@@ -1243,9 +1594,14 @@ func (c *Client) GetWebOrigins() []string {
 	return *c.WebOrigins
 }
 
-// String returns a string representation of Client.
+// String returns a string representation of Client with any secrets redacted.
 func (c *Client) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of Client with any secrets redacted.
+func (c *Client) GoString() string {
+	return c.String()
 }
 
 // GetPrivateKeyJWT returns the PrivateKeyJWT field.
@@ -1261,6 +1617,14 @@ func (c *ClientAuthenticationMethods) String() string {
 	return Stringify(c)
 }
 
+// GetAllowAnyOrganization returns the AllowAnyOrganization field if it's non-nil, zero value otherwise.
+func (c *ClientGrant) GetAllowAnyOrganization() bool {
+	if c == nil || c.AllowAnyOrganization == nil {
+		return false
+	}
+	return *c.AllowAnyOrganization
+}
+
 // GetAudience returns the Audience field if it's non-nil, zero value otherwise.
 func (c *ClientGrant) GetAudience() string {
 	if c == nil || c.Audience == nil {
@@ -1285,11 +1649,36 @@ func (c *ClientGrant) GetID() string {
 	return *c.ID
 }
 
+// GetOrganizationUsage returns the OrganizationUsage field if it's non-nil, zero value otherwise.
+func (c *ClientGrant) GetOrganizationUsage() string {
+	if c == nil || c.OrganizationUsage == nil {
+		return ""
+	}
+	return *c.OrganizationUsage
+}
+
 // String returns a string representation of ClientGrant.
 func (c *ClientGrant) String() string {
 	return Stringify(c)
 }
 
+// items returns the ClientGrants field, which holds the resources of the page.
+func (c *ClientGrantList) items() []*ClientGrant {
+	if c == nil {
+		return nil
+	}
+	return c.ClientGrants
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (c *ClientGrantList) NextPage(ctx context.Context) (*ClientGrantList, error) {
+	if c == nil {
+		return nil, nil
+	}
+	return nextPage[*ClientGrantList](ctx, c.List)
+}
+
 // String returns a string representation of ClientGrantList.
 func (c *ClientGrantList) String() string {
 	return Stringify(c)
@@ -1332,11 +1721,45 @@ func (c *ClientJWTConfiguration) String() string {
 	return Stringify(c)
 }
 
+// items returns the Clients field, which holds the resources of the page.
+func (c *ClientList) items() []*Client {
+	if c == nil {
+		return nil
+	}
+	return c.Clients
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (c *ClientList) NextPage(ctx context.Context) (*ClientList, error) {
+	if c == nil {
+		return nil, nil
+	}
+	return nextPage[*ClientList](ctx, c.List)
+}
+
 // String returns a string representation of ClientList.
 func (c *ClientList) String() string {
 	return Stringify(c)
 }
 
+// ListAll retrieves all client applications by going through every page of results.
+//
+// Pages are retrieved concurrently when WithListConcurrency is used.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Clients/get_clients
+func (m *ClientManager) ListAll(ctx context.Context, opts ...RequestOption) ([]*Client, error) {
+	return listAll(m.Management, listPages(func(opts ...RequestOption) (*ClientList, error) { return m.List(ctx, opts...) }, (*ClientList).items), opts...)
+}
+
+// Paginate returns a Paginator over all client applications, retrieving the pages of
+// results as they're needed.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Clients/get_clients
+func (m *ClientManager) Paginate(ctx context.Context, opts ...RequestOption) *Paginator[*Client] {
+	return NewPaginator(func(opts ...RequestOption) (*ClientList, error) { return m.List(ctx, opts...) }, (*ClientList).items, opts...)
+}
+
 // GetAndroid returns the Android field.
 func (c *ClientMobile) GetAndroid() *ClientMobileAndroid {
 	if c == nil {
@@ -1495,6 +1918,16 @@ func (c *ClientRefreshToken) String() string {
 	return Stringify(c)
 }
 
+// String returns a string representation of Config with any secrets redacted.
+func (c *Config) String() string {
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of Config with any secrets redacted.
+func (c *Config) GoString() string {
+	return c.String()
+}
+
 // GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
 func (c *Connection) GetDisplayName() string {
 	if c == nil || c.DisplayName == nil {
@@ -1575,9 +2008,14 @@ func (c *Connection) GetStrategy() string {
 	return *c.Strategy
 }
 
-// String returns a string representation of Connection.
+// String returns a string representation of Connection with any secrets redacted.
 func (c *Connection) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of Connection with any secrets redacted.
+func (c *Connection) GoString() string {
+	return c.String()
 }
 
 // GetAudience returns the Audience field if it's non-nil, zero value otherwise.
@@ -1620,9 +2058,31 @@ func (c *ConnectionGatewayAuthentication) GetSubject() string {
 	return *c.Subject
 }
 
-// String returns a string representation of ConnectionGatewayAuthentication.
+// String returns a string representation of ConnectionGatewayAuthentication with any secrets redacted.
 func (c *ConnectionGatewayAuthentication) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionGatewayAuthentication with any secrets redacted.
+func (c *ConnectionGatewayAuthentication) GoString() string {
+	return c.String()
+}
+
+// items returns the Connections field, which holds the resources of the page.
+func (c *ConnectionList) items() []*Connection {
+	if c == nil {
+		return nil
+	}
+	return c.Connections
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (c *ConnectionList) NextPage(ctx context.Context) (*ConnectionList, error) {
+	if c == nil {
+		return nil, nil
+	}
+	return nextPage[*ConnectionList](ctx, c.List)
 }
 
 // String returns a string representation of ConnectionList.
@@ -1630,6 +2090,23 @@ func (c *ConnectionList) String() string {
 	return Stringify(c)
 }
 
+// ListAll retrieves all connections by going through every page of results.
+//
+// Pages are retrieved concurrently when WithListConcurrency is used.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Connections/get_connections
+func (m *ConnectionManager) ListAll(opts ...RequestOption) ([]*Connection, error) {
+	return listAll(m.Management, listPages(m.List, (*ConnectionList).items), opts...)
+}
+
+// Paginate returns a Paginator over all connections, retrieving the pages of
+// results as they're needed.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Connections/get_connections
+func (m *ConnectionManager) Paginate(opts ...RequestOption) *Paginator[*Connection] {
+	return NewPaginator(m.List, (*ConnectionList).items, opts...)
+}
+
 // GetBruteForceProtection returns the BruteForceProtection field if it's non-nil, zero value otherwise.
 func (c *ConnectionOptions) GetBruteForceProtection() bool {
 	if c == nil || c.BruteForceProtection == nil {
@@ -1989,9 +2466,14 @@ func (c *ConnectionOptionsApple) GetTeamID() string {
 	return *c.TeamID
 }
 
-// String returns a string representation of ConnectionOptionsApple.
+// String returns a string representation of ConnectionOptionsApple with any secrets redacted.
 func (c *ConnectionOptionsApple) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsApple with any secrets redacted.
+func (c *ConnectionOptionsApple) GoString() string {
+	return c.String()
 }
 
 // GetAdmin returns the Admin field if it's non-nil, zero value otherwise.
@@ -2194,9 +2676,14 @@ func (c *ConnectionOptionsAzureAD) GetWAADProtocol() string {
 	return *c.WAADProtocol
 }
 
-// String returns a string representation of ConnectionOptionsAzureAD.
+// String returns a string representation of ConnectionOptionsAzureAD with any secrets redacted.
 func (c *ConnectionOptionsAzureAD) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsAzureAD with any secrets redacted.
+func (c *ConnectionOptionsAzureAD) GoString() string {
+	return c.String()
 }
 
 // GetBruteForceProtection returns the BruteForceProtection field if it's non-nil, zero value otherwise.
@@ -2665,9 +3152,14 @@ func (c *ConnectionOptionsFacebook) GetUserVideos() bool {
 	return *c.UserVideos
 }
 
-// String returns a string representation of ConnectionOptionsFacebook.
+// String returns a string representation of ConnectionOptionsFacebook with any secrets redacted.
 func (c *ConnectionOptionsFacebook) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsFacebook with any secrets redacted.
+func (c *ConnectionOptionsFacebook) GoString() string {
+	return c.String()
 }
 
 // GetAdminOrg returns the AdminOrg field if it's non-nil, zero value otherwise.
@@ -2862,9 +3354,14 @@ func (c *ConnectionOptionsGitHub) GetWriteRepoHook() bool {
 	return *c.WriteRepoHook
 }
 
-// String returns a string representation of ConnectionOptionsGitHub.
+// String returns a string representation of ConnectionOptionsGitHub with any secrets redacted.
 func (c *ConnectionOptionsGitHub) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsGitHub with any secrets redacted.
+func (c *ConnectionOptionsGitHub) GoString() string {
+	return c.String()
 }
 
 // GetAdmin returns the Admin field if it's non-nil, zero value otherwise.
@@ -2987,9 +3484,14 @@ func (c *ConnectionOptionsGoogleApps) GetTenantDomain() string {
 	return *c.TenantDomain
 }
 
-// String returns a string representation of ConnectionOptionsGoogleApps.
+// String returns a string representation of ConnectionOptionsGoogleApps with any secrets redacted.
 func (c *ConnectionOptionsGoogleApps) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsGoogleApps with any secrets redacted.
+func (c *ConnectionOptionsGoogleApps) GoString() string {
+	return c.String()
 }
 
 // GetAdsenseManagement returns the AdsenseManagement field if it's non-nil, zero value otherwise.
@@ -3272,9 +3774,14 @@ func (c *ConnectionOptionsGoogleOAuth2) GetYoutube() bool {
 	return *c.Youtube
 }
 
-// String returns a string representation of ConnectionOptionsGoogleOAuth2.
+// String returns a string representation of ConnectionOptionsGoogleOAuth2 with any secrets redacted.
 func (c *ConnectionOptionsGoogleOAuth2) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsGoogleOAuth2 with any secrets redacted.
+func (c *ConnectionOptionsGoogleOAuth2) GoString() string {
+	return c.String()
 }
 
 // GetBasicProfile returns the BasicProfile field if it's non-nil, zero value otherwise.
@@ -3341,9 +3848,14 @@ func (c *ConnectionOptionsLinkedin) GetStrategyVersion() int {
 	return *c.StrategyVersion
 }
 
-// String returns a string representation of ConnectionOptionsLinkedin.
+// String returns a string representation of ConnectionOptionsLinkedin with any secrets redacted.
 func (c *ConnectionOptionsLinkedin) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsLinkedin with any secrets redacted.
+func (c *ConnectionOptionsLinkedin) GoString() string {
+	return c.String()
 }
 
 // GetAuthorizationURL returns the AuthorizationURL field if it's non-nil, zero value otherwise.
@@ -3426,9 +3938,14 @@ func (c *ConnectionOptionsOAuth2) GetTokenURL() string {
 	return *c.TokenURL
 }
 
-// String returns a string representation of ConnectionOptionsOAuth2.
+// String returns a string representation of ConnectionOptionsOAuth2 with any secrets redacted.
 func (c *ConnectionOptionsOAuth2) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsOAuth2 with any secrets redacted.
+func (c *ConnectionOptionsOAuth2) GoString() string {
+	return c.String()
 }
 
 // GetAuthorizationEndpoint returns the AuthorizationEndpoint field if it's non-nil, zero value otherwise.
@@ -3551,9 +4068,14 @@ func (c *ConnectionOptionsOIDC) GetUserInfoEndpoint() string {
 	return *c.UserInfoEndpoint
 }
 
-// String returns a string representation of ConnectionOptionsOIDC.
+// String returns a string representation of ConnectionOptionsOIDC with any secrets redacted.
 func (c *ConnectionOptionsOIDC) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsOIDC with any secrets redacted.
+func (c *ConnectionOptionsOIDC) GoString() string {
+	return c.String()
 }
 
 // GetAuthorizationEndpoint returns the AuthorizationEndpoint field if it's non-nil, zero value otherwise.
@@ -3660,9 +4182,14 @@ func (c *ConnectionOptionsOkta) GetUserInfoEndpoint() string {
 	return *c.UserInfoEndpoint
 }
 
-// String returns a string representation of ConnectionOptionsOkta.
+// String returns a string representation of ConnectionOptionsOkta with any secrets redacted.
 func (c *ConnectionOptionsOkta) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsOkta with any secrets redacted.
+func (c *ConnectionOptionsOkta) GoString() string {
+	return c.String()
 }
 
 // GetLength returns the Length field if it's non-nil, zero value otherwise.
@@ -3790,9 +4317,14 @@ func (c *ConnectionOptionsPingFederate) GetTenantDomain() string {
 	return *c.TenantDomain
 }
 
-// String returns a string representation of ConnectionOptionsPingFederate.
+// String returns a string representation of ConnectionOptionsPingFederate with any secrets redacted.
 func (c *ConnectionOptionsPingFederate) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsPingFederate with any secrets redacted.
+func (c *ConnectionOptionsPingFederate) GoString() string {
+	return c.String()
 }
 
 // GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
@@ -3843,9 +4375,14 @@ func (c *ConnectionOptionsSalesforce) GetSetUserAttributes() string {
 	return *c.SetUserAttributes
 }
 
-// String returns a string representation of ConnectionOptionsSalesforce.
+// String returns a string representation of ConnectionOptionsSalesforce with any secrets redacted.
 func (c *ConnectionOptionsSalesforce) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsSalesforce with any secrets redacted.
+func (c *ConnectionOptionsSalesforce) GoString() string {
+	return c.String()
 }
 
 // GetCert returns the Cert field if it's non-nil, zero value otherwise.
@@ -4032,9 +4569,14 @@ func (c *ConnectionOptionsSAML) GetUserIDAttribute() string {
 	return *c.UserIDAttribute
 }
 
-// String returns a string representation of ConnectionOptionsSAML.
+// String returns a string representation of ConnectionOptionsSAML with any secrets redacted.
 func (c *ConnectionOptionsSAML) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsSAML with any secrets redacted.
+func (c *ConnectionOptionsSAML) GoString() string {
+	return c.String()
 }
 
 // GetClientAuthorizeQuery returns the ClientAuthorizeQuery field if it's non-nil, zero value otherwise.
@@ -4106,9 +4648,14 @@ func (c *ConnectionOptionsSAMLSigningKey) GetKey() string {
 	return *c.Key
 }
 
-// String returns a string representation of ConnectionOptionsSAMLSigningKey.
+// String returns a string representation of ConnectionOptionsSAMLSigningKey with any secrets redacted.
 func (c *ConnectionOptionsSAMLSigningKey) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsSAMLSigningKey with any secrets redacted.
+func (c *ConnectionOptionsSAMLSigningKey) GoString() string {
+	return c.String()
 }
 
 // GetBruteForceProtection returns the BruteForceProtection field if it's non-nil, zero value otherwise.
@@ -4223,9 +4770,14 @@ func (c *ConnectionOptionsSMS) GetTwilioToken() string {
 	return *c.TwilioToken
 }
 
-// String returns a string representation of ConnectionOptionsSMS.
+// String returns a string representation of ConnectionOptionsSMS with any secrets redacted.
 func (c *ConnectionOptionsSMS) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsSMS with any secrets redacted.
+func (c *ConnectionOptionsSMS) GoString() string {
+	return c.String()
 }
 
 // GetCalendars returns the Calendars field if it's non-nil, zero value otherwise.
@@ -4444,9 +4996,14 @@ func (c *ConnectionOptionsWindowsLive) GetUserUpdate() bool {
 	return *c.UserUpdate
 }
 
-// String returns a string representation of ConnectionOptionsWindowsLive.
+// String returns a string representation of ConnectionOptionsWindowsLive with any secrets redacted.
 func (c *ConnectionOptionsWindowsLive) String() string {
-	return Stringify(c)
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of ConnectionOptionsWindowsLive with any secrets redacted.
+func (c *ConnectionOptionsWindowsLive) GoString() string {
+	return c.String()
 }
 
 // String returns a string representation of CreateEnrollmentTicket.
@@ -4463,9 +5020,9 @@ func (c *Credential) GetAlgorithm() string {
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (c *Credential) GetCreatedAt() time.Time {
+func (c *Credential) GetCreatedAt() Timestamp {
 	if c == nil || c.CreatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *c.CreatedAt
 }
@@ -4479,9 +5036,9 @@ func (c *Credential) GetCredentialType() string {
 }
 
 // GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (c *Credential) GetExpiresAt() time.Time {
+func (c *Credential) GetExpiresAt() Timestamp {
 	if c == nil || c.ExpiresAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *c.ExpiresAt
 }
@@ -4527,18 +5084,36 @@ func (c *Credential) GetPEM() string {
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (c *Credential) GetUpdatedAt() time.Time {
+func (c *Credential) GetUpdatedAt() Timestamp {
 	if c == nil || c.UpdatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *c.UpdatedAt
 }
 
-// String returns a string representation of Credential.
+// String returns a string representation of Credential with any secrets redacted.
 func (c *Credential) String() string {
+	return stringifyRedacted(c)
+}
+
+// GoString returns a string representation of Credential with any secrets redacted.
+func (c *Credential) GoString() string {
+	return c.String()
+}
+
+// String returns a string representation of CredentialRotationEvent.
+func (c *CredentialRotationEvent) String() string {
 	return Stringify(c)
 }
 
+// GetCertificate returns the Certificate field.
+func (c *CustomDomain) GetCertificate() *CustomDomainCertificate {
+	if c == nil {
+		return nil
+	}
+	return c.Certificate
+}
+
 // GetCNAMEAPIKey returns the CNAMEAPIKey field if it's non-nil, zero value otherwise.
 func (c *CustomDomain) GetCNAMEAPIKey() string {
 	if c == nil || c.CNAMEAPIKey == nil {
@@ -4632,23 +5207,65 @@ func (c *CustomDomain) String() string {
 	return Stringify(c)
 }
 
+// GetCertificateAuthority returns the CertificateAuthority field if it's non-nil, zero value otherwise.
+func (c *CustomDomainCertificate) GetCertificateAuthority() string {
+	if c == nil || c.CertificateAuthority == nil {
+		return ""
+	}
+	return *c.CertificateAuthority
+}
+
+// GetErrorMsg returns the ErrorMsg field if it's non-nil, zero value otherwise.
+func (c *CustomDomainCertificate) GetErrorMsg() string {
+	if c == nil || c.ErrorMsg == nil {
+		return ""
+	}
+	return *c.ErrorMsg
+}
+
+// GetRenewsBefore returns the RenewsBefore field if it's non-nil, zero value otherwise.
+func (c *CustomDomainCertificate) GetRenewsBefore() Timestamp {
+	if c == nil || c.RenewsBefore == nil {
+		return Timestamp{}
+	}
+	return *c.RenewsBefore
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *CustomDomainCertificate) GetStatus() string {
+	if c == nil || c.Status == nil {
+		return ""
+	}
+	return *c.Status
+}
+
+// String returns a string representation of CustomDomainCertificate.
+func (c *CustomDomainCertificate) String() string {
+	return Stringify(c)
+}
+
+// String returns a string representation of CustomDomainCertificateStatus.
+func (c *CustomDomainCertificateStatus) String() string {
+	return Stringify(c)
+}
+
 // String returns a string representation of CustomDomainVerification.
 func (c *CustomDomainVerification) String() string {
 	return Stringify(c)
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *DailyStat) GetCreatedAt() time.Time {
+func (d *DailyStat) GetCreatedAt() Timestamp {
 	if d == nil || d.CreatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *d.CreatedAt
 }
 
 // GetDate returns the Date field if it's non-nil, zero value otherwise.
-func (d *DailyStat) GetDate() time.Time {
+func (d *DailyStat) GetDate() Timestamp {
 	if d == nil || d.Date == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *d.Date
 }
@@ -4678,9 +5295,9 @@ func (d *DailyStat) GetSignups() int {
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DailyStat) GetUpdatedAt() time.Time {
+func (d *DailyStat) GetUpdatedAt() Timestamp {
 	if d == nil || d.UpdatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *d.UpdatedAt
 }
@@ -4690,6 +5307,11 @@ func (d *DailyStat) String() string {
 	return Stringify(d)
 }
 
+// String returns a string representation of DeviceAuthorization.
+func (d *DeviceAuthorization) String() string {
+	return Stringify(d)
+}
+
 // GetCredentials returns the Credentials field.
 func (e *Email) GetCredentials() *EmailCredentials {
 	if e == nil {
@@ -5147,9 +5769,9 @@ func (e *EmailTemplate) String() string {
 }
 
 // GetEnrolledAt returns the EnrolledAt field if it's non-nil, zero value otherwise.
-func (e *Enrollment) GetEnrolledAt() time.Time {
+func (e *Enrollment) GetEnrolledAt() Timestamp {
 	if e == nil || e.EnrolledAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *e.EnrolledAt
 }
@@ -5171,9 +5793,9 @@ func (e *Enrollment) GetIdentifier() string {
 }
 
 // GetLastAuth returns the LastAuth field if it's non-nil, zero value otherwise.
-func (e *Enrollment) GetLastAuth() time.Time {
+func (e *Enrollment) GetLastAuth() Timestamp {
 	if e == nil || e.LastAuth == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *e.LastAuth
 }
@@ -5212,6 +5834,11 @@ func (e *EnrollmentTicket) String() string {
 	return Stringify(e)
 }
 
+// String returns a string representation of EnsureResult.
+func (e *EnsureResult) String() string {
+	return Stringify(e)
+}
+
 // GetAudience returns the Audience field if it's non-nil, zero value otherwise.
 func (g *Grant) GetAudience() string {
 	if g == nil || g.Audience == nil {
@@ -5249,11 +5876,33 @@ func (g *Grant) String() string {
 	return Stringify(g)
 }
 
+// items returns the Grants field, which holds the resources of the page.
+func (g *GrantList) items() []*Grant {
+	if g == nil {
+		return nil
+	}
+	return g.Grants
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (g *GrantList) NextPage(ctx context.Context) (*GrantList, error) {
+	if g == nil {
+		return nil, nil
+	}
+	return nextPage[*GrantList](ctx, g.List)
+}
+
 // String returns a string representation of GrantList.
 func (g *GrantList) String() string {
 	return Stringify(g)
 }
 
+// String returns a string representation of HealthCheckError.
+func (h *HealthCheckError) String() string {
+	return Stringify(h)
+}
+
 // GetDependencies returns the Dependencies field if it's non-nil, zero value otherwise.
 func (h *Hook) GetDependencies() map[string]string {
 	if h == nil || h.Dependencies == nil {
@@ -5307,11 +5956,33 @@ func (h *Hook) String() string {
 	return Stringify(h)
 }
 
+// items returns the Hooks field, which holds the resources of the page.
+func (h *HookList) items() []*Hook {
+	if h == nil {
+		return nil
+	}
+	return h.Hooks
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (h *HookList) NextPage(ctx context.Context) (*HookList, error) {
+	if h == nil {
+		return nil, nil
+	}
+	return nextPage[*HookList](ctx, h.List)
+}
+
 // String returns a string representation of HookList.
 func (h *HookList) String() string {
 	return Stringify(h)
 }
 
+// String returns a string representation of InsufficientScopeError.
+func (i *InsufficientScopeError) String() string {
+	return Stringify(i)
+}
+
 // GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
 func (j *Job) GetClientID() string {
 	if j == nil || j.ClientID == nil {
@@ -5329,9 +6000,9 @@ func (j *Job) GetConnectionID() string {
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (j *Job) GetCreatedAt() time.Time {
+func (j *Job) GetCreatedAt() Timestamp {
 	if j == nil || j.CreatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *j.CreatedAt
 }
@@ -5554,9 +6225,9 @@ func (l *Log) GetConnectionID() string {
 }
 
 // GetDate returns the Date field if it's non-nil, zero value otherwise.
-func (l *Log) GetDate() time.Time {
+func (l *Log) GetDate() Timestamp {
 	if l == nil || l.Date == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *l.Date
 }
@@ -5601,6 +6272,14 @@ func (l *Log) GetIsMobile() bool {
 	return *l.IsMobile
 }
 
+// GetLocation returns the Location field.
+func (l *Log) GetLocation() *LogLocationInfo {
+	if l == nil {
+		return nil
+	}
+	return l.Location
+}
+
 // GetLogID returns the LogID field if it's non-nil, zero value otherwise.
 func (l *Log) GetLogID() string {
 	if l == nil || l.LogID == nil {
@@ -5686,6 +6365,402 @@ func (l *Log) String() string {
 	return Stringify(l)
 }
 
+// GetRequest returns the Request field.
+func (l *LogAPIOperationDetails) GetRequest() *LogAPIRequest {
+	if l == nil {
+		return nil
+	}
+	return l.Request
+}
+
+// GetResponse returns the Response field.
+func (l *LogAPIOperationDetails) GetResponse() *LogAPIResponse {
+	if l == nil {
+		return nil
+	}
+	return l.Response
+}
+
+// String returns a string representation of LogAPIOperationDetails.
+func (l *LogAPIOperationDetails) String() string {
+	return Stringify(l)
+}
+
+// GetAuth returns the Auth field.
+func (l *LogAPIRequest) GetAuth() *LogAPIRequestAuth {
+	if l == nil {
+		return nil
+	}
+	return l.Auth
+}
+
+// GetChannel returns the Channel field if it's non-nil, zero value otherwise.
+func (l *LogAPIRequest) GetChannel() string {
+	if l == nil || l.Channel == nil {
+		return ""
+	}
+	return *l.Channel
+}
+
+// GetIP returns the IP field if it's non-nil, zero value otherwise.
+func (l *LogAPIRequest) GetIP() string {
+	if l == nil || l.IP == nil {
+		return ""
+	}
+	return *l.IP
+}
+
+// GetMethod returns the Method field if it's non-nil, zero value otherwise.
+func (l *LogAPIRequest) GetMethod() string {
+	if l == nil || l.Method == nil {
+		return ""
+	}
+	return *l.Method
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (l *LogAPIRequest) GetPath() string {
+	if l == nil || l.Path == nil {
+		return ""
+	}
+	return *l.Path
+}
+
+// String returns a string representation of LogAPIRequest.
+func (l *LogAPIRequest) String() string {
+	return Stringify(l)
+}
+
+// GetCredentials returns the Credentials field.
+func (l *LogAPIRequestAuth) GetCredentials() *LogAPIRequestCredentials {
+	if l == nil {
+		return nil
+	}
+	return l.Credentials
+}
+
+// GetStrategy returns the Strategy field if it's non-nil, zero value otherwise.
+func (l *LogAPIRequestAuth) GetStrategy() string {
+	if l == nil || l.Strategy == nil {
+		return ""
+	}
+	return *l.Strategy
+}
+
+// GetUser returns the User field.
+func (l *LogAPIRequestAuth) GetUser() *LogAPIRequestUser {
+	if l == nil {
+		return nil
+	}
+	return l.User
+}
+
+// String returns a string representation of LogAPIRequestAuth.
+func (l *LogAPIRequestAuth) String() string {
+	return Stringify(l)
+}
+
+// GetJTI returns the JTI field if it's non-nil, zero value otherwise.
+func (l *LogAPIRequestCredentials) GetJTI() string {
+	if l == nil || l.JTI == nil {
+		return ""
+	}
+	return *l.JTI
+}
+
+// String returns a string representation of LogAPIRequestCredentials.
+func (l *LogAPIRequestCredentials) String() string {
+	return Stringify(l)
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (l *LogAPIRequestUser) GetEmail() string {
+	if l == nil || l.Email == nil {
+		return ""
+	}
+	return *l.Email
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *LogAPIRequestUser) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (l *LogAPIRequestUser) GetUserID() string {
+	if l == nil || l.UserID == nil {
+		return ""
+	}
+	return *l.UserID
+}
+
+// String returns a string representation of LogAPIRequestUser.
+func (l *LogAPIRequestUser) String() string {
+	return Stringify(l)
+}
+
+// GetStatusCode returns the StatusCode field if it's non-nil, zero value otherwise.
+func (l *LogAPIResponse) GetStatusCode() int {
+	if l == nil || l.StatusCode == nil {
+		return 0
+	}
+	return *l.StatusCode
+}
+
+// String returns a string representation of LogAPIResponse.
+func (l *LogAPIResponse) String() string {
+	return Stringify(l)
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (l *LogError) GetMessage() string {
+	if l == nil || l.Message == nil {
+		return ""
+	}
+	return *l.Message
+}
+
+// GetOAuthError returns the OAuthError field if it's non-nil, zero value otherwise.
+func (l *LogError) GetOAuthError() string {
+	if l == nil || l.OAuthError == nil {
+		return ""
+	}
+	return *l.OAuthError
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (l *LogError) GetType() string {
+	if l == nil || l.Type == nil {
+		return ""
+	}
+	return *l.Type
+}
+
+// String returns a string representation of LogError.
+func (l *LogError) String() string {
+	return Stringify(l)
+}
+
+// GetError returns the Error field.
+func (l *LogErrorDetails) GetError() *LogError {
+	if l == nil {
+		return nil
+	}
+	return l.Error
+}
+
+// String returns a string representation of LogErrorDetails.
+func (l *LogErrorDetails) String() string {
+	return Stringify(l)
+}
+
+// GetCityName returns the CityName field if it's non-nil, zero value otherwise.
+func (l *LogLocationInfo) GetCityName() string {
+	if l == nil || l.CityName == nil {
+		return ""
+	}
+	return *l.CityName
+}
+
+// GetContinentCode returns the ContinentCode field if it's non-nil, zero value otherwise.
+func (l *LogLocationInfo) GetContinentCode() string {
+	if l == nil || l.ContinentCode == nil {
+		return ""
+	}
+	return *l.ContinentCode
+}
+
+// GetCountryCode returns the CountryCode field if it's non-nil, zero value otherwise.
+func (l *LogLocationInfo) GetCountryCode() string {
+	if l == nil || l.CountryCode == nil {
+		return ""
+	}
+	return *l.CountryCode
+}
+
+// GetCountryCode3 returns the CountryCode3 field if it's non-nil, zero value otherwise.
+func (l *LogLocationInfo) GetCountryCode3() string {
+	if l == nil || l.CountryCode3 == nil {
+		return ""
+	}
+	return *l.CountryCode3
+}
+
+// GetCountryName returns the CountryName field if it's non-nil, zero value otherwise.
+func (l *LogLocationInfo) GetCountryName() string {
+	if l == nil || l.CountryName == nil {
+		return ""
+	}
+	return *l.CountryName
+}
+
+// GetLatitude returns the Latitude field if it's non-nil, zero value otherwise.
+func (l *LogLocationInfo) GetLatitude() float64 {
+	if l == nil || l.Latitude == nil {
+		return 0
+	}
+	return *l.Latitude
+}
+
+// GetLongitude returns the Longitude field if it's non-nil, zero value otherwise.
+func (l *LogLocationInfo) GetLongitude() float64 {
+	if l == nil || l.Longitude == nil {
+		return 0
+	}
+	return *l.Longitude
+}
+
+// GetTimeZone returns the TimeZone field if it's non-nil, zero value otherwise.
+func (l *LogLocationInfo) GetTimeZone() string {
+	if l == nil || l.TimeZone == nil {
+		return ""
+	}
+	return *l.TimeZone
+}
+
+// String returns a string representation of LogLocationInfo.
+func (l *LogLocationInfo) String() string {
+	return Stringify(l)
+}
+
+// GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
+func (l *LogLoginDetails) GetCompletedAt() int64 {
+	if l == nil || l.CompletedAt == nil {
+		return 0
+	}
+	return *l.CompletedAt
+}
+
+// GetElapsedTime returns the ElapsedTime field if it's non-nil, zero value otherwise.
+func (l *LogLoginDetails) GetElapsedTime() int64 {
+	if l == nil || l.ElapsedTime == nil {
+		return 0
+	}
+	return *l.ElapsedTime
+}
+
+// GetInitiatedAt returns the InitiatedAt field if it's non-nil, zero value otherwise.
+func (l *LogLoginDetails) GetInitiatedAt() int64 {
+	if l == nil || l.InitiatedAt == nil {
+		return 0
+	}
+	return *l.InitiatedAt
+}
+
+// GetSessionID returns the SessionID field if it's non-nil, zero value otherwise.
+func (l *LogLoginDetails) GetSessionID() string {
+	if l == nil || l.SessionID == nil {
+		return ""
+	}
+	return *l.SessionID
+}
+
+// GetStats returns the Stats field.
+func (l *LogLoginDetails) GetStats() *LogLoginStats {
+	if l == nil {
+		return nil
+	}
+	return l.Stats
+}
+
+// String returns a string representation of LogLoginDetails.
+func (l *LogLoginDetails) String() string {
+	return Stringify(l)
+}
+
+// GetLoginsCount returns the LoginsCount field if it's non-nil, zero value otherwise.
+func (l *LogLoginStats) GetLoginsCount() int {
+	if l == nil || l.LoginsCount == nil {
+		return 0
+	}
+	return *l.LoginsCount
+}
+
+// String returns a string representation of LogLoginStats.
+func (l *LogLoginStats) String() string {
+	return Stringify(l)
+}
+
+// GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
+func (l *LogPrompt) GetCompletedAt() int64 {
+	if l == nil || l.CompletedAt == nil {
+		return 0
+	}
+	return *l.CompletedAt
+}
+
+// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
+func (l *LogPrompt) GetConnectionID() string {
+	if l == nil || l.ConnectionID == nil {
+		return ""
+	}
+	return *l.ConnectionID
+}
+
+// GetElapsedTime returns the ElapsedTime field if it's non-nil, zero value otherwise.
+func (l *LogPrompt) GetElapsedTime() int64 {
+	if l == nil || l.ElapsedTime == nil {
+		return 0
+	}
+	return *l.ElapsedTime
+}
+
+// GetFlow returns the Flow field if it's non-nil, zero value otherwise.
+func (l *LogPrompt) GetFlow() string {
+	if l == nil || l.Flow == nil {
+		return ""
+	}
+	return *l.Flow
+}
+
+// GetIdentity returns the Identity field if it's non-nil, zero value otherwise.
+func (l *LogPrompt) GetIdentity() string {
+	if l == nil || l.Identity == nil {
+		return ""
+	}
+	return *l.Identity
+}
+
+// GetInitiatedAt returns the InitiatedAt field if it's non-nil, zero value otherwise.
+func (l *LogPrompt) GetInitiatedAt() int64 {
+	if l == nil || l.InitiatedAt == nil {
+		return 0
+	}
+	return *l.InitiatedAt
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *LogPrompt) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetSessionUser returns the SessionUser field if it's non-nil, zero value otherwise.
+func (l *LogPrompt) GetSessionUser() string {
+	if l == nil || l.SessionUser == nil {
+		return ""
+	}
+	return *l.SessionUser
+}
+
+// GetStrategy returns the Strategy field if it's non-nil, zero value otherwise.
+func (l *LogPrompt) GetStrategy() string {
+	if l == nil || l.Strategy == nil {
+		return ""
+	}
+	return *l.Strategy
+}
+
+// String returns a string representation of LogPrompt.
+func (l *LogPrompt) String() string {
+	return Stringify(l)
+}
+
 // GetID returns the ID field if it's non-nil, zero value otherwise.
 func (l *LogStream) GetID() string {
 	if l == nil || l.ID == nil {
@@ -5694,6 +6769,14 @@ func (l *LogStream) GetID() string {
 	return *l.ID
 }
 
+// GetIsPaused returns the IsPaused field if it's non-nil, zero value otherwise.
+func (l *LogStream) GetIsPaused() bool {
+	if l == nil || l.IsPaused == nil {
+		return false
+	}
+	return *l.IsPaused
+}
+
 // GetName returns the Name field if it's non-nil, zero value otherwise.
 func (l *LogStream) GetName() string {
 	if l == nil || l.Name == nil {
@@ -5723,6 +6806,27 @@ func (l *LogStream) String() string {
 	return Stringify(l)
 }
 
+// GetData returns the Data field.
+func (l *LogStreamEvent) GetData() *Log {
+	if l == nil {
+		return nil
+	}
+	return l.Data
+}
+
+// GetLogID returns the LogID field if it's non-nil, zero value otherwise.
+func (l *LogStreamEvent) GetLogID() string {
+	if l == nil || l.LogID == nil {
+		return ""
+	}
+	return *l.LogID
+}
+
+// String returns a string representation of LogStreamEvent.
+func (l *LogStreamEvent) String() string {
+	return Stringify(l)
+}
+
 // GetAccountID returns the AccountID field if it's non-nil, zero value otherwise.
 func (l *LogStreamSinkAmazonEventBridge) GetAccountID() string {
 	if l == nil || l.AccountID == nil {
@@ -5947,6 +7051,11 @@ func (l *LogStreamSinkSumo) String() string {
 	return Stringify(l)
 }
 
+// String returns a string representation of MissingEnvError.
+func (m *MissingEnvError) String() string {
+	return Stringify(m)
+}
+
 // GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
 func (m *MultiFactor) GetEnabled() bool {
 	if m == nil || m.Enabled == nil {
@@ -6353,6 +7462,28 @@ func (o *OrganizationBranding) String() string {
 	return Stringify(o)
 }
 
+// items returns the ClientGrants field, which holds the resources of the page.
+func (o *OrganizationClientGrantList) items() []*ClientGrant {
+	if o == nil {
+		return nil
+	}
+	return o.ClientGrants
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (o *OrganizationClientGrantList) NextPage(ctx context.Context) (*OrganizationClientGrantList, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return nextPage[*OrganizationClientGrantList](ctx, o.List)
+}
+
+// String returns a string representation of OrganizationClientGrantList.
+func (o *OrganizationClientGrantList) String() string {
+	return Stringify(o)
+}
+
 // GetAssignMembershipOnLogin returns the AssignMembershipOnLogin field if it's non-nil, zero value otherwise.
 func (o *OrganizationConnection) GetAssignMembershipOnLogin() bool {
 	if o == nil || o.AssignMembershipOnLogin == nil {
@@ -6403,6 +7534,23 @@ func (o *OrganizationConnectionDetails) String() string {
 	return Stringify(o)
 }
 
+// items returns the OrganizationConnections field, which holds the resources of the page.
+func (o *OrganizationConnectionList) items() []*OrganizationConnection {
+	if o == nil {
+		return nil
+	}
+	return o.OrganizationConnections
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (o *OrganizationConnectionList) NextPage(ctx context.Context) (*OrganizationConnectionList, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return nextPage[*OrganizationConnectionList](ctx, o.List)
+}
+
 // String returns a string representation of OrganizationConnectionList.
 func (o *OrganizationConnectionList) String() string {
 	return Stringify(o)
@@ -6535,11 +7683,45 @@ func (o *OrganizationInvitationInviter) String() string {
 	return Stringify(o)
 }
 
+// items returns the OrganizationInvitations field, which holds the resources of the page.
+func (o *OrganizationInvitationList) items() []*OrganizationInvitation {
+	if o == nil {
+		return nil
+	}
+	return o.OrganizationInvitations
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (o *OrganizationInvitationList) NextPage(ctx context.Context) (*OrganizationInvitationList, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return nextPage[*OrganizationInvitationList](ctx, o.List)
+}
+
 // String returns a string representation of OrganizationInvitationList.
 func (o *OrganizationInvitationList) String() string {
 	return Stringify(o)
 }
 
+// items returns the Organizations field, which holds the resources of the page.
+func (o *OrganizationList) items() []*Organization {
+	if o == nil {
+		return nil
+	}
+	return o.Organizations
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (o *OrganizationList) NextPage(ctx context.Context) (*OrganizationList, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return nextPage[*OrganizationList](ctx, o.List)
+}
+
 // String returns a string representation of OrganizationList.
 func (o *OrganizationList) String() string {
 	return Stringify(o)
@@ -6582,6 +7764,33 @@ func (o *OrganizationMember) String() string {
 	return Stringify(o)
 }
 
+// String returns a string representation of OrganizationMemberImportError.
+func (o *OrganizationMemberImportError) String() string {
+	return Stringify(o)
+}
+
+// String returns a string representation of OrganizationMemberImportResult.
+func (o *OrganizationMemberImportResult) String() string {
+	return Stringify(o)
+}
+
+// items returns the Members field, which holds the resources of the page.
+func (o *OrganizationMemberList) items() []OrganizationMember {
+	if o == nil {
+		return nil
+	}
+	return o.Members
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (o *OrganizationMemberList) NextPage(ctx context.Context) (*OrganizationMemberList, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return nextPage[*OrganizationMemberList](ctx, o.List)
+}
+
 // String returns a string representation of OrganizationMemberList.
 func (o *OrganizationMemberList) String() string {
 	return Stringify(o)
@@ -6616,11 +7825,41 @@ func (o *OrganizationMemberRole) String() string {
 	return Stringify(o)
 }
 
+// items returns the Roles field, which holds the resources of the page.
+func (o *OrganizationMemberRoleList) items() []OrganizationMemberRole {
+	if o == nil {
+		return nil
+	}
+	return o.Roles
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (o *OrganizationMemberRoleList) NextPage(ctx context.Context) (*OrganizationMemberRoleList, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return nextPage[*OrganizationMemberRoleList](ctx, o.List)
+}
+
 // String returns a string representation of OrganizationMemberRoleList.
 func (o *OrganizationMemberRoleList) String() string {
 	return Stringify(o)
 }
 
+// GetOrganization returns the Organization field.
+func (o *OrganizationState) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+	return o.Organization
+}
+
+// String returns a string representation of OrganizationState.
+func (o *OrganizationState) String() string {
+	return Stringify(o)
+}
+
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
 func (p *Permission) GetDescription() string {
 	if p == nil || p.Description == nil {
@@ -6658,6 +7897,23 @@ func (p *Permission) String() string {
 	return Stringify(p)
 }
 
+// items returns the Permissions field, which holds the resources of the page.
+func (p *PermissionList) items() []*Permission {
+	if p == nil {
+		return nil
+	}
+	return p.Permissions
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (p *PermissionList) NextPage(ctx context.Context) (*PermissionList, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return nextPage[*PermissionList](ctx, p.List)
+}
+
 // String returns a string representation of PermissionList.
 func (p *PermissionList) String() string {
 	return Stringify(p)
@@ -6752,6 +8008,24 @@ func (p *Prompt) String() string {
 	return Stringify(p)
 }
 
+// String returns a string representation of RateLimit.
+func (r *RateLimit) String() string {
+	return Stringify(r)
+}
+
+// GetRateLimit returns the RateLimit field.
+func (r *RequestEvent) GetRateLimit() *RateLimit {
+	if r == nil {
+		return nil
+	}
+	return r.RateLimit
+}
+
+// String returns a string representation of RequestEvent.
+func (r *RequestEvent) String() string {
+	return Stringify(r)
+}
+
 // GetAllowOfflineAccess returns the AllowOfflineAccess field if it's non-nil, zero value otherwise.
 func (r *ResourceServer) GetAllowOfflineAccess() bool {
 	if r == nil || r.AllowOfflineAccess == nil {
@@ -6760,6 +8034,22 @@ func (r *ResourceServer) GetAllowOfflineAccess() bool {
 	return *r.AllowOfflineAccess
 }
 
+// GetAuthorizationDetails returns the AuthorizationDetails field if it's non-nil, zero value otherwise.
+func (r *ResourceServer) GetAuthorizationDetails() []ResourceServerAuthorizationDetails {
+	if r == nil || r.AuthorizationDetails == nil {
+		return nil
+	}
+	return *r.AuthorizationDetails
+}
+
+// GetConsentPolicy returns the ConsentPolicy field if it's non-nil, zero value otherwise.
+func (r *ResourceServer) GetConsentPolicy() string {
+	if r == nil || r.ConsentPolicy == nil {
+		return ""
+	}
+	return *r.ConsentPolicy
+}
+
 // GetEnforcePolicies returns the EnforcePolicies field if it's non-nil, zero value otherwise.
 func (r *ResourceServer) GetEnforcePolicies() bool {
 	if r == nil || r.EnforcePolicies == nil {
@@ -6840,6 +8130,14 @@ func (r *ResourceServer) GetTokenDialect() string {
 	return *r.TokenDialect
 }
 
+// GetTokenEncryption returns the TokenEncryption field.
+func (r *ResourceServer) GetTokenEncryption() *ResourceServerTokenEncryption {
+	if r == nil {
+		return nil
+	}
+	return r.TokenEncryption
+}
+
 // GetTokenLifetime returns the TokenLifetime field if it's non-nil, zero value otherwise.
 func (r *ResourceServer) GetTokenLifetime() int {
 	if r == nil || r.TokenLifetime == nil {
@@ -6869,11 +8167,58 @@ func (r *ResourceServer) String() string {
 	return Stringify(r)
 }
 
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *ResourceServerAuthorizationDetails) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
+	}
+	return *r.Type
+}
+
+// String returns a string representation of ResourceServerAuthorizationDetails.
+func (r *ResourceServerAuthorizationDetails) String() string {
+	return Stringify(r)
+}
+
+// items returns the ResourceServers field, which holds the resources of the page.
+func (r *ResourceServerList) items() []*ResourceServer {
+	if r == nil {
+		return nil
+	}
+	return r.ResourceServers
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (r *ResourceServerList) NextPage(ctx context.Context) (*ResourceServerList, error) {
+	if r == nil {
+		return nil, nil
+	}
+	return nextPage[*ResourceServerList](ctx, r.List)
+}
+
 // String returns a string representation of ResourceServerList.
 func (r *ResourceServerList) String() string {
 	return Stringify(r)
 }
 
+// ListAll retrieves all resource servers by going through every page of results.
+//
+// Pages are retrieved concurrently when WithListConcurrency is used.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Resource_Servers/get_resource_servers
+func (m *ResourceServerManager) ListAll(opts ...RequestOption) ([]*ResourceServer, error) {
+	return listAll(m.Management, listPages(m.List, (*ResourceServerList).items), opts...)
+}
+
+// Paginate returns a Paginator over all resource servers, retrieving the pages of
+// results as they're needed.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Resource_Servers/get_resource_servers
+func (m *ResourceServerManager) Paginate(opts ...RequestOption) *Paginator[*ResourceServer] {
+	return NewPaginator(m.List, (*ResourceServerList).items, opts...)
+}
+
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
 func (r *ResourceServerScope) GetDescription() string {
 	if r == nil || r.Description == nil {
@@ -6895,6 +8240,69 @@ func (r *ResourceServerScope) String() string {
 	return Stringify(r)
 }
 
+// GetEncryptionKey returns the EncryptionKey field.
+func (r *ResourceServerTokenEncryption) GetEncryptionKey() *ResourceServerTokenEncryptionKey {
+	if r == nil {
+		return nil
+	}
+	return r.EncryptionKey
+}
+
+// GetFormat returns the Format field if it's non-nil, zero value otherwise.
+func (r *ResourceServerTokenEncryption) GetFormat() string {
+	if r == nil || r.Format == nil {
+		return ""
+	}
+	return *r.Format
+}
+
+// String returns a string representation of ResourceServerTokenEncryption.
+func (r *ResourceServerTokenEncryption) String() string {
+	return Stringify(r)
+}
+
+// GetAlgorithm returns the Algorithm field if it's non-nil, zero value otherwise.
+func (r *ResourceServerTokenEncryptionKey) GetAlgorithm() string {
+	if r == nil || r.Algorithm == nil {
+		return ""
+	}
+	return *r.Algorithm
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (r *ResourceServerTokenEncryptionKey) GetKeyID() string {
+	if r == nil || r.KeyID == nil {
+		return ""
+	}
+	return *r.KeyID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *ResourceServerTokenEncryptionKey) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetPEM returns the PEM field if it's non-nil, zero value otherwise.
+func (r *ResourceServerTokenEncryptionKey) GetPEM() string {
+	if r == nil || r.PEM == nil {
+		return ""
+	}
+	return *r.PEM
+}
+
+// String returns a string representation of ResourceServerTokenEncryptionKey.
+func (r *ResourceServerTokenEncryptionKey) String() string {
+	return Stringify(r)
+}
+
+// String returns a string representation of RetryEvent.
+func (r *RetryEvent) String() string {
+	return Stringify(r)
+}
+
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
 func (r *Role) GetDescription() string {
 	if r == nil || r.Description == nil {
@@ -6924,11 +8332,58 @@ func (r *Role) String() string {
 	return Stringify(r)
 }
 
+// items returns the Roles field, which holds the resources of the page.
+func (r *RoleList) items() []*Role {
+	if r == nil {
+		return nil
+	}
+	return r.Roles
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (r *RoleList) NextPage(ctx context.Context) (*RoleList, error) {
+	if r == nil {
+		return nil, nil
+	}
+	return nextPage[*RoleList](ctx, r.List)
+}
+
 // String returns a string representation of RoleList.
 func (r *RoleList) String() string {
 	return Stringify(r)
 }
 
+// ListAll retrieves all roles by going through every page of results.
+//
+// Pages are retrieved concurrently when WithListConcurrency is used.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Roles/get_roles
+func (m *RoleManager) ListAll(opts ...RequestOption) ([]*Role, error) {
+	return listAll(m.Management, listPages(m.List, (*RoleList).items), opts...)
+}
+
+// Paginate returns a Paginator over all roles, retrieving the pages of
+// results as they're needed.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Roles/get_roles
+func (m *RoleManager) Paginate(opts ...RequestOption) *Paginator[*Role] {
+	return NewPaginator(m.List, (*RoleList).items, opts...)
+}
+
+// GetRole returns the Role field.
+func (r *RoleState) GetRole() *Role {
+	if r == nil {
+		return nil
+	}
+	return r.Role
+}
+
+// String returns a string representation of RoleState.
+func (r *RoleState) String() string {
+	return Stringify(r)
+}
+
 // GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
 func (r *Rule) GetEnabled() bool {
 	if r == nil || r.Enabled == nil {
@@ -6995,6 +8450,23 @@ func (r *RuleConfig) String() string {
 	return Stringify(r)
 }
 
+// items returns the Rules field, which holds the resources of the page.
+func (r *RuleList) items() []*Rule {
+	if r == nil {
+		return nil
+	}
+	return r.Rules
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (r *RuleList) NextPage(ctx context.Context) (*RuleList, error) {
+	if r == nil {
+		return nil, nil
+	}
+	return nextPage[*RuleList](ctx, r.List)
+}
+
 // String returns a string representation of RuleList.
 func (r *RuleList) String() string {
 	return Stringify(r)
@@ -7017,17 +8489,17 @@ func (s *SigningKey) GetCurrent() bool {
 }
 
 // GetCurrentSince returns the CurrentSince field if it's non-nil, zero value otherwise.
-func (s *SigningKey) GetCurrentSince() time.Time {
+func (s *SigningKey) GetCurrentSince() Timestamp {
 	if s == nil || s.CurrentSince == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *s.CurrentSince
 }
 
 // GetCurrentUntil returns the CurrentUntil field if it's non-nil, zero value otherwise.
-func (s *SigningKey) GetCurrentUntil() time.Time {
+func (s *SigningKey) GetCurrentUntil() Timestamp {
 	if s == nil || s.CurrentUntil == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *s.CurrentUntil
 }
@@ -7081,9 +8553,9 @@ func (s *SigningKey) GetRevoked() bool {
 }
 
 // GetRevokedAt returns the RevokedAt field if it's non-nil, zero value otherwise.
-func (s *SigningKey) GetRevokedAt() time.Time {
+func (s *SigningKey) GetRevokedAt() Timestamp {
 	if s == nil || s.RevokedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *s.RevokedAt
 }
@@ -7101,6 +8573,11 @@ func (s *SigningKey) String() string {
 	return Stringify(s)
 }
 
+// String returns a string representation of SigningKeyRotationEvent.
+func (s *SigningKeyRotationEvent) String() string {
+	return Stringify(s)
+}
+
 // GetPreLogin returns the PreLogin field.
 func (s *Stage) GetPreLogin() *PreLogin {
 	if s == nil {
@@ -7122,6 +8599,11 @@ func (s *Stage) String() string {
 	return Stringify(s)
 }
 
+// String returns a string representation of StatTotals.
+func (s *StatTotals) String() string {
+	return Stringify(s)
+}
+
 // GetAllowList returns the AllowList field if it's non-nil, zero value otherwise.
 func (s *SuspiciousIPThrottling) GetAllowList() []string {
 	if s == nil || s.AllowList == nil {
@@ -7159,6 +8641,14 @@ func (s *SuspiciousIPThrottling) String() string {
 	return Stringify(s)
 }
 
+// GetACRValuesSupported returns the ACRValuesSupported field if it's non-nil, zero value otherwise.
+func (t *Tenant) GetACRValuesSupported() []string {
+	if t == nil || t.ACRValuesSupported == nil {
+		return nil
+	}
+	return *t.ACRValuesSupported
+}
+
 // GetAllowedLogoutURLs returns the AllowedLogoutURLs field if it's non-nil, zero value otherwise.
 func (t *Tenant) GetAllowedLogoutURLs() []string {
 	if t == nil || t.AllowedLogoutURLs == nil {
@@ -7255,6 +8745,14 @@ func (t *Tenant) GetIdleSessionLifetime() float64 {
 	return *t.IdleSessionLifetime
 }
 
+// GetMTLS returns the MTLS field.
+func (t *Tenant) GetMTLS() *TenantMTLSConfiguration {
+	if t == nil {
+		return nil
+	}
+	return t.MTLS
+}
+
 // GetPictureURL returns the PictureURL field if it's non-nil, zero value otherwise.
 func (t *Tenant) GetPictureURL() string {
 	if t == nil || t.PictureURL == nil {
@@ -7263,6 +8761,14 @@ func (t *Tenant) GetPictureURL() string {
 	return *t.PictureURL
 }
 
+// GetPushedAuthorizationRequestsSupported returns the PushedAuthorizationRequestsSupported field if it's non-nil, zero value otherwise.
+func (t *Tenant) GetPushedAuthorizationRequestsSupported() bool {
+	if t == nil || t.PushedAuthorizationRequestsSupported == nil {
+		return false
+	}
+	return *t.PushedAuthorizationRequestsSupported
+}
+
 // GetSandboxVersion returns the SandboxVersion field if it's non-nil, zero value otherwise.
 func (t *Tenant) GetSandboxVersion() string {
 	if t == nil || t.SandboxVersion == nil {
@@ -7295,6 +8801,14 @@ func (t *Tenant) GetSessionLifetime() float64 {
 	return *t.SessionLifetime
 }
 
+// GetSessions returns the Sessions field.
+func (t *Tenant) GetSessions() *TenantSessions {
+	if t == nil {
+		return nil
+	}
+	return t.Sessions
+}
+
 // GetSupportEmail returns the SupportEmail field if it's non-nil, zero value otherwise.
 func (t *Tenant) GetSupportEmail() string {
 	if t == nil || t.SupportEmail == nil {
@@ -7345,6 +8859,16 @@ func (t *TenantChangePassword) String() string {
 	return Stringify(t)
 }
 
+// String returns a string representation of TenantConfig with any secrets redacted.
+func (t *TenantConfig) String() string {
+	return stringifyRedacted(t)
+}
+
+// GoString returns a string representation of TenantConfig with any secrets redacted.
+func (t *TenantConfig) GoString() string {
+	return t.String()
+}
+
 // GetCharset returns the Charset field if it's non-nil, zero value otherwise.
 func (t *TenantDeviceFlow) GetCharset() string {
 	if t == nil || t.Charset == nil {
@@ -7579,6 +9103,14 @@ func (t *TenantFlags) GetNoDisclosureEnterpriseConnections() bool {
 	return *t.NoDisclosureEnterpriseConnections
 }
 
+// GetRemoveAlgFromJWKS returns the RemoveAlgFromJWKS field if it's non-nil, zero value otherwise.
+func (t *TenantFlags) GetRemoveAlgFromJWKS() bool {
+	if t == nil || t.RemoveAlgFromJWKS == nil {
+		return false
+	}
+	return *t.RemoveAlgFromJWKS
+}
+
 // GetRequirePushedAuthorizationRequests returns the RequirePushedAuthorizationRequests field if it's non-nil, zero value otherwise.
 func (t *TenantFlags) GetRequirePushedAuthorizationRequests() bool {
 	if t == nil || t.RequirePushedAuthorizationRequests == nil {
@@ -7637,6 +9169,19 @@ func (t *TenantGuardianMFAPage) String() string {
 	return Stringify(t)
 }
 
+// GetEnableEndpointAliases returns the EnableEndpointAliases field if it's non-nil, zero value otherwise.
+func (t *TenantMTLSConfiguration) GetEnableEndpointAliases() bool {
+	if t == nil || t.EnableEndpointAliases == nil {
+		return false
+	}
+	return *t.EnableEndpointAliases
+}
+
+// String returns a string representation of TenantMTLSConfiguration.
+func (t *TenantMTLSConfiguration) String() string {
+	return Stringify(t)
+}
+
 // GetMode returns the Mode field if it's non-nil, zero value otherwise.
 func (t *TenantSessionCookie) GetMode() string {
 	if t == nil || t.Mode == nil {
@@ -7650,6 +9195,19 @@ func (t *TenantSessionCookie) String() string {
 	return Stringify(t)
 }
 
+// GetOIDCLogoutPromptEnabled returns the OIDCLogoutPromptEnabled field if it's non-nil, zero value otherwise.
+func (t *TenantSessions) GetOIDCLogoutPromptEnabled() bool {
+	if t == nil || t.OIDCLogoutPromptEnabled == nil {
+		return false
+	}
+	return *t.OIDCLogoutPromptEnabled
+}
+
+// String returns a string representation of TenantSessions.
+func (t *TenantSessions) String() string {
+	return Stringify(t)
+}
+
 // GetColors returns the Colors field.
 func (t *TenantUniversalLogin) GetColors() *TenantUniversalLoginColors {
 	if t == nil {
@@ -7802,9 +9360,9 @@ func (u *User) GetConnection() string {
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (u *User) GetCreatedAt() time.Time {
+func (u *User) GetCreatedAt() Timestamp {
 	if u == nil || u.CreatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *u.CreatedAt
 }
@@ -7866,17 +9424,17 @@ func (u *User) GetLastIP() string {
 }
 
 // GetLastLogin returns the LastLogin field if it's non-nil, zero value otherwise.
-func (u *User) GetLastLogin() time.Time {
+func (u *User) GetLastLogin() Timestamp {
 	if u == nil || u.LastLogin == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *u.LastLogin
 }
 
 // GetLastPasswordReset returns the LastPasswordReset field if it's non-nil, zero value otherwise.
-func (u *User) GetLastPasswordReset() time.Time {
+func (u *User) GetLastPasswordReset() Timestamp {
 	if u == nil || u.LastPasswordReset == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *u.LastPasswordReset
 }
@@ -7945,14 +9503,6 @@ func (u *User) GetPhoneVerified() bool {
 	return *u.PhoneVerified
 }
 
-// GetPicture returns the Picture field if it's non-nil, zero value otherwise.
-func (u *User) GetPicture() string {
-	if u == nil || u.Picture == nil {
-		return ""
-	}
-	return *u.Picture
-}
-
 // GetScreenName returns the ScreenName field if it's non-nil, zero value otherwise.
 func (u *User) GetScreenName() string {
 	if u == nil || u.ScreenName == nil {
@@ -7962,9 +9512,9 @@ func (u *User) GetScreenName() string {
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (u *User) GetUpdatedAt() time.Time {
+func (u *User) GetUpdatedAt() Timestamp {
 	if u == nil || u.UpdatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *u.UpdatedAt
 }
@@ -8019,6 +9569,11 @@ func (u *UserBlock) String() string {
 	return Stringify(u)
 }
 
+// String returns a string representation of UserDeletion.
+func (u *UserDeletion) String() string {
+	return Stringify(u)
+}
+
 // GetAuthMethod returns the AuthMethod field if it's non-nil, zero value otherwise.
 func (u *UserEnrollment) GetAuthMethod() string {
 	if u == nil || u.AuthMethod == nil {
@@ -8028,9 +9583,9 @@ func (u *UserEnrollment) GetAuthMethod() string {
 }
 
 // GetEnrolledAt returns the EnrolledAt field if it's non-nil, zero value otherwise.
-func (u *UserEnrollment) GetEnrolledAt() time.Time {
+func (u *UserEnrollment) GetEnrolledAt() Timestamp {
 	if u == nil || u.EnrolledAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *u.EnrolledAt
 }
@@ -8052,9 +9607,9 @@ func (u *UserEnrollment) GetIdentifier() string {
 }
 
 // GetLastAuth returns the LastAuth field if it's non-nil, zero value otherwise.
-func (u *UserEnrollment) GetLastAuth() time.Time {
+func (u *UserEnrollment) GetLastAuth() Timestamp {
 	if u == nil || u.LastAuth == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *u.LastAuth
 }
@@ -8194,11 +9749,53 @@ func (u *UserIdentityLink) String() string {
 	return Stringify(u)
 }
 
+// String returns a string representation of UserImportResult.
+func (u *UserImportResult) String() string {
+	return Stringify(u)
+}
+
+// items returns the Users field, which holds the resources of the page.
+func (u *UserList) items() []*User {
+	if u == nil {
+		return nil
+	}
+	return u.Users
+}
+
+// NextPage retrieves the page following this one the way this page was
+// retrieved, or returns nil if it's the last page.
+func (u *UserList) NextPage(ctx context.Context) (*UserList, error) {
+	if u == nil {
+		return nil, nil
+	}
+	return nextPage[*UserList](ctx, u.List)
+}
+
 // String returns a string representation of UserList.
 func (u *UserList) String() string {
 	return Stringify(u)
 }
 
+// ListAll retrieves all users by going through every page of results.
+//
+// Pages are retrieved concurrently when WithListConcurrency is used.
+//
+// Note that the Management API returns at most the first 1000 users of a
+// search, use a user export job to retrieve more than that.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Users/get_users
+func (m *UserManager) ListAll(opts ...RequestOption) ([]*User, error) {
+	return listAll(m.Management, listPages(m.List, (*UserList).items), opts...)
+}
+
+// Paginate returns a Paginator over all users, retrieving the pages of
+// results as they're needed.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Users/get_users
+func (m *UserManager) Paginate(opts ...RequestOption) *Paginator[*User] {
+	return NewPaginator(m.List, (*UserList).items, opts...)
+}
+
 // GetRecoveryCode returns the RecoveryCode field if it's non-nil, zero value otherwise.
 func (u *UserRecoveryCode) GetRecoveryCode() string {
 	if u == nil || u.RecoveryCode == nil {
@@ -8211,3 +9808,8 @@ func (u *UserRecoveryCode) GetRecoveryCode() string {
 func (u *UserRecoveryCode) String() string {
 	return Stringify(u)
 }
+
+// String returns a string representation of ValidationError.
+func (v *ValidationError) String() string {
+	return Stringify(v)
+}
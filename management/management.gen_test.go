@@ -4,13 +4,13 @@
 package management
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
-	"time"
 )
 
 func TestAction_GetBuiltAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &Action{BuiltAt: &zeroValue}
 	a.GetBuiltAt()
 	a = &Action{}
@@ -30,7 +30,7 @@ func TestAction_GetCode(tt *testing.T) {
 }
 
 func TestAction_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &Action{CreatedAt: &zeroValue}
 	a.GetCreatedAt()
 	a = &Action{}
@@ -107,7 +107,7 @@ func TestAction_GetStatus(tt *testing.T) {
 }
 
 func TestAction_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &Action{UpdatedAt: &zeroValue}
 	a.GetUpdatedAt()
 	a = &Action{}
@@ -132,7 +132,7 @@ func TestActionBinding_GetAction(tt *testing.T) {
 }
 
 func TestActionBinding_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &ActionBinding{CreatedAt: &zeroValue}
 	a.GetCreatedAt()
 	a = &ActionBinding{}
@@ -179,7 +179,7 @@ func TestActionBinding_GetTriggerID(tt *testing.T) {
 }
 
 func TestActionBinding_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &ActionBinding{UpdatedAt: &zeroValue}
 	a.GetUpdatedAt()
 	a = &ActionBinding{}
@@ -196,6 +196,24 @@ func TestActionBinding_String(t *testing.T) {
 	}
 }
 
+func TestActionBindingList_items(tt *testing.T) {
+	a := &ActionBindingList{}
+	a.items()
+	a = nil
+	a.items()
+}
+
+func TestActionBindingList_NextPage(tt *testing.T) {
+	a := &ActionBindingList{}
+	if next, err := a.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	a = nil
+	if next, err := a.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestActionBindingList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ActionBindingList{}
@@ -271,7 +289,7 @@ func TestActionDependency_String(t *testing.T) {
 }
 
 func TestActionExecution_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &ActionExecution{CreatedAt: &zeroValue}
 	a.GetCreatedAt()
 	a = &ActionExecution{}
@@ -311,7 +329,7 @@ func TestActionExecution_GetTriggerID(tt *testing.T) {
 }
 
 func TestActionExecution_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &ActionExecution{UpdatedAt: &zeroValue}
 	a.GetUpdatedAt()
 	a = &ActionExecution{}
@@ -339,7 +357,7 @@ func TestActionExecutionResult_GetActionName(tt *testing.T) {
 }
 
 func TestActionExecutionResult_GetEndedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &ActionExecutionResult{EndedAt: &zeroValue}
 	a.GetEndedAt()
 	a = &ActionExecutionResult{}
@@ -349,7 +367,7 @@ func TestActionExecutionResult_GetEndedAt(tt *testing.T) {
 }
 
 func TestActionExecutionResult_GetStartedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &ActionExecutionResult{StartedAt: &zeroValue}
 	a.GetStartedAt()
 	a = &ActionExecutionResult{}
@@ -366,6 +384,24 @@ func TestActionExecutionResult_String(t *testing.T) {
 	}
 }
 
+func TestActionList_items(tt *testing.T) {
+	a := &ActionList{}
+	a.items()
+	a = nil
+	a.items()
+}
+
+func TestActionList_NextPage(tt *testing.T) {
+	a := &ActionList{}
+	if next, err := a.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	a = nil
+	if next, err := a.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestActionList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ActionList{}
@@ -375,7 +411,7 @@ func TestActionList_String(t *testing.T) {
 }
 
 func TestActionLogSession_GetExpires(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &ActionLogSession{Expires: &zeroValue}
 	a.GetExpires()
 	a = &ActionLogSession{}
@@ -410,6 +446,31 @@ func TestActionLogSessionFilter_String(t *testing.T) {
 	}
 }
 
+func TestActionMigration_GetAction(tt *testing.T) {
+	a := &ActionMigration{}
+	a.GetAction()
+	a = nil
+	a.GetAction()
+}
+
+func TestActionMigration_GetBinding(tt *testing.T) {
+	a := &ActionMigration{}
+	a.GetBinding()
+	a = nil
+	a.GetBinding()
+}
+
+func TestActionMigration_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ActionMigration{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestActionSecret_GetName(tt *testing.T) {
 	var zeroValue string
 	a := &ActionSecret{Name: &zeroValue}
@@ -421,7 +482,7 @@ func TestActionSecret_GetName(tt *testing.T) {
 }
 
 func TestActionSecret_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &ActionSecret{UpdatedAt: &zeroValue}
 	a.GetUpdatedAt()
 	a = &ActionSecret{}
@@ -502,7 +563,7 @@ func TestActionVersion_GetAction(tt *testing.T) {
 }
 
 func TestActionVersion_GetBuiltAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &ActionVersion{BuiltAt: &zeroValue}
 	a.GetBuiltAt()
 	a = &ActionVersion{}
@@ -522,7 +583,7 @@ func TestActionVersion_GetCode(tt *testing.T) {
 }
 
 func TestActionVersion_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &ActionVersion{CreatedAt: &zeroValue}
 	a.GetCreatedAt()
 	a = &ActionVersion{}
@@ -552,7 +613,7 @@ func TestActionVersion_GetStatus(tt *testing.T) {
 }
 
 func TestActionVersion_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &ActionVersion{UpdatedAt: &zeroValue}
 	a.GetUpdatedAt()
 	a = &ActionVersion{}
@@ -607,6 +668,24 @@ func TestActionVersionError_String(t *testing.T) {
 	}
 }
 
+func TestActionVersionList_items(tt *testing.T) {
+	a := &ActionVersionList{}
+	a.items()
+	a = nil
+	a.items()
+}
+
+func TestActionVersionList_NextPage(tt *testing.T) {
+	a := &ActionVersionList{}
+	if next, err := a.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	a = nil
+	if next, err := a.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestActionVersionList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ActionVersionList{}
@@ -615,6 +694,30 @@ func TestActionVersionList_String(t *testing.T) {
 	}
 }
 
+func TestAmbiguousNameError_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &AmbiguousNameError{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestAPIError_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &APIError{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestAuditEvent_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &AuditEvent{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestAuthenticationMethod_GetAuthenticationMethods(tt *testing.T) {
 	var zeroValue []AuthenticationMethodReference
 	a := &AuthenticationMethod{AuthenticationMethods: &zeroValue}
@@ -636,7 +739,7 @@ func TestAuthenticationMethod_GetConfirmed(tt *testing.T) {
 }
 
 func TestAuthenticationMethod_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &AuthenticationMethod{CreatedAt: &zeroValue}
 	a.GetCreatedAt()
 	a = &AuthenticationMethod{}
@@ -656,7 +759,7 @@ func TestAuthenticationMethod_GetEmail(tt *testing.T) {
 }
 
 func TestAuthenticationMethod_GetEnrolledAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &AuthenticationMethod{EnrolledAt: &zeroValue}
 	a.GetEnrolledAt()
 	a = &AuthenticationMethod{}
@@ -686,7 +789,7 @@ func TestAuthenticationMethod_GetKeyID(tt *testing.T) {
 }
 
 func TestAuthenticationMethod_GetLastAuthedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	a := &AuthenticationMethod{LastAuthedAt: &zeroValue}
 	a.GetLastAuthedAt()
 	a = &AuthenticationMethod{}
@@ -783,6 +886,24 @@ func TestAuthenticationMethod_String(t *testing.T) {
 	}
 }
 
+func TestAuthenticationMethodList_items(tt *testing.T) {
+	a := &AuthenticationMethodList{}
+	a.items()
+	a = nil
+	a.items()
+}
+
+func TestAuthenticationMethodList_NextPage(tt *testing.T) {
+	a := &AuthenticationMethodList{}
+	if next, err := a.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	a = nil
+	if next, err := a.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestAuthenticationMethodList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &AuthenticationMethodList{}
@@ -827,6 +948,74 @@ func TestBlacklistToken_String(t *testing.T) {
 	}
 }
 
+func TestBotDetection_GetAllowList(tt *testing.T) {
+	var zeroValue []string
+	b := &BotDetection{AllowList: &zeroValue}
+	b.GetAllowList()
+	b = &BotDetection{}
+	b.GetAllowList()
+	b = nil
+	b.GetAllowList()
+}
+
+func TestBotDetection_GetBotDetectionLevel(tt *testing.T) {
+	var zeroValue string
+	b := &BotDetection{BotDetectionLevel: &zeroValue}
+	b.GetBotDetectionLevel()
+	b = &BotDetection{}
+	b.GetBotDetectionLevel()
+	b = nil
+	b.GetBotDetectionLevel()
+}
+
+func TestBotDetection_GetChallengePasswordlessPolicy(tt *testing.T) {
+	var zeroValue string
+	b := &BotDetection{ChallengePasswordlessPolicy: &zeroValue}
+	b.GetChallengePasswordlessPolicy()
+	b = &BotDetection{}
+	b.GetChallengePasswordlessPolicy()
+	b = nil
+	b.GetChallengePasswordlessPolicy()
+}
+
+func TestBotDetection_GetChallengePasswordPolicy(tt *testing.T) {
+	var zeroValue string
+	b := &BotDetection{ChallengePasswordPolicy: &zeroValue}
+	b.GetChallengePasswordPolicy()
+	b = &BotDetection{}
+	b.GetChallengePasswordPolicy()
+	b = nil
+	b.GetChallengePasswordPolicy()
+}
+
+func TestBotDetection_GetChallengePasswordResetPolicy(tt *testing.T) {
+	var zeroValue string
+	b := &BotDetection{ChallengePasswordResetPolicy: &zeroValue}
+	b.GetChallengePasswordResetPolicy()
+	b = &BotDetection{}
+	b.GetChallengePasswordResetPolicy()
+	b = nil
+	b.GetChallengePasswordResetPolicy()
+}
+
+func TestBotDetection_GetMonitoringModeEnabled(tt *testing.T) {
+	var zeroValue bool
+	b := &BotDetection{MonitoringModeEnabled: &zeroValue}
+	b.GetMonitoringModeEnabled()
+	b = &BotDetection{}
+	b.GetMonitoringModeEnabled()
+	b = nil
+	b.GetMonitoringModeEnabled()
+}
+
+func TestBotDetection_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BotDetection{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestBranding_GetColors(tt *testing.T) {
 	b := &Branding{}
 	b.GetColors()
@@ -1230,6 +1419,220 @@ func TestBruteForceProtection_String(t *testing.T) {
 	}
 }
 
+func TestCaptcha_GetActiveProviderID(tt *testing.T) {
+	var zeroValue string
+	c := &Captcha{ActiveProviderID: &zeroValue}
+	c.GetActiveProviderID()
+	c = &Captcha{}
+	c.GetActiveProviderID()
+	c = nil
+	c.GetActiveProviderID()
+}
+
+func TestCaptcha_GetArkose(tt *testing.T) {
+	c := &Captcha{}
+	c.GetArkose()
+	c = nil
+	c.GetArkose()
+}
+
+func TestCaptcha_GetAuthChallenge(tt *testing.T) {
+	c := &Captcha{}
+	c.GetAuthChallenge()
+	c = nil
+	c.GetAuthChallenge()
+}
+
+func TestCaptcha_GetFriendlyCaptcha(tt *testing.T) {
+	c := &Captcha{}
+	c.GetFriendlyCaptcha()
+	c = nil
+	c.GetFriendlyCaptcha()
+}
+
+func TestCaptcha_GetHCaptcha(tt *testing.T) {
+	c := &Captcha{}
+	c.GetHCaptcha()
+	c = nil
+	c.GetHCaptcha()
+}
+
+func TestCaptcha_GetRecaptchaEnterprise(tt *testing.T) {
+	c := &Captcha{}
+	c.GetRecaptchaEnterprise()
+	c = nil
+	c.GetRecaptchaEnterprise()
+}
+
+func TestCaptcha_GetRecaptchaV2(tt *testing.T) {
+	c := &Captcha{}
+	c.GetRecaptchaV2()
+	c = nil
+	c.GetRecaptchaV2()
+}
+
+func TestCaptcha_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &Captcha{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestCaptchaArkose_GetClientSubdomain(tt *testing.T) {
+	var zeroValue string
+	c := &CaptchaArkose{ClientSubdomain: &zeroValue}
+	c.GetClientSubdomain()
+	c = &CaptchaArkose{}
+	c.GetClientSubdomain()
+	c = nil
+	c.GetClientSubdomain()
+}
+
+func TestCaptchaArkose_GetFailOpen(tt *testing.T) {
+	var zeroValue bool
+	c := &CaptchaArkose{FailOpen: &zeroValue}
+	c.GetFailOpen()
+	c = &CaptchaArkose{}
+	c.GetFailOpen()
+	c = nil
+	c.GetFailOpen()
+}
+
+func TestCaptchaArkose_GetSecret(tt *testing.T) {
+	var zeroValue string
+	c := &CaptchaArkose{Secret: &zeroValue}
+	c.GetSecret()
+	c = &CaptchaArkose{}
+	c.GetSecret()
+	c = nil
+	c.GetSecret()
+}
+
+func TestCaptchaArkose_GetSiteKey(tt *testing.T) {
+	var zeroValue string
+	c := &CaptchaArkose{SiteKey: &zeroValue}
+	c.GetSiteKey()
+	c = &CaptchaArkose{}
+	c.GetSiteKey()
+	c = nil
+	c.GetSiteKey()
+}
+
+func TestCaptchaArkose_GetVerifySubdomain(tt *testing.T) {
+	var zeroValue string
+	c := &CaptchaArkose{VerifySubdomain: &zeroValue}
+	c.GetVerifySubdomain()
+	c = &CaptchaArkose{}
+	c.GetVerifySubdomain()
+	c = nil
+	c.GetVerifySubdomain()
+}
+
+func TestCaptchaArkose_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CaptchaArkose{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestCaptchaAuthChallenge_GetFailOpen(tt *testing.T) {
+	var zeroValue bool
+	c := &CaptchaAuthChallenge{FailOpen: &zeroValue}
+	c.GetFailOpen()
+	c = &CaptchaAuthChallenge{}
+	c.GetFailOpen()
+	c = nil
+	c.GetFailOpen()
+}
+
+func TestCaptchaAuthChallenge_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CaptchaAuthChallenge{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestCaptchaProvider_GetSecret(tt *testing.T) {
+	var zeroValue string
+	c := &CaptchaProvider{Secret: &zeroValue}
+	c.GetSecret()
+	c = &CaptchaProvider{}
+	c.GetSecret()
+	c = nil
+	c.GetSecret()
+}
+
+func TestCaptchaProvider_GetSiteKey(tt *testing.T) {
+	var zeroValue string
+	c := &CaptchaProvider{SiteKey: &zeroValue}
+	c.GetSiteKey()
+	c = &CaptchaProvider{}
+	c.GetSiteKey()
+	c = nil
+	c.GetSiteKey()
+}
+
+func TestCaptchaProvider_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CaptchaProvider{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestCaptchaRecaptchaEnterprise_GetAPIKey(tt *testing.T) {
+	var zeroValue string
+	c := &CaptchaRecaptchaEnterprise{APIKey: &zeroValue}
+	c.GetAPIKey()
+	c = &CaptchaRecaptchaEnterprise{}
+	c.GetAPIKey()
+	c = nil
+	c.GetAPIKey()
+}
+
+func TestCaptchaRecaptchaEnterprise_GetProjectID(tt *testing.T) {
+	var zeroValue string
+	c := &CaptchaRecaptchaEnterprise{ProjectID: &zeroValue}
+	c.GetProjectID()
+	c = &CaptchaRecaptchaEnterprise{}
+	c.GetProjectID()
+	c = nil
+	c.GetProjectID()
+}
+
+func TestCaptchaRecaptchaEnterprise_GetSiteKey(tt *testing.T) {
+	var zeroValue string
+	c := &CaptchaRecaptchaEnterprise{SiteKey: &zeroValue}
+	c.GetSiteKey()
+	c = &CaptchaRecaptchaEnterprise{}
+	c.GetSiteKey()
+	c = nil
+	c.GetSiteKey()
+}
+
+func TestCaptchaRecaptchaEnterprise_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CaptchaRecaptchaEnterprise{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestClient_GetAllowedClients(tt *testing.T) {
 	var zeroValue []string
 	c := &Client{AllowedClients: &zeroValue}
@@ -1578,6 +1981,9 @@ func TestClient_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestClientAuthenticationMethods_GetPrivateKeyJWT(tt *testing.T) {
@@ -1595,6 +2001,16 @@ func TestClientAuthenticationMethods_String(t *testing.T) {
 	}
 }
 
+func TestClientGrant_GetAllowAnyOrganization(tt *testing.T) {
+	var zeroValue bool
+	c := &ClientGrant{AllowAnyOrganization: &zeroValue}
+	c.GetAllowAnyOrganization()
+	c = &ClientGrant{}
+	c.GetAllowAnyOrganization()
+	c = nil
+	c.GetAllowAnyOrganization()
+}
+
 func TestClientGrant_GetAudience(tt *testing.T) {
 	var zeroValue string
 	c := &ClientGrant{Audience: &zeroValue}
@@ -1625,6 +2041,16 @@ func TestClientGrant_GetID(tt *testing.T) {
 	c.GetID()
 }
 
+func TestClientGrant_GetOrganizationUsage(tt *testing.T) {
+	var zeroValue string
+	c := &ClientGrant{OrganizationUsage: &zeroValue}
+	c.GetOrganizationUsage()
+	c = &ClientGrant{}
+	c.GetOrganizationUsage()
+	c = nil
+	c.GetOrganizationUsage()
+}
+
 func TestClientGrant_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ClientGrant{}
@@ -1633,6 +2059,24 @@ func TestClientGrant_String(t *testing.T) {
 	}
 }
 
+func TestClientGrantList_items(tt *testing.T) {
+	c := &ClientGrantList{}
+	c.items()
+	c = nil
+	c.items()
+}
+
+func TestClientGrantList_NextPage(tt *testing.T) {
+	c := &ClientGrantList{}
+	if next, err := c.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	c = nil
+	if next, err := c.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestClientGrantList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ClientGrantList{}
@@ -1689,6 +2133,24 @@ func TestClientJWTConfiguration_String(t *testing.T) {
 	}
 }
 
+func TestClientList_items(tt *testing.T) {
+	c := &ClientList{}
+	c.items()
+	c = nil
+	c.items()
+}
+
+func TestClientList_NextPage(tt *testing.T) {
+	c := &ClientList{}
+	if next, err := c.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	c = nil
+	if next, err := c.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestClientList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ClientList{}
@@ -1893,6 +2355,17 @@ func TestClientRefreshToken_String(t *testing.T) {
 	}
 }
 
+func TestConfig_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &Config{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestConnection_GetDisplayName(tt *testing.T) {
 	var zeroValue string
 	c := &Connection{DisplayName: &zeroValue}
@@ -1999,6 +2472,9 @@ func TestConnection_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionGatewayAuthentication_GetAudience(tt *testing.T) {
@@ -2057,12 +2533,33 @@ func TestConnectionGatewayAuthentication_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
-}
-
-func TestConnectionList_String(t *testing.T) {
-	var rawJSON json.RawMessage
-	v := &ConnectionList{}
-	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestConnectionList_items(tt *testing.T) {
+	c := &ConnectionList{}
+	c.items()
+	c = nil
+	c.items()
+}
+
+func TestConnectionList_NextPage(tt *testing.T) {
+	c := &ConnectionList{}
+	if next, err := c.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	c = nil
+	if next, err := c.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
+func TestConnectionList_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ConnectionList{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
@@ -2527,6 +3024,9 @@ func TestConnectionOptionsApple_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsAzureAD_GetAdmin(tt *testing.T) {
@@ -2785,6 +3285,9 @@ func TestConnectionOptionsAzureAD_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsEmail_GetBruteForceProtection(tt *testing.T) {
@@ -3373,6 +3876,9 @@ func TestConnectionOptionsFacebook_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsGitHub_GetAdminOrg(tt *testing.T) {
@@ -3621,6 +4127,9 @@ func TestConnectionOptionsGitHub_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsGoogleApps_GetAdmin(tt *testing.T) {
@@ -3779,6 +4288,9 @@ func TestConnectionOptionsGoogleApps_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsGoogleOAuth2_GetAdsenseManagement(tt *testing.T) {
@@ -4137,6 +4649,9 @@ func TestConnectionOptionsGoogleOAuth2_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsLinkedin_GetBasicProfile(tt *testing.T) {
@@ -4225,6 +4740,9 @@ func TestConnectionOptionsLinkedin_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsOAuth2_GetAuthorizationURL(tt *testing.T) {
@@ -4333,6 +4851,9 @@ func TestConnectionOptionsOAuth2_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsOIDC_GetAuthorizationEndpoint(tt *testing.T) {
@@ -4491,6 +5012,9 @@ func TestConnectionOptionsOIDC_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsOkta_GetAuthorizationEndpoint(tt *testing.T) {
@@ -4629,6 +5153,9 @@ func TestConnectionOptionsOkta_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsOTP_GetLength(tt *testing.T) {
@@ -4792,6 +5319,9 @@ func TestConnectionOptionsPingFederate_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsSalesforce_GetClientID(tt *testing.T) {
@@ -4860,6 +5390,9 @@ func TestConnectionOptionsSalesforce_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsSAML_GetCert(tt *testing.T) {
@@ -5092,6 +5625,9 @@ func TestConnectionOptionsSAML_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsSAMLIdpInitiated_GetClientAuthorizeQuery(tt *testing.T) {
@@ -5188,6 +5724,9 @@ func TestConnectionOptionsSAMLSigningKey_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsSMS_GetBruteForceProtection(tt *testing.T) {
@@ -5330,6 +5869,9 @@ func TestConnectionOptionsSMS_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestConnectionOptionsWindowsLive_GetCalendars(tt *testing.T) {
@@ -5608,6 +6150,9 @@ func TestConnectionOptionsWindowsLive_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestCreateEnrollmentTicket_String(t *testing.T) {
@@ -5629,7 +6174,7 @@ func TestCredential_GetAlgorithm(tt *testing.T) {
 }
 
 func TestCredential_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	c := &Credential{CreatedAt: &zeroValue}
 	c.GetCreatedAt()
 	c = &Credential{}
@@ -5649,7 +6194,7 @@ func TestCredential_GetCredentialType(tt *testing.T) {
 }
 
 func TestCredential_GetExpiresAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	c := &Credential{ExpiresAt: &zeroValue}
 	c.GetExpiresAt()
 	c = &Credential{}
@@ -5709,7 +6254,7 @@ func TestCredential_GetPEM(tt *testing.T) {
 }
 
 func TestCredential_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	c := &Credential{UpdatedAt: &zeroValue}
 	c.GetUpdatedAt()
 	c = &Credential{}
@@ -5724,6 +6269,24 @@ func TestCredential_String(t *testing.T) {
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestCredentialRotationEvent_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CredentialRotationEvent{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestCustomDomain_GetCertificate(tt *testing.T) {
+	c := &CustomDomain{}
+	c.GetCertificate()
+	c = nil
+	c.GetCertificate()
 }
 
 func TestCustomDomain_GetCNAMEAPIKey(tt *testing.T) {
@@ -5841,6 +6404,62 @@ func TestCustomDomain_String(t *testing.T) {
 	}
 }
 
+func TestCustomDomainCertificate_GetCertificateAuthority(tt *testing.T) {
+	var zeroValue string
+	c := &CustomDomainCertificate{CertificateAuthority: &zeroValue}
+	c.GetCertificateAuthority()
+	c = &CustomDomainCertificate{}
+	c.GetCertificateAuthority()
+	c = nil
+	c.GetCertificateAuthority()
+}
+
+func TestCustomDomainCertificate_GetErrorMsg(tt *testing.T) {
+	var zeroValue string
+	c := &CustomDomainCertificate{ErrorMsg: &zeroValue}
+	c.GetErrorMsg()
+	c = &CustomDomainCertificate{}
+	c.GetErrorMsg()
+	c = nil
+	c.GetErrorMsg()
+}
+
+func TestCustomDomainCertificate_GetRenewsBefore(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CustomDomainCertificate{RenewsBefore: &zeroValue}
+	c.GetRenewsBefore()
+	c = &CustomDomainCertificate{}
+	c.GetRenewsBefore()
+	c = nil
+	c.GetRenewsBefore()
+}
+
+func TestCustomDomainCertificate_GetStatus(tt *testing.T) {
+	var zeroValue string
+	c := &CustomDomainCertificate{Status: &zeroValue}
+	c.GetStatus()
+	c = &CustomDomainCertificate{}
+	c.GetStatus()
+	c = nil
+	c.GetStatus()
+}
+
+func TestCustomDomainCertificate_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CustomDomainCertificate{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestCustomDomainCertificateStatus_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CustomDomainCertificateStatus{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestCustomDomainVerification_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &CustomDomainVerification{}
@@ -5850,7 +6469,7 @@ func TestCustomDomainVerification_String(t *testing.T) {
 }
 
 func TestDailyStat_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	d := &DailyStat{CreatedAt: &zeroValue}
 	d.GetCreatedAt()
 	d = &DailyStat{}
@@ -5860,7 +6479,7 @@ func TestDailyStat_GetCreatedAt(tt *testing.T) {
 }
 
 func TestDailyStat_GetDate(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	d := &DailyStat{Date: &zeroValue}
 	d.GetDate()
 	d = &DailyStat{}
@@ -5900,7 +6519,7 @@ func TestDailyStat_GetSignups(tt *testing.T) {
 }
 
 func TestDailyStat_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	d := &DailyStat{UpdatedAt: &zeroValue}
 	d.GetUpdatedAt()
 	d = &DailyStat{}
@@ -5917,6 +6536,14 @@ func TestDailyStat_String(t *testing.T) {
 	}
 }
 
+func TestDeviceAuthorization_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &DeviceAuthorization{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestEmail_GetCredentials(tt *testing.T) {
 	e := &Email{}
 	e.GetCredentials()
@@ -6504,7 +7131,7 @@ func TestEmailTemplate_String(t *testing.T) {
 }
 
 func TestEnrollment_GetEnrolledAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	e := &Enrollment{EnrolledAt: &zeroValue}
 	e.GetEnrolledAt()
 	e = &Enrollment{}
@@ -6534,7 +7161,7 @@ func TestEnrollment_GetIdentifier(tt *testing.T) {
 }
 
 func TestEnrollment_GetLastAuth(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	e := &Enrollment{LastAuth: &zeroValue}
 	e.GetLastAuth()
 	e = &Enrollment{}
@@ -6589,6 +7216,14 @@ func TestEnrollmentTicket_String(t *testing.T) {
 	}
 }
 
+func TestEnsureResult_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &EnsureResult{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestGrant_GetAudience(tt *testing.T) {
 	var zeroValue string
 	g := &Grant{Audience: &zeroValue}
@@ -6637,6 +7272,24 @@ func TestGrant_String(t *testing.T) {
 	}
 }
 
+func TestGrantList_items(tt *testing.T) {
+	g := &GrantList{}
+	g.items()
+	g = nil
+	g.items()
+}
+
+func TestGrantList_NextPage(tt *testing.T) {
+	g := &GrantList{}
+	if next, err := g.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	g = nil
+	if next, err := g.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestGrantList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &GrantList{}
@@ -6645,6 +7298,14 @@ func TestGrantList_String(t *testing.T) {
 	}
 }
 
+func TestHealthCheckError_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &HealthCheckError{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestHook_GetDependencies(tt *testing.T) {
 	var zeroValue map[string]string
 	h := &Hook{Dependencies: &zeroValue}
@@ -6713,6 +7374,24 @@ func TestHook_String(t *testing.T) {
 	}
 }
 
+func TestHookList_items(tt *testing.T) {
+	h := &HookList{}
+	h.items()
+	h = nil
+	h.items()
+}
+
+func TestHookList_NextPage(tt *testing.T) {
+	h := &HookList{}
+	if next, err := h.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	h = nil
+	if next, err := h.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestHookList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &HookList{}
@@ -6721,6 +7400,14 @@ func TestHookList_String(t *testing.T) {
 	}
 }
 
+func TestInsufficientScopeError_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &InsufficientScopeError{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestJob_GetClientID(tt *testing.T) {
 	var zeroValue string
 	j := &Job{ClientID: &zeroValue}
@@ -6742,7 +7429,7 @@ func TestJob_GetConnectionID(tt *testing.T) {
 }
 
 func TestJob_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	j := &Job{CreatedAt: &zeroValue}
 	j.GetCreatedAt()
 	j = &Job{}
@@ -7026,7 +7713,7 @@ func TestLog_GetConnectionID(tt *testing.T) {
 }
 
 func TestLog_GetDate(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	l := &Log{Date: &zeroValue}
 	l.GetDate()
 	l = &Log{}
@@ -7085,6 +7772,13 @@ func TestLog_GetIsMobile(tt *testing.T) {
 	l.GetIsMobile()
 }
 
+func TestLog_GetLocation(tt *testing.T) {
+	l := &Log{}
+	l.GetLocation()
+	l = nil
+	l.GetLocation()
+}
+
 func TestLog_GetLogID(tt *testing.T) {
 	var zeroValue string
 	l := &Log{LogID: &zeroValue}
@@ -7193,6 +7887,501 @@ func TestLog_String(t *testing.T) {
 	}
 }
 
+func TestLogAPIOperationDetails_GetRequest(tt *testing.T) {
+	l := &LogAPIOperationDetails{}
+	l.GetRequest()
+	l = nil
+	l.GetRequest()
+}
+
+func TestLogAPIOperationDetails_GetResponse(tt *testing.T) {
+	l := &LogAPIOperationDetails{}
+	l.GetResponse()
+	l = nil
+	l.GetResponse()
+}
+
+func TestLogAPIOperationDetails_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogAPIOperationDetails{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestLogAPIRequest_GetAuth(tt *testing.T) {
+	l := &LogAPIRequest{}
+	l.GetAuth()
+	l = nil
+	l.GetAuth()
+}
+
+func TestLogAPIRequest_GetChannel(tt *testing.T) {
+	var zeroValue string
+	l := &LogAPIRequest{Channel: &zeroValue}
+	l.GetChannel()
+	l = &LogAPIRequest{}
+	l.GetChannel()
+	l = nil
+	l.GetChannel()
+}
+
+func TestLogAPIRequest_GetIP(tt *testing.T) {
+	var zeroValue string
+	l := &LogAPIRequest{IP: &zeroValue}
+	l.GetIP()
+	l = &LogAPIRequest{}
+	l.GetIP()
+	l = nil
+	l.GetIP()
+}
+
+func TestLogAPIRequest_GetMethod(tt *testing.T) {
+	var zeroValue string
+	l := &LogAPIRequest{Method: &zeroValue}
+	l.GetMethod()
+	l = &LogAPIRequest{}
+	l.GetMethod()
+	l = nil
+	l.GetMethod()
+}
+
+func TestLogAPIRequest_GetPath(tt *testing.T) {
+	var zeroValue string
+	l := &LogAPIRequest{Path: &zeroValue}
+	l.GetPath()
+	l = &LogAPIRequest{}
+	l.GetPath()
+	l = nil
+	l.GetPath()
+}
+
+func TestLogAPIRequest_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogAPIRequest{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestLogAPIRequestAuth_GetCredentials(tt *testing.T) {
+	l := &LogAPIRequestAuth{}
+	l.GetCredentials()
+	l = nil
+	l.GetCredentials()
+}
+
+func TestLogAPIRequestAuth_GetStrategy(tt *testing.T) {
+	var zeroValue string
+	l := &LogAPIRequestAuth{Strategy: &zeroValue}
+	l.GetStrategy()
+	l = &LogAPIRequestAuth{}
+	l.GetStrategy()
+	l = nil
+	l.GetStrategy()
+}
+
+func TestLogAPIRequestAuth_GetUser(tt *testing.T) {
+	l := &LogAPIRequestAuth{}
+	l.GetUser()
+	l = nil
+	l.GetUser()
+}
+
+func TestLogAPIRequestAuth_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogAPIRequestAuth{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestLogAPIRequestCredentials_GetJTI(tt *testing.T) {
+	var zeroValue string
+	l := &LogAPIRequestCredentials{JTI: &zeroValue}
+	l.GetJTI()
+	l = &LogAPIRequestCredentials{}
+	l.GetJTI()
+	l = nil
+	l.GetJTI()
+}
+
+func TestLogAPIRequestCredentials_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogAPIRequestCredentials{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestLogAPIRequestUser_GetEmail(tt *testing.T) {
+	var zeroValue string
+	l := &LogAPIRequestUser{Email: &zeroValue}
+	l.GetEmail()
+	l = &LogAPIRequestUser{}
+	l.GetEmail()
+	l = nil
+	l.GetEmail()
+}
+
+func TestLogAPIRequestUser_GetName(tt *testing.T) {
+	var zeroValue string
+	l := &LogAPIRequestUser{Name: &zeroValue}
+	l.GetName()
+	l = &LogAPIRequestUser{}
+	l.GetName()
+	l = nil
+	l.GetName()
+}
+
+func TestLogAPIRequestUser_GetUserID(tt *testing.T) {
+	var zeroValue string
+	l := &LogAPIRequestUser{UserID: &zeroValue}
+	l.GetUserID()
+	l = &LogAPIRequestUser{}
+	l.GetUserID()
+	l = nil
+	l.GetUserID()
+}
+
+func TestLogAPIRequestUser_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogAPIRequestUser{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestLogAPIResponse_GetStatusCode(tt *testing.T) {
+	var zeroValue int
+	l := &LogAPIResponse{StatusCode: &zeroValue}
+	l.GetStatusCode()
+	l = &LogAPIResponse{}
+	l.GetStatusCode()
+	l = nil
+	l.GetStatusCode()
+}
+
+func TestLogAPIResponse_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogAPIResponse{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestLogError_GetMessage(tt *testing.T) {
+	var zeroValue string
+	l := &LogError{Message: &zeroValue}
+	l.GetMessage()
+	l = &LogError{}
+	l.GetMessage()
+	l = nil
+	l.GetMessage()
+}
+
+func TestLogError_GetOAuthError(tt *testing.T) {
+	var zeroValue string
+	l := &LogError{OAuthError: &zeroValue}
+	l.GetOAuthError()
+	l = &LogError{}
+	l.GetOAuthError()
+	l = nil
+	l.GetOAuthError()
+}
+
+func TestLogError_GetType(tt *testing.T) {
+	var zeroValue string
+	l := &LogError{Type: &zeroValue}
+	l.GetType()
+	l = &LogError{}
+	l.GetType()
+	l = nil
+	l.GetType()
+}
+
+func TestLogError_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogError{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestLogErrorDetails_GetError(tt *testing.T) {
+	l := &LogErrorDetails{}
+	l.GetError()
+	l = nil
+	l.GetError()
+}
+
+func TestLogErrorDetails_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogErrorDetails{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestLogLocationInfo_GetCityName(tt *testing.T) {
+	var zeroValue string
+	l := &LogLocationInfo{CityName: &zeroValue}
+	l.GetCityName()
+	l = &LogLocationInfo{}
+	l.GetCityName()
+	l = nil
+	l.GetCityName()
+}
+
+func TestLogLocationInfo_GetContinentCode(tt *testing.T) {
+	var zeroValue string
+	l := &LogLocationInfo{ContinentCode: &zeroValue}
+	l.GetContinentCode()
+	l = &LogLocationInfo{}
+	l.GetContinentCode()
+	l = nil
+	l.GetContinentCode()
+}
+
+func TestLogLocationInfo_GetCountryCode(tt *testing.T) {
+	var zeroValue string
+	l := &LogLocationInfo{CountryCode: &zeroValue}
+	l.GetCountryCode()
+	l = &LogLocationInfo{}
+	l.GetCountryCode()
+	l = nil
+	l.GetCountryCode()
+}
+
+func TestLogLocationInfo_GetCountryCode3(tt *testing.T) {
+	var zeroValue string
+	l := &LogLocationInfo{CountryCode3: &zeroValue}
+	l.GetCountryCode3()
+	l = &LogLocationInfo{}
+	l.GetCountryCode3()
+	l = nil
+	l.GetCountryCode3()
+}
+
+func TestLogLocationInfo_GetCountryName(tt *testing.T) {
+	var zeroValue string
+	l := &LogLocationInfo{CountryName: &zeroValue}
+	l.GetCountryName()
+	l = &LogLocationInfo{}
+	l.GetCountryName()
+	l = nil
+	l.GetCountryName()
+}
+
+func TestLogLocationInfo_GetLatitude(tt *testing.T) {
+	var zeroValue float64
+	l := &LogLocationInfo{Latitude: &zeroValue}
+	l.GetLatitude()
+	l = &LogLocationInfo{}
+	l.GetLatitude()
+	l = nil
+	l.GetLatitude()
+}
+
+func TestLogLocationInfo_GetLongitude(tt *testing.T) {
+	var zeroValue float64
+	l := &LogLocationInfo{Longitude: &zeroValue}
+	l.GetLongitude()
+	l = &LogLocationInfo{}
+	l.GetLongitude()
+	l = nil
+	l.GetLongitude()
+}
+
+func TestLogLocationInfo_GetTimeZone(tt *testing.T) {
+	var zeroValue string
+	l := &LogLocationInfo{TimeZone: &zeroValue}
+	l.GetTimeZone()
+	l = &LogLocationInfo{}
+	l.GetTimeZone()
+	l = nil
+	l.GetTimeZone()
+}
+
+func TestLogLocationInfo_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogLocationInfo{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestLogLoginDetails_GetCompletedAt(tt *testing.T) {
+	var zeroValue int64
+	l := &LogLoginDetails{CompletedAt: &zeroValue}
+	l.GetCompletedAt()
+	l = &LogLoginDetails{}
+	l.GetCompletedAt()
+	l = nil
+	l.GetCompletedAt()
+}
+
+func TestLogLoginDetails_GetElapsedTime(tt *testing.T) {
+	var zeroValue int64
+	l := &LogLoginDetails{ElapsedTime: &zeroValue}
+	l.GetElapsedTime()
+	l = &LogLoginDetails{}
+	l.GetElapsedTime()
+	l = nil
+	l.GetElapsedTime()
+}
+
+func TestLogLoginDetails_GetInitiatedAt(tt *testing.T) {
+	var zeroValue int64
+	l := &LogLoginDetails{InitiatedAt: &zeroValue}
+	l.GetInitiatedAt()
+	l = &LogLoginDetails{}
+	l.GetInitiatedAt()
+	l = nil
+	l.GetInitiatedAt()
+}
+
+func TestLogLoginDetails_GetSessionID(tt *testing.T) {
+	var zeroValue string
+	l := &LogLoginDetails{SessionID: &zeroValue}
+	l.GetSessionID()
+	l = &LogLoginDetails{}
+	l.GetSessionID()
+	l = nil
+	l.GetSessionID()
+}
+
+func TestLogLoginDetails_GetStats(tt *testing.T) {
+	l := &LogLoginDetails{}
+	l.GetStats()
+	l = nil
+	l.GetStats()
+}
+
+func TestLogLoginDetails_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogLoginDetails{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestLogLoginStats_GetLoginsCount(tt *testing.T) {
+	var zeroValue int
+	l := &LogLoginStats{LoginsCount: &zeroValue}
+	l.GetLoginsCount()
+	l = &LogLoginStats{}
+	l.GetLoginsCount()
+	l = nil
+	l.GetLoginsCount()
+}
+
+func TestLogLoginStats_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogLoginStats{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestLogPrompt_GetCompletedAt(tt *testing.T) {
+	var zeroValue int64
+	l := &LogPrompt{CompletedAt: &zeroValue}
+	l.GetCompletedAt()
+	l = &LogPrompt{}
+	l.GetCompletedAt()
+	l = nil
+	l.GetCompletedAt()
+}
+
+func TestLogPrompt_GetConnectionID(tt *testing.T) {
+	var zeroValue string
+	l := &LogPrompt{ConnectionID: &zeroValue}
+	l.GetConnectionID()
+	l = &LogPrompt{}
+	l.GetConnectionID()
+	l = nil
+	l.GetConnectionID()
+}
+
+func TestLogPrompt_GetElapsedTime(tt *testing.T) {
+	var zeroValue int64
+	l := &LogPrompt{ElapsedTime: &zeroValue}
+	l.GetElapsedTime()
+	l = &LogPrompt{}
+	l.GetElapsedTime()
+	l = nil
+	l.GetElapsedTime()
+}
+
+func TestLogPrompt_GetFlow(tt *testing.T) {
+	var zeroValue string
+	l := &LogPrompt{Flow: &zeroValue}
+	l.GetFlow()
+	l = &LogPrompt{}
+	l.GetFlow()
+	l = nil
+	l.GetFlow()
+}
+
+func TestLogPrompt_GetIdentity(tt *testing.T) {
+	var zeroValue string
+	l := &LogPrompt{Identity: &zeroValue}
+	l.GetIdentity()
+	l = &LogPrompt{}
+	l.GetIdentity()
+	l = nil
+	l.GetIdentity()
+}
+
+func TestLogPrompt_GetInitiatedAt(tt *testing.T) {
+	var zeroValue int64
+	l := &LogPrompt{InitiatedAt: &zeroValue}
+	l.GetInitiatedAt()
+	l = &LogPrompt{}
+	l.GetInitiatedAt()
+	l = nil
+	l.GetInitiatedAt()
+}
+
+func TestLogPrompt_GetName(tt *testing.T) {
+	var zeroValue string
+	l := &LogPrompt{Name: &zeroValue}
+	l.GetName()
+	l = &LogPrompt{}
+	l.GetName()
+	l = nil
+	l.GetName()
+}
+
+func TestLogPrompt_GetSessionUser(tt *testing.T) {
+	var zeroValue string
+	l := &LogPrompt{SessionUser: &zeroValue}
+	l.GetSessionUser()
+	l = &LogPrompt{}
+	l.GetSessionUser()
+	l = nil
+	l.GetSessionUser()
+}
+
+func TestLogPrompt_GetStrategy(tt *testing.T) {
+	var zeroValue string
+	l := &LogPrompt{Strategy: &zeroValue}
+	l.GetStrategy()
+	l = &LogPrompt{}
+	l.GetStrategy()
+	l = nil
+	l.GetStrategy()
+}
+
+func TestLogPrompt_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogPrompt{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestLogStream_GetID(tt *testing.T) {
 	var zeroValue string
 	l := &LogStream{ID: &zeroValue}
@@ -7203,6 +8392,16 @@ func TestLogStream_GetID(tt *testing.T) {
 	l.GetID()
 }
 
+func TestLogStream_GetIsPaused(tt *testing.T) {
+	var zeroValue bool
+	l := &LogStream{IsPaused: &zeroValue}
+	l.GetIsPaused()
+	l = &LogStream{}
+	l.GetIsPaused()
+	l = nil
+	l.GetIsPaused()
+}
+
 func TestLogStream_GetName(tt *testing.T) {
 	var zeroValue string
 	l := &LogStream{Name: &zeroValue}
@@ -7241,6 +8440,31 @@ func TestLogStream_String(t *testing.T) {
 	}
 }
 
+func TestLogStreamEvent_GetData(tt *testing.T) {
+	l := &LogStreamEvent{}
+	l.GetData()
+	l = nil
+	l.GetData()
+}
+
+func TestLogStreamEvent_GetLogID(tt *testing.T) {
+	var zeroValue string
+	l := &LogStreamEvent{LogID: &zeroValue}
+	l.GetLogID()
+	l = &LogStreamEvent{}
+	l.GetLogID()
+	l = nil
+	l.GetLogID()
+}
+
+func TestLogStreamEvent_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogStreamEvent{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestLogStreamSinkAmazonEventBridge_GetAccountID(tt *testing.T) {
 	var zeroValue string
 	l := &LogStreamSinkAmazonEventBridge{AccountID: &zeroValue}
@@ -7535,6 +8759,14 @@ func TestLogStreamSinkSumo_String(t *testing.T) {
 	}
 }
 
+func TestMissingEnvError_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &MissingEnvError{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestMultiFactor_GetEnabled(tt *testing.T) {
 	var zeroValue bool
 	m := &MultiFactor{Enabled: &zeroValue}
@@ -8078,6 +9310,32 @@ func TestOrganizationBranding_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationClientGrantList_items(tt *testing.T) {
+	o := &OrganizationClientGrantList{}
+	o.items()
+	o = nil
+	o.items()
+}
+
+func TestOrganizationClientGrantList_NextPage(tt *testing.T) {
+	o := &OrganizationClientGrantList{}
+	if next, err := o.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	o = nil
+	if next, err := o.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
+func TestOrganizationClientGrantList_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationClientGrantList{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestOrganizationConnection_GetAssignMembershipOnLogin(tt *testing.T) {
 	var zeroValue bool
 	o := &OrganizationConnection{AssignMembershipOnLogin: &zeroValue}
@@ -8141,6 +9399,24 @@ func TestOrganizationConnectionDetails_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationConnectionList_items(tt *testing.T) {
+	o := &OrganizationConnectionList{}
+	o.items()
+	o = nil
+	o.items()
+}
+
+func TestOrganizationConnectionList_NextPage(tt *testing.T) {
+	o := &OrganizationConnectionList{}
+	if next, err := o.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	o = nil
+	if next, err := o.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestOrganizationConnectionList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &OrganizationConnectionList{}
@@ -8307,6 +9583,24 @@ func TestOrganizationInvitationInviter_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationInvitationList_items(tt *testing.T) {
+	o := &OrganizationInvitationList{}
+	o.items()
+	o = nil
+	o.items()
+}
+
+func TestOrganizationInvitationList_NextPage(tt *testing.T) {
+	o := &OrganizationInvitationList{}
+	if next, err := o.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	o = nil
+	if next, err := o.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestOrganizationInvitationList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &OrganizationInvitationList{}
@@ -8315,6 +9609,24 @@ func TestOrganizationInvitationList_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationList_items(tt *testing.T) {
+	o := &OrganizationList{}
+	o.items()
+	o = nil
+	o.items()
+}
+
+func TestOrganizationList_NextPage(tt *testing.T) {
+	o := &OrganizationList{}
+	if next, err := o.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	o = nil
+	if next, err := o.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestOrganizationList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &OrganizationList{}
@@ -8371,6 +9683,40 @@ func TestOrganizationMember_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationMemberImportError_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationMemberImportError{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganizationMemberImportResult_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationMemberImportResult{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganizationMemberList_items(tt *testing.T) {
+	o := &OrganizationMemberList{}
+	o.items()
+	o = nil
+	o.items()
+}
+
+func TestOrganizationMemberList_NextPage(tt *testing.T) {
+	o := &OrganizationMemberList{}
+	if next, err := o.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	o = nil
+	if next, err := o.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestOrganizationMemberList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &OrganizationMemberList{}
@@ -8417,6 +9763,24 @@ func TestOrganizationMemberRole_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationMemberRoleList_items(tt *testing.T) {
+	o := &OrganizationMemberRoleList{}
+	o.items()
+	o = nil
+	o.items()
+}
+
+func TestOrganizationMemberRoleList_NextPage(tt *testing.T) {
+	o := &OrganizationMemberRoleList{}
+	if next, err := o.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	o = nil
+	if next, err := o.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestOrganizationMemberRoleList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &OrganizationMemberRoleList{}
@@ -8425,6 +9789,21 @@ func TestOrganizationMemberRoleList_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationState_GetOrganization(tt *testing.T) {
+	o := &OrganizationState{}
+	o.GetOrganization()
+	o = nil
+	o.GetOrganization()
+}
+
+func TestOrganizationState_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationState{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestPermission_GetDescription(tt *testing.T) {
 	var zeroValue string
 	p := &Permission{Description: &zeroValue}
@@ -8473,6 +9852,24 @@ func TestPermission_String(t *testing.T) {
 	}
 }
 
+func TestPermissionList_items(tt *testing.T) {
+	p := &PermissionList{}
+	p.items()
+	p = nil
+	p.items()
+}
+
+func TestPermissionList_NextPage(tt *testing.T) {
+	p := &PermissionList{}
+	if next, err := p.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	p = nil
+	if next, err := p.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestPermissionList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &PermissionList{}
@@ -8601,6 +9998,29 @@ func TestPrompt_String(t *testing.T) {
 	}
 }
 
+func TestRateLimit_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &RateLimit{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestRequestEvent_GetRateLimit(tt *testing.T) {
+	r := &RequestEvent{}
+	r.GetRateLimit()
+	r = nil
+	r.GetRateLimit()
+}
+
+func TestRequestEvent_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &RequestEvent{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestResourceServer_GetAllowOfflineAccess(tt *testing.T) {
 	var zeroValue bool
 	r := &ResourceServer{AllowOfflineAccess: &zeroValue}
@@ -8611,6 +10031,26 @@ func TestResourceServer_GetAllowOfflineAccess(tt *testing.T) {
 	r.GetAllowOfflineAccess()
 }
 
+func TestResourceServer_GetAuthorizationDetails(tt *testing.T) {
+	var zeroValue []ResourceServerAuthorizationDetails
+	r := &ResourceServer{AuthorizationDetails: &zeroValue}
+	r.GetAuthorizationDetails()
+	r = &ResourceServer{}
+	r.GetAuthorizationDetails()
+	r = nil
+	r.GetAuthorizationDetails()
+}
+
+func TestResourceServer_GetConsentPolicy(tt *testing.T) {
+	var zeroValue string
+	r := &ResourceServer{ConsentPolicy: &zeroValue}
+	r.GetConsentPolicy()
+	r = &ResourceServer{}
+	r.GetConsentPolicy()
+	r = nil
+	r.GetConsentPolicy()
+}
+
 func TestResourceServer_GetEnforcePolicies(tt *testing.T) {
 	var zeroValue bool
 	r := &ResourceServer{EnforcePolicies: &zeroValue}
@@ -8711,6 +10151,13 @@ func TestResourceServer_GetTokenDialect(tt *testing.T) {
 	r.GetTokenDialect()
 }
 
+func TestResourceServer_GetTokenEncryption(tt *testing.T) {
+	r := &ResourceServer{}
+	r.GetTokenEncryption()
+	r = nil
+	r.GetTokenEncryption()
+}
+
 func TestResourceServer_GetTokenLifetime(tt *testing.T) {
 	var zeroValue int
 	r := &ResourceServer{TokenLifetime: &zeroValue}
@@ -8749,6 +10196,42 @@ func TestResourceServer_String(t *testing.T) {
 	}
 }
 
+func TestResourceServerAuthorizationDetails_GetType(tt *testing.T) {
+	var zeroValue string
+	r := &ResourceServerAuthorizationDetails{Type: &zeroValue}
+	r.GetType()
+	r = &ResourceServerAuthorizationDetails{}
+	r.GetType()
+	r = nil
+	r.GetType()
+}
+
+func TestResourceServerAuthorizationDetails_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ResourceServerAuthorizationDetails{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestResourceServerList_items(tt *testing.T) {
+	r := &ResourceServerList{}
+	r.items()
+	r = nil
+	r.items()
+}
+
+func TestResourceServerList_NextPage(tt *testing.T) {
+	r := &ResourceServerList{}
+	if next, err := r.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	r = nil
+	if next, err := r.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestResourceServerList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ResourceServerList{}
@@ -8785,6 +10268,87 @@ func TestResourceServerScope_String(t *testing.T) {
 	}
 }
 
+func TestResourceServerTokenEncryption_GetEncryptionKey(tt *testing.T) {
+	r := &ResourceServerTokenEncryption{}
+	r.GetEncryptionKey()
+	r = nil
+	r.GetEncryptionKey()
+}
+
+func TestResourceServerTokenEncryption_GetFormat(tt *testing.T) {
+	var zeroValue string
+	r := &ResourceServerTokenEncryption{Format: &zeroValue}
+	r.GetFormat()
+	r = &ResourceServerTokenEncryption{}
+	r.GetFormat()
+	r = nil
+	r.GetFormat()
+}
+
+func TestResourceServerTokenEncryption_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ResourceServerTokenEncryption{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestResourceServerTokenEncryptionKey_GetAlgorithm(tt *testing.T) {
+	var zeroValue string
+	r := &ResourceServerTokenEncryptionKey{Algorithm: &zeroValue}
+	r.GetAlgorithm()
+	r = &ResourceServerTokenEncryptionKey{}
+	r.GetAlgorithm()
+	r = nil
+	r.GetAlgorithm()
+}
+
+func TestResourceServerTokenEncryptionKey_GetKeyID(tt *testing.T) {
+	var zeroValue string
+	r := &ResourceServerTokenEncryptionKey{KeyID: &zeroValue}
+	r.GetKeyID()
+	r = &ResourceServerTokenEncryptionKey{}
+	r.GetKeyID()
+	r = nil
+	r.GetKeyID()
+}
+
+func TestResourceServerTokenEncryptionKey_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &ResourceServerTokenEncryptionKey{Name: &zeroValue}
+	r.GetName()
+	r = &ResourceServerTokenEncryptionKey{}
+	r.GetName()
+	r = nil
+	r.GetName()
+}
+
+func TestResourceServerTokenEncryptionKey_GetPEM(tt *testing.T) {
+	var zeroValue string
+	r := &ResourceServerTokenEncryptionKey{PEM: &zeroValue}
+	r.GetPEM()
+	r = &ResourceServerTokenEncryptionKey{}
+	r.GetPEM()
+	r = nil
+	r.GetPEM()
+}
+
+func TestResourceServerTokenEncryptionKey_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ResourceServerTokenEncryptionKey{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestRetryEvent_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &RetryEvent{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestRole_GetDescription(tt *testing.T) {
 	var zeroValue string
 	r := &Role{Description: &zeroValue}
@@ -8823,6 +10387,24 @@ func TestRole_String(t *testing.T) {
 	}
 }
 
+func TestRoleList_items(tt *testing.T) {
+	r := &RoleList{}
+	r.items()
+	r = nil
+	r.items()
+}
+
+func TestRoleList_NextPage(tt *testing.T) {
+	r := &RoleList{}
+	if next, err := r.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	r = nil
+	if next, err := r.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestRoleList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &RoleList{}
@@ -8831,6 +10413,21 @@ func TestRoleList_String(t *testing.T) {
 	}
 }
 
+func TestRoleState_GetRole(tt *testing.T) {
+	r := &RoleState{}
+	r.GetRole()
+	r = nil
+	r.GetRole()
+}
+
+func TestRoleState_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &RoleState{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestRule_GetEnabled(tt *testing.T) {
 	var zeroValue bool
 	r := &Rule{Enabled: &zeroValue}
@@ -8917,6 +10514,24 @@ func TestRuleConfig_String(t *testing.T) {
 	}
 }
 
+func TestRuleList_items(tt *testing.T) {
+	r := &RuleList{}
+	r.items()
+	r = nil
+	r.items()
+}
+
+func TestRuleList_NextPage(tt *testing.T) {
+	r := &RuleList{}
+	if next, err := r.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	r = nil
+	if next, err := r.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestRuleList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &RuleList{}
@@ -8946,7 +10561,7 @@ func TestSigningKey_GetCurrent(tt *testing.T) {
 }
 
 func TestSigningKey_GetCurrentSince(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	s := &SigningKey{CurrentSince: &zeroValue}
 	s.GetCurrentSince()
 	s = &SigningKey{}
@@ -8956,7 +10571,7 @@ func TestSigningKey_GetCurrentSince(tt *testing.T) {
 }
 
 func TestSigningKey_GetCurrentUntil(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	s := &SigningKey{CurrentUntil: &zeroValue}
 	s.GetCurrentUntil()
 	s = &SigningKey{}
@@ -9026,7 +10641,7 @@ func TestSigningKey_GetRevoked(tt *testing.T) {
 }
 
 func TestSigningKey_GetRevokedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	s := &SigningKey{RevokedAt: &zeroValue}
 	s.GetRevokedAt()
 	s = &SigningKey{}
@@ -9053,6 +10668,14 @@ func TestSigningKey_String(t *testing.T) {
 	}
 }
 
+func TestSigningKeyRotationEvent_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SigningKeyRotationEvent{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestStage_GetPreLogin(tt *testing.T) {
 	s := &Stage{}
 	s.GetPreLogin()
@@ -9075,6 +10698,14 @@ func TestStage_String(t *testing.T) {
 	}
 }
 
+func TestStatTotals_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &StatTotals{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestSuspiciousIPThrottling_GetAllowList(tt *testing.T) {
 	var zeroValue []string
 	s := &SuspiciousIPThrottling{AllowList: &zeroValue}
@@ -9120,6 +10751,16 @@ func TestSuspiciousIPThrottling_String(t *testing.T) {
 	}
 }
 
+func TestTenant_GetACRValuesSupported(tt *testing.T) {
+	var zeroValue []string
+	t := &Tenant{ACRValuesSupported: &zeroValue}
+	t.GetACRValuesSupported()
+	t = &Tenant{}
+	t.GetACRValuesSupported()
+	t = nil
+	t.GetACRValuesSupported()
+}
+
 func TestTenant_GetAllowedLogoutURLs(tt *testing.T) {
 	var zeroValue []string
 	t := &Tenant{AllowedLogoutURLs: &zeroValue}
@@ -9225,6 +10866,13 @@ func TestTenant_GetIdleSessionLifetime(tt *testing.T) {
 	t.GetIdleSessionLifetime()
 }
 
+func TestTenant_GetMTLS(tt *testing.T) {
+	t := &Tenant{}
+	t.GetMTLS()
+	t = nil
+	t.GetMTLS()
+}
+
 func TestTenant_GetPictureURL(tt *testing.T) {
 	var zeroValue string
 	t := &Tenant{PictureURL: &zeroValue}
@@ -9235,6 +10883,16 @@ func TestTenant_GetPictureURL(tt *testing.T) {
 	t.GetPictureURL()
 }
 
+func TestTenant_GetPushedAuthorizationRequestsSupported(tt *testing.T) {
+	var zeroValue bool
+	t := &Tenant{PushedAuthorizationRequestsSupported: &zeroValue}
+	t.GetPushedAuthorizationRequestsSupported()
+	t = &Tenant{}
+	t.GetPushedAuthorizationRequestsSupported()
+	t = nil
+	t.GetPushedAuthorizationRequestsSupported()
+}
+
 func TestTenant_GetSandboxVersion(tt *testing.T) {
 	var zeroValue string
 	t := &Tenant{SandboxVersion: &zeroValue}
@@ -9272,6 +10930,13 @@ func TestTenant_GetSessionLifetime(tt *testing.T) {
 	t.GetSessionLifetime()
 }
 
+func TestTenant_GetSessions(tt *testing.T) {
+	t := &Tenant{}
+	t.GetSessions()
+	t = nil
+	t.GetSessions()
+}
+
 func TestTenant_GetSupportEmail(tt *testing.T) {
 	var zeroValue string
 	t := &Tenant{SupportEmail: &zeroValue}
@@ -9335,6 +11000,17 @@ func TestTenantChangePassword_String(t *testing.T) {
 	}
 }
 
+func TestTenantConfig_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &TenantConfig{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+	if err := json.Unmarshal([]byte(v.GoString()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestTenantDeviceFlow_GetCharset(tt *testing.T) {
 	var zeroValue string
 	t := &TenantDeviceFlow{Charset: &zeroValue}
@@ -9631,6 +11307,16 @@ func TestTenantFlags_GetNoDisclosureEnterpriseConnections(tt *testing.T) {
 	t.GetNoDisclosureEnterpriseConnections()
 }
 
+func TestTenantFlags_GetRemoveAlgFromJWKS(tt *testing.T) {
+	var zeroValue bool
+	t := &TenantFlags{RemoveAlgFromJWKS: &zeroValue}
+	t.GetRemoveAlgFromJWKS()
+	t = &TenantFlags{}
+	t.GetRemoveAlgFromJWKS()
+	t = nil
+	t.GetRemoveAlgFromJWKS()
+}
+
 func TestTenantFlags_GetRequirePushedAuthorizationRequests(tt *testing.T) {
 	var zeroValue bool
 	t := &TenantFlags{RequirePushedAuthorizationRequests: &zeroValue}
@@ -9707,6 +11393,24 @@ func TestTenantGuardianMFAPage_String(t *testing.T) {
 	}
 }
 
+func TestTenantMTLSConfiguration_GetEnableEndpointAliases(tt *testing.T) {
+	var zeroValue bool
+	t := &TenantMTLSConfiguration{EnableEndpointAliases: &zeroValue}
+	t.GetEnableEndpointAliases()
+	t = &TenantMTLSConfiguration{}
+	t.GetEnableEndpointAliases()
+	t = nil
+	t.GetEnableEndpointAliases()
+}
+
+func TestTenantMTLSConfiguration_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &TenantMTLSConfiguration{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestTenantSessionCookie_GetMode(tt *testing.T) {
 	var zeroValue string
 	t := &TenantSessionCookie{Mode: &zeroValue}
@@ -9725,6 +11429,24 @@ func TestTenantSessionCookie_String(t *testing.T) {
 	}
 }
 
+func TestTenantSessions_GetOIDCLogoutPromptEnabled(tt *testing.T) {
+	var zeroValue bool
+	t := &TenantSessions{OIDCLogoutPromptEnabled: &zeroValue}
+	t.GetOIDCLogoutPromptEnabled()
+	t = &TenantSessions{}
+	t.GetOIDCLogoutPromptEnabled()
+	t = nil
+	t.GetOIDCLogoutPromptEnabled()
+}
+
+func TestTenantSessions_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &TenantSessions{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestTenantUniversalLogin_GetColors(tt *testing.T) {
 	t := &TenantUniversalLogin{}
 	t.GetColors()
@@ -9914,7 +11636,7 @@ func TestUser_GetConnection(tt *testing.T) {
 }
 
 func TestUser_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	u := &User{CreatedAt: &zeroValue}
 	u.GetCreatedAt()
 	u = &User{}
@@ -9994,7 +11716,7 @@ func TestUser_GetLastIP(tt *testing.T) {
 }
 
 func TestUser_GetLastLogin(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	u := &User{LastLogin: &zeroValue}
 	u.GetLastLogin()
 	u = &User{}
@@ -10004,7 +11726,7 @@ func TestUser_GetLastLogin(tt *testing.T) {
 }
 
 func TestUser_GetLastPasswordReset(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	u := &User{LastPasswordReset: &zeroValue}
 	u.GetLastPasswordReset()
 	u = &User{}
@@ -10093,16 +11815,6 @@ func TestUser_GetPhoneVerified(tt *testing.T) {
 	u.GetPhoneVerified()
 }
 
-func TestUser_GetPicture(tt *testing.T) {
-	var zeroValue string
-	u := &User{Picture: &zeroValue}
-	u.GetPicture()
-	u = &User{}
-	u.GetPicture()
-	u = nil
-	u.GetPicture()
-}
-
 func TestUser_GetScreenName(tt *testing.T) {
 	var zeroValue string
 	u := &User{ScreenName: &zeroValue}
@@ -10114,7 +11826,7 @@ func TestUser_GetScreenName(tt *testing.T) {
 }
 
 func TestUser_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	u := &User{UpdatedAt: &zeroValue}
 	u.GetUpdatedAt()
 	u = &User{}
@@ -10189,6 +11901,14 @@ func TestUserBlock_String(t *testing.T) {
 	}
 }
 
+func TestUserDeletion_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &UserDeletion{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestUserEnrollment_GetAuthMethod(tt *testing.T) {
 	var zeroValue string
 	u := &UserEnrollment{AuthMethod: &zeroValue}
@@ -10200,7 +11920,7 @@ func TestUserEnrollment_GetAuthMethod(tt *testing.T) {
 }
 
 func TestUserEnrollment_GetEnrolledAt(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	u := &UserEnrollment{EnrolledAt: &zeroValue}
 	u.GetEnrolledAt()
 	u = &UserEnrollment{}
@@ -10230,7 +11950,7 @@ func TestUserEnrollment_GetIdentifier(tt *testing.T) {
 }
 
 func TestUserEnrollment_GetLastAuth(tt *testing.T) {
-	var zeroValue time.Time
+	var zeroValue Timestamp
 	u := &UserEnrollment{LastAuth: &zeroValue}
 	u.GetLastAuth()
 	u = &UserEnrollment{}
@@ -10413,6 +12133,32 @@ func TestUserIdentityLink_String(t *testing.T) {
 	}
 }
 
+func TestUserImportResult_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &UserImportResult{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestUserList_items(tt *testing.T) {
+	u := &UserList{}
+	u.items()
+	u = nil
+	u.items()
+}
+
+func TestUserList_NextPage(tt *testing.T) {
+	u := &UserList{}
+	if next, err := u.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+	u = nil
+	if next, err := u.NextPage(context.Background()); next != nil || err != nil {
+		tt.Errorf("expected no next page, got %v, %v", next, err)
+	}
+}
+
 func TestUserList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &UserList{}
@@ -10438,3 +12184,11 @@ func TestUserRecoveryCode_String(t *testing.T) {
 		t.Errorf("failed to produce a valid json")
 	}
 }
+
+func TestValidationError_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ValidationError{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
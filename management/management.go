@@ -1,16 +1,20 @@
 package management
 
 //go:generate go run gen-methods.go
+//go:generate go run gen-patches.go
 
 import (
 	"context"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/oauth2"
 
 	"github.com/auth0/go-auth0/internal/client"
+	"github.com/auth0/go-auth0/tokencache"
 )
 
 // Management is an Auth0 management client used to interact with the Auth0
@@ -109,6 +113,13 @@ type Management struct {
 	EmailProvider *EmailProviderManager
 
 	url             *url.URL
+	failoverHosts   []string
+	hedgingDelay    time.Duration
+	readCacheTTL    time.Duration
+	readCache       *client.ReadCache
+	clock           Clock
+	tokenCache      TokenCache
+	metricsName     string
 	basePath        string
 	userAgent       string
 	debug           bool
@@ -116,8 +127,87 @@ type Management struct {
 	tokenSource     oauth2.TokenSource
 	http            *http.Client
 	auth0ClientInfo *client.Auth0ClientInfo
+	validate        bool
+	transportConfig []func(*http.Transport)
+	transport       http.RoundTripper
+	breakerFailures int
+	breakerCooldown time.Duration
+	maxConcurrency  int
+	listConcurrency int
+	baseURI         string
+	onRequestStart  []func(RequestEvent)
+	onRequestEnd    []func(RequestEvent)
+	onRetry         []func(RetryEvent)
+	auditSinks      []func(AuditEvent)
+	actorSource     oauth2.TokenSource
+	scopePreflight  bool
+	rateLimiter     *RateLimiter
+	retryPolicy     client.RetryPolicy
+	rateLimitMu     sync.Mutex
+	rateLimit       *RateLimit
 }
 
+// Clock tells the time and waits for durations to elapse. It is used by the
+// management client to tell when access tokens expire, how long to wait before
+// retrying rate limited requests, and when to poll again in helpers waiting
+// for changes, such as UserImporter.Wait.
+//
+// See WithClock.
+type Clock = client.Clock
+
+// managementClock is the clock of the management client, which can be used
+// before the options setting the clock are applied.
+type managementClock struct {
+	m *Management
+}
+
+func (c managementClock) Now() time.Time {
+	return c.m.clock.Now()
+}
+
+func (c managementClock) After(d time.Duration) <-chan time.Time {
+	return c.m.clock.After(d)
+}
+
+// TokenCache persists the access tokens retrieved by the management client
+// with client credentials, such as tokencache.Keyring or tokencache.Encrypted.
+//
+// See WithTokenCache.
+type TokenCache = tokencache.Cache
+
+// managementTokenCache is the token cache of the management client, which can
+// be used before the options setting the token cache are applied.
+type managementTokenCache struct {
+	m *Management
+}
+
+func (c managementTokenCache) Get(key string) (*oauth2.Token, error) {
+	if c.m.tokenCache == nil {
+		return nil, nil
+	}
+	return c.m.tokenCache.Get(key)
+}
+
+func (c managementTokenCache) Set(key string, token *oauth2.Token) error {
+	if c.m.tokenCache == nil {
+		return nil
+	}
+	return c.m.tokenCache.Set(key, token)
+}
+
+func (c managementTokenCache) Delete(key string) error {
+	if c.m.tokenCache == nil {
+		return nil
+	}
+	return c.m.tokenCache.Delete(key)
+}
+
+// Auth0ClientInfo is the client information sent in the "Auth0-Client" header
+// of every request, used by Auth0 for telemetry.
+//
+// See WithAuth0ClientInfo, WithAuth0ClientEnvEntry and WithNoAuth0ClientInfo.
+type Auth0ClientInfo = client.Auth0ClientInfo
+
 // New creates a new Auth0 Management client by authenticating using the
 // supplied client id and secret.
 func New(domain string, options ...Option) (*Management, error) {
@@ -141,20 +231,63 @@ func New(domain string, options ...Option) (*Management, error) {
 		ctx:             context.Background(),
 		http:            http.DefaultClient,
 		auth0ClientInfo: client.DefaultAuth0ClientInfo,
+		clock:           client.SystemClock,
+		retryPolicy:     client.RetryPolicy{MaxRetries: -1},
 	}
 
 	for _, option := range options {
 		option(m)
 	}
 
-	m.http = client.Wrap(
-		m.http,
-		m.tokenSource,
+	if err := m.configureTransport(); err != nil {
+		return nil, err
+	}
+
+	m.baseURI = m.buildBaseURI()
+
+	if m.readCacheTTL > 0 {
+		m.readCache = client.NewReadCache(m.readCacheTTL, m.readCachePaths())
+	}
+
+	// The token source is kept before it's wrapped, so that the tokens
+	// retrieved to tell the actors of audit events aren't counted.
+	m.actorSource = m.tokenSource
+
+	if m.metricsName != "" {
+		metrics := expvarMetrics(m.metricsName)
+		m.onRequestEnd = append(m.onRequestEnd, func(event RequestEvent) {
+			recordRequest(metrics, event)
+		})
+		if m.tokenSource != nil {
+			m.tokenSource = &countingTokenSource{source: m.tokenSource, metrics: metrics}
+		}
+	}
+
+	clientOptions := []client.Option{
 		client.WithDebug(m.debug),
 		client.WithUserAgent(m.userAgent),
-		client.WithRateLimit(),
-		client.WithAuth0ClientInfo(m.auth0ClientInfo),
-	)
+		client.WithFailover(m.url.Host, m.failoverHosts),
+		client.WithHedging(m.hedgingDelay),
+	}
+	if len(m.onRequestStart) > 0 || len(m.onRequestEnd) > 0 {
+		clientOptions = append(clientOptions, func(c *http.Client) {
+			c.Transport = m.hooksTransport(c.Transport)
+		})
+	}
+	if m.rateLimiter != nil {
+		clientOptions = append(clientOptions, client.WithRateLimiter(m.rateLimiter))
+	}
+	clientOptions = append(clientOptions, client.WithRetries(m.clock, m.retryPolicy, m.retryHooks()...))
+	if m.breakerFailures > 0 {
+		clientOptions = append(clientOptions, client.WithCircuitBreaker(m.breakerFailures, m.breakerCooldown))
+	}
+	if m.maxConcurrency > 0 {
+		clientOptions = append(clientOptions, client.WithMaxConcurrentRequests(m.maxConcurrency))
+	}
+	clientOptions = append(clientOptions, client.WithAuth0ClientInfo(m.auth0ClientInfo))
+	clientOptions = append(clientOptions, client.WithReadCache(m.readCache))
+
+	m.http = client.Wrap(m.http, m.tokenSource, clientOptions...)
 
 	m.Client = newClientManager(m)
 	m.ClientGrant = newClientGrantManager(m)
@@ -189,3 +322,64 @@ func New(domain string, options ...Option) (*Management, error) {
 
 	return m, nil
 }
+
+// configureTransport sets up the transport used by the underlying HTTP client
+// according to the options given to the management client.
+func (m *Management) configureTransport() error {
+	if len(m.transportConfig) == 0 {
+		return nil
+	}
+
+	transport, err := client.ConfigureTransport(m.http.Transport, m.transportConfig...)
+	if err != nil {
+		return err
+	}
+
+	httpClient := *m.http
+	httpClient.Transport = transport
+	m.http = &httpClient
+	m.transport = transport
+
+	return nil
+}
+
+// readCachePaths returns the paths of the resources whose reads are cached
+// when WithReadCache is used.
+func (m *Management) readCachePaths() []string {
+	base := strings.TrimSuffix(m.url.Path, "/") + "/" + m.basePath + "/"
+	return []string{
+		base + "clients",
+		base + "connections",
+		base + "resource-servers",
+		base + "tenants/settings",
+	}
+}
+
+// InvalidateReadCache drops every response cached by the management client
+// when WithReadCache is used, so that the next reads are sent to the
+// Management API. Use it after changing resources by other means than the
+// management client.
+func (m *Management) InvalidateReadCache() {
+	if m.readCache != nil {
+		m.readCache.Invalidate()
+	}
+}
+
+// tokenContext returns the context used to request access tokens. Unless the
+// context already defines an HTTP client for that purpose, token requests go
+// through the same transport as the requests made to the Management API.
+func (m *Management) tokenContext() context.Context {
+	if m.ctx.Value(oauth2.HTTPClient) != nil {
+		return m.ctx
+	}
+
+	return context.WithValue(m.ctx, oauth2.HTTPClient, &http.Client{
+		Transport: client.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			transport := http.DefaultTransport
+			if m.transport != nil {
+				transport = m.transport
+			}
+			return client.FailoverTransport(transport, m.url.Host, m.failoverHosts).RoundTrip(req)
+		}),
+	})
+}
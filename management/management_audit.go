@@ -0,0 +1,154 @@
+package management
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AuditEvent describes a call to the Management API which may have changed
+// the resources of the tenant, that is a POST, PATCH, PUT or DELETE request.
+// It is passed to the sinks registered with WithAuditSink.
+type AuditEvent struct {
+	// Time is when the request was sent.
+	Time time.Time
+	// Actor is the subject of the access token the request was sent with,
+	// such as "{client_id}@clients" for client credentials, or an empty
+	// string if it isn't known.
+	Actor string
+	// Method is the HTTP method of the request.
+	Method string
+	// Path is the path of the resource, relative to the Management API, such
+	// as "clients/{id}".
+	Path string
+	// Fields are the names of the top-level fields of the payload of the
+	// request, sorted, such as "name" and "callbacks" when updating the
+	// name and callbacks of a client. Their values aren't included so that
+	// secrets aren't disclosed.
+	Fields []string
+	// StatusCode is the status code of the response, or 0 if none was
+	// received.
+	StatusCode int
+	// Err is the error which prevented receiving a response, if any.
+	Err error
+}
+
+// Succeeded reports whether the call succeeded, that is whether a response
+// was received with a status code other than an error.
+func (e AuditEvent) Succeeded() bool {
+	return e.Err == nil && e.StatusCode > 0 && e.StatusCode < http.StatusBadRequest
+}
+
+// isMutatingMethod reports whether requests with the method may change the
+// resources of the tenant.
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// newAuditEvent returns the audit event of the request, before it's sent.
+func (m *Management) newAuditEvent(req *http.Request) AuditEvent {
+	return AuditEvent{
+		Time:   m.clock.Now(),
+		Actor:  m.actor(),
+		Method: req.Method,
+		Path:   m.resourcePath(req.URL),
+		Fields: payloadFields(req),
+	}
+}
+
+// audit completes the audit event with the outcome of the request and passes
+// it to the sinks.
+func (m *Management) audit(event AuditEvent, res *http.Response, err error) {
+	event.Err = err
+	if res != nil {
+		event.StatusCode = res.StatusCode
+	}
+
+	for _, sink := range m.auditSinks {
+		sink(event)
+	}
+}
+
+// actor returns the subject of the access token of the management client, if
+// it's a JWT.
+func (m *Management) actor() string {
+	claims, _ := m.tokenClaims()
+	return claims.Subject
+}
+
+type tokenClaims struct {
+	Subject string  `json:"sub"`
+	Scope   *string `json:"scope"`
+}
+
+// tokenClaims returns the claims of the access token of the management client,
+// and whether it's a JWT. The signature of the token isn't verified.
+func (m *Management) tokenClaims() (tokenClaims, bool) {
+	var claims tokenClaims
+	if m.actorSource == nil {
+		return claims, false
+	}
+
+	token, err := m.actorSource.Token()
+	if err != nil {
+		return claims, false
+	}
+
+	parts := strings.Split(token.AccessToken, ".")
+	if len(parts) != 3 {
+		return claims, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return claims, false
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return claims, false
+	}
+
+	return claims, true
+}
+
+// payloadFields returns the sorted names of the top-level fields of the JSON
+// payload of the request, if any.
+func payloadFields(req *http.Request) []string {
+	// Only JSON payloads are read, so that streamed uploads such as users
+	// files aren't read again.
+	if req.GetBody == nil || !strings.HasPrefix(req.Header.Get("Content-Type"), "application/json") {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return nil
+	}
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return nil
+	}
+
+	fields := make([]string, 0, len(payload))
+	for field := range payload {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
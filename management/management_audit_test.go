@@ -0,0 +1,62 @@
+package management
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/go-auth0"
+)
+
+func TestAuditSink(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"The client does not exist."}`))
+		default:
+			w.Write([]byte(`{"client_id":"123"}`))
+		}
+	})
+	token := "eyJhbGciOiJSUzI1NiJ9." +
+		base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"my-client@clients"}`)) +
+		".signature"
+
+	var events []AuditEvent
+	m := newTestManagement(t, h,
+		WithStaticToken(token),
+		WithAuditSink(func(e AuditEvent) { events = append(events, e) }),
+	)
+
+	_, err := m.Client.Read(context.Background(), "123")
+	require.NoError(t, err)
+
+	err = m.Client.Update(context.Background(), "123", &Client{
+		Name:         auth0.String("My App"),
+		ClientSecret: auth0.String("very-secret"),
+	})
+	require.NoError(t, err)
+
+	err = m.Client.Delete(context.Background(), "456")
+	require.Error(t, err)
+
+	require.Len(t, events, 2)
+
+	assert.Equal(t, "my-client@clients", events[0].Actor)
+	assert.Equal(t, http.MethodPatch, events[0].Method)
+	assert.Equal(t, "clients/123", events[0].Path)
+	assert.Equal(t, []string{"client_secret", "name"}, events[0].Fields)
+	assert.Equal(t, http.StatusOK, events[0].StatusCode)
+	assert.False(t, events[0].Time.IsZero())
+	assert.True(t, events[0].Succeeded())
+
+	assert.Equal(t, http.MethodDelete, events[1].Method)
+	assert.Equal(t, "clients/456", events[1].Path)
+	assert.Empty(t, events[1].Fields)
+	assert.Equal(t, http.StatusNotFound, events[1].StatusCode)
+	assert.False(t, events[1].Succeeded())
+}
@@ -0,0 +1,192 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the configuration of the tenants which management clients can be
+// created for, loaded from a config file by LoadConfig.
+//
+// Its layout is the one of the config file of the Auth0 CLI, so that tools can
+// use the tenants the CLI is logged in to, and can also be written in YAML:
+//
+//	default_tenant: dev
+//	tenants:
+//	  dev:
+//	    domain: dev.eu.auth0.com
+//	    client_id: my-client-id
+//	    client_secret: my-client-secret
+type Config struct {
+	// DefaultTenant is the tenant used when none is selected.
+	DefaultTenant string `json:"default_tenant,omitempty" yaml:"default_tenant,omitempty"`
+
+	// Tenants are the configured tenants, keyed by name or domain.
+	Tenants map[string]*TenantConfig `json:"tenants,omitempty" yaml:"tenants,omitempty"`
+}
+
+// TenantConfig is the configuration of a tenant in a config file.
+type TenantConfig struct {
+	// Name is the name of the tenant.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Domain is the domain of the tenant.
+	Domain string `json:"domain,omitempty" yaml:"domain,omitempty"`
+
+	// ClientID is the client ID used to authenticate.
+	ClientID string `json:"client_id,omitempty" yaml:"client_id,omitempty"`
+
+	// ClientSecret is the client secret used to authenticate with client
+	// credentials.
+	ClientSecret string `json:"client_secret,omitempty" yaml:"client_secret,omitempty"`
+
+	// Audience is the audience of the access tokens retrieved with client
+	// credentials, which defaults to the Management API of the domain.
+	Audience string `json:"audience,omitempty" yaml:"audience,omitempty"`
+
+	// AccessToken is an access token used to authenticate when there's no
+	// client secret, such as the one the Auth0 CLI got when logging in.
+	AccessToken string `json:"access_token,omitempty" yaml:"access_token,omitempty"`
+
+	// ExpiresAt is when the access token expires.
+	ExpiresAt time.Time `json:"expires_at,omitempty" yaml:"expires_at,omitempty"`
+}
+
+// DefaultConfigPath returns the path of the config file of the Auth0 CLI,
+// which is ~/.config/auth0/config.json.
+func DefaultConfigPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "auth0", "config.json"), nil
+}
+
+// LoadConfig reads the config file at the given path, which is decoded as
+// YAML if its extension is .yaml or .yml, and as JSON otherwise.
+func LoadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the config file: %w", err)
+	}
+
+	var config Config
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(b, &config)
+	default:
+		err = json.Unmarshal(b, &config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the config file %s: %w", path, err)
+	}
+
+	return &config, nil
+}
+
+// Tenant returns the configuration of the tenant with the given key, name or
+// domain, or of the default tenant if the given tenant is empty.
+func (c *Config) Tenant(tenant string) (*TenantConfig, error) {
+	if tenant == "" {
+		tenant = c.DefaultTenant
+	}
+	if tenant == "" {
+		if len(c.Tenants) != 1 {
+			return nil, fmt.Errorf("no tenant selected and no default tenant configured among %s", c.tenantNames())
+		}
+		for key := range c.Tenants {
+			tenant = key
+		}
+	}
+
+	if t, ok := c.Tenants[tenant]; ok && t != nil {
+		return t.withDomain(tenant), nil
+	}
+	for key, t := range c.Tenants {
+		if t != nil && (t.Name == tenant || t.Domain == tenant) {
+			return t.withDomain(key), nil
+		}
+	}
+
+	return nil, fmt.Errorf("tenant %q isn't configured, the configured tenants are %s", tenant, c.tenantNames())
+}
+
+func (c *Config) tenantNames() string {
+	if len(c.Tenants) == 0 {
+		return "none"
+	}
+	return strings.Join(sortedKeys(c.Tenants), ", ")
+}
+
+// withDomain returns a copy of the tenant whose domain defaults to its key in
+// the config file, which is the domain of the tenant in the config file of the
+// Auth0 CLI.
+func (t *TenantConfig) withDomain(key string) *TenantConfig {
+	tenant := *t
+	if tenant.Domain == "" && strings.Contains(key, ".") {
+		tenant.Domain = key
+	}
+	return &tenant
+}
+
+// Options returns the options configuring a management client to
+// authenticate to the tenant, with client credentials if the tenant has a
+// client secret, or with its access token otherwise.
+func (t *TenantConfig) Options() ([]Option, error) {
+	switch {
+	case t.ClientID != "" && t.ClientSecret != "":
+		if t.Audience != "" {
+			return []Option{WithClientCredentialsAndAudience(t.ClientID, t.ClientSecret, t.Audience)}, nil
+		}
+		return []Option{WithClientCredentials(t.ClientID, t.ClientSecret)}, nil
+	case t.AccessToken != "":
+		if !t.ExpiresAt.IsZero() && !t.ExpiresAt.After(time.Now()) {
+			return nil, fmt.Errorf("the access token of tenant %s expired at %s", t.Domain, t.ExpiresAt.Format(time.RFC3339))
+		}
+		return []Option{WithStaticToken(t.AccessToken)}, nil
+	default:
+		return nil, fmt.Errorf("tenant %s has neither client credentials nor an access token", t.Domain)
+	}
+}
+
+// NewFromConfig creates a new Auth0 Management client for the given tenant of
+// the config file at path, as selected by Config.Tenant. The path defaults to
+// the one returned by DefaultConfigPath if empty.
+//
+// The access tokens are retrieved with ctx. The options are applied after the
+// ones configured by the config file, so they can override them.
+func NewFromConfig(ctx context.Context, path, tenant string, options ...Option) (*Management, error) {
+	if path == "" {
+		var err error
+		if path, err = DefaultConfigPath(); err != nil {
+			return nil, err
+		}
+	}
+
+	config, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := config.Tenant(tenant)
+	if err != nil {
+		return nil, err
+	}
+	if t.Domain == "" {
+		return nil, fmt.Errorf("the selected tenant %q has no domain", t.Name)
+	}
+
+	configOptions, err := t.Options()
+	if err != nil {
+		return nil, err
+	}
+
+	return New(t.Domain, append(append([]Option{WithContext(ctx)}, configOptions...), options...)...)
+}
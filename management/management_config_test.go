@@ -0,0 +1,117 @@
+package management
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func TestLoadConfig_CLI(t *testing.T) {
+	path := writeConfigFile(t, "config.json", `{
+		"install_id": "3a2b6f5e",
+		"default_tenant": "dev.eu.auth0.com",
+		"tenants": {
+			"dev.eu.auth0.com": {
+				"name": "dev",
+				"access_token": "dev-token",
+				"expires_at": "2100-01-01T00:00:00Z",
+				"client_id": "dev-client-id"
+			},
+			"prod.us.auth0.com": {
+				"name": "prod",
+				"domain": "prod.us.auth0.com",
+				"access_token": "prod-token",
+				"expires_at": "2000-01-01T00:00:00Z"
+			}
+		}
+	}`)
+
+	config, err := LoadConfig(path)
+	require.NoError(t, err)
+
+	tenant, err := config.Tenant("")
+	require.NoError(t, err)
+	assert.Equal(t, "dev.eu.auth0.com", tenant.Domain)
+	assert.Equal(t, "dev-token", tenant.AccessToken)
+	assert.Equal(t, time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), tenant.ExpiresAt)
+
+	tenant, err = config.Tenant("prod")
+	require.NoError(t, err)
+	assert.Equal(t, "prod.us.auth0.com", tenant.Domain)
+
+	_, err = tenant.Options()
+	assert.EqualError(t, err, "the access token of tenant prod.us.auth0.com expired at 2000-01-01T00:00:00Z")
+
+	_, err = config.Tenant("staging")
+	assert.EqualError(t, err, `tenant "staging" isn't configured, the configured tenants are dev.eu.auth0.com, prod.us.auth0.com`)
+
+	m, err := NewFromConfig(context.Background(), path, "dev")
+	require.NoError(t, err)
+
+	token, err := m.tokenSource.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "dev-token", token.AccessToken)
+	assert.Equal(t, "dev.eu.auth0.com", m.url.Host)
+}
+
+func TestLoadConfig_YAML(t *testing.T) {
+	path := writeConfigFile(t, "auth0.yaml", `
+tenants:
+  dev:
+    domain: dev.eu.auth0.com
+    client_id: my-client-id
+    client_secret: my-client-secret
+    audience: https://dev.eu.auth0.com/api/v2/
+`)
+
+	config, err := LoadConfig(path)
+	require.NoError(t, err)
+
+	tenant, err := config.Tenant("")
+	require.NoError(t, err)
+	assert.Equal(t, &TenantConfig{
+		Domain:       "dev.eu.auth0.com",
+		ClientID:     "my-client-id",
+		ClientSecret: "my-client-secret",
+		Audience:     "https://dev.eu.auth0.com/api/v2/",
+	}, tenant)
+
+	options, err := tenant.Options()
+	require.NoError(t, err)
+	assert.Len(t, options, 1)
+
+	assert.NotContains(t, config.String(), "my-client-secret")
+}
+
+func TestLoadConfig_Errors(t *testing.T) {
+	_, err := LoadConfig(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorContains(t, err, "failed to read the config file")
+
+	path := writeConfigFile(t, "config.json", `{"tenants": []}`)
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, err, "failed to decode the config file")
+
+	config := &Config{Tenants: map[string]*TenantConfig{"a": {}, "b": {}}}
+	_, err = config.Tenant("")
+	assert.EqualError(t, err, "no tenant selected and no default tenant configured among a, b")
+
+	_, err = config.Tenant("a")
+	require.NoError(t, err)
+
+	_, err = (&TenantConfig{Domain: "a.auth0.com"}).Options()
+	assert.EqualError(t, err, "tenant a.auth0.com has neither client credentials nor an access token")
+}
@@ -0,0 +1,102 @@
+package management
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/auth0/go-auth0/internal/client"
+)
+
+// DeviceAuthorization tells the user how to authorize a management client
+// created with NewWithDeviceAuthorization.
+type DeviceAuthorization struct {
+	// UserCode is the code the user has to enter at VerificationURI.
+	UserCode string
+
+	// VerificationURI is the URI where the user has to enter UserCode.
+	VerificationURI string
+
+	// VerificationURIComplete is the URI where the user can authorize the
+	// client without entering the code, such as to be shown as a QR code.
+	VerificationURIComplete string
+
+	// ExpiresIn is how long the user has to authorize the client.
+	ExpiresIn time.Duration
+}
+
+// DeviceFlowError is returned by NewWithDeviceAuthorization when the device
+// authorization flow fails, such as with the code "access_denied" when the
+// user denied the authorization, or "expired_token" when the user didn't
+// authorize the client in time.
+type DeviceFlowError = client.DeviceFlowError
+
+// deviceTokenSource is the token source of a management client created with
+// NewWithDeviceAuthorization, which is only known once the flow is done.
+type deviceTokenSource struct {
+	source oauth2.TokenSource
+}
+
+func (s *deviceTokenSource) Token() (*oauth2.Token, error) {
+	return s.source.Token()
+}
+
+// NewWithDeviceAuthorization creates a new Auth0 Management client
+// authenticated with the device authorization flow, so that scripts run by
+// operators can call the Management API on their behalf without machine to
+// machine credentials.
+//
+// The client identified by clientID must be a native application of the
+// tenant with the Device Code grant enabled. The access token is requested
+// for the Management API of the tenant with the given scopes, which should
+// include "offline_access" for the token to be refreshed once it expires.
+//
+// Once the flow is started, prompt is called with what the user has to do to
+// authorize the client, such as to print it, and NewWithDeviceAuthorization
+// returns once the user did or ctx is done. A *DeviceFlowError is returned if
+// the user denied the authorization or didn't authorize the client in time.
+func NewWithDeviceAuthorization(
+	ctx context.Context,
+	domain,
+	clientID string,
+	scopes []string,
+	prompt func(DeviceAuthorization),
+	options ...Option,
+) (*Management, error) {
+	source := &deviceTokenSource{}
+
+	options = append([]Option{WithContext(ctx)}, options...)
+	options = append(options, func(m *Management) {
+		m.tokenSource = source
+	})
+
+	m, err := New(domain, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenContext := m.tokenContext()
+	uri := m.url.String()
+
+	code, err := client.RequestDeviceCode(tokenContext, uri, clientID, uri+"/api/v2/", scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	prompt(DeviceAuthorization{
+		UserCode:                code.UserCode,
+		VerificationURI:         code.VerificationURI,
+		VerificationURIComplete: code.VerificationURIComplete,
+		ExpiresIn:               time.Duration(code.ExpiresIn) * time.Second,
+	})
+
+	token, err := client.PollDeviceToken(tokenContext, uri, clientID, code, m.clock)
+	if err != nil {
+		return nil, err
+	}
+
+	source.source = client.DeviceTokenSource(tokenContext, uri, clientID, token, m.clock)
+
+	return m, nil
+}
@@ -0,0 +1,117 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// immediateClock is a clock whose waits elapse immediately.
+type immediateClock struct{}
+
+func (immediateClock) Now() time.Time {
+	return time.Now()
+}
+
+func (immediateClock) After(time.Duration) <-chan time.Time {
+	c := make(chan time.Time, 1)
+	c <- time.Now()
+	return c
+}
+
+func TestNewWithDeviceAuthorization(t *testing.T) {
+	var polls int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/oauth/device/code":
+			assert.Equal(t, "native-client-id", r.Form.Get("client_id"))
+			assert.Equal(t, "read:users offline_access", r.Form.Get("scope"))
+			assert.Equal(t, "http://"+r.Host+"/api/v2/", r.Form.Get("audience"))
+			w.Write([]byte(`{
+				"device_code": "device-code",
+				"user_code": "ABCD-EFGH",
+				"verification_uri": "https://example.auth0.com/activate",
+				"verification_uri_complete": "https://example.auth0.com/activate?user_code=ABCD-EFGH",
+				"expires_in": 900,
+				"interval": 5
+			}`))
+		case "/oauth/token":
+			assert.Equal(t, "urn:ietf:params:oauth:grant-type:device_code", r.Form.Get("grant_type"))
+			assert.Equal(t, "device-code", r.Form.Get("device_code"))
+			if atomic.AddInt32(&polls, 1) < 3 {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"error":"authorization_pending","error_description":"User has yet to authorize device code."}`))
+				return
+			}
+			w.Write([]byte(`{"access_token":"device-token","token_type":"Bearer","expires_in":86400}`))
+		default:
+			assert.Equal(t, "Bearer device-token", r.Header.Get("Authorization"))
+			w.Write([]byte(`{"user_id":"123"}`))
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	var authorization DeviceAuthorization
+	m, err := NewWithDeviceAuthorization(
+		context.Background(),
+		s.URL,
+		"native-client-id",
+		[]string{"read:users", "offline_access"},
+		func(a DeviceAuthorization) { authorization = a },
+		WithInsecure(),
+		WithClock(immediateClock{}),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, DeviceAuthorization{
+		UserCode:                "ABCD-EFGH",
+		VerificationURI:         "https://example.auth0.com/activate",
+		VerificationURIComplete: "https://example.auth0.com/activate?user_code=ABCD-EFGH",
+		ExpiresIn:               15 * time.Minute,
+	}, authorization)
+	assert.Equal(t, int32(3), polls)
+
+	_, err = m.User.Read("123")
+	assert.NoError(t, err)
+}
+
+func TestNewWithDeviceAuthorization_Denied(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/oauth/device/code":
+			w.Write([]byte(`{"device_code":"device-code","user_code":"ABCD-EFGH","expires_in":900,"interval":5}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error":"access_denied","error_description":"User denied the authorization."}`))
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	_, err := NewWithDeviceAuthorization(
+		context.Background(),
+		s.URL,
+		"native-client-id",
+		nil,
+		func(DeviceAuthorization) {},
+		WithInsecure(),
+		WithClock(immediateClock{}),
+	)
+
+	var flowErr *DeviceFlowError
+	require.True(t, errors.As(err, &flowErr))
+	assert.Equal(t, "access_denied", flowErr.Code)
+	assert.EqualError(t, err, "device authorization failed: access_denied: User denied the authorization.")
+}
@@ -0,0 +1,124 @@
+package management
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// EnsureResult summarizes the changes made by an Ensure method to converge a
+// resource to its desired state.
+//
+// A resource has drifted when any field set on the desired state has a
+// different value on the live resource. Fields the Management API doesn't
+// return, such as secrets, are therefore always patched.
+type EnsureResult struct {
+	// ID of the resource that was ensured.
+	ID string
+
+	// Created is true when the resource didn't exist and was created.
+	Created bool
+
+	// Updated is true when the resource had drifted from the desired state
+	// and was patched.
+	Updated bool
+
+	// Added holds the sub-resources that were added, such as the permissions
+	// of a role or the connections of an organization.
+	Added []string
+
+	// Changed holds the sub-resources that had drifted and were patched.
+	Changed []string
+
+	// Removed holds the sub-resources that were removed because they weren't
+	// part of the desired state.
+	Removed []string
+}
+
+// HasChanges returns true if any change was made to converge the resource.
+func (r *EnsureResult) HasChanges() bool {
+	return r.Created || r.Updated || len(r.Added) > 0 || len(r.Changed) > 0 || len(r.Removed) > 0
+}
+
+// ensure creates desired when read fails because the resource doesn't exist,
+// or updates the existing resource with patch when the fields set on patch
+// have drifted. It returns the existing resource, or desired once created.
+func ensure[T any](
+	desired, patch *T,
+	read func() (*T, error),
+	create func() error,
+	update func(existing *T) error,
+) (*T, *EnsureResult, error) {
+	existing, err := read()
+	if IsStatusNotFound(err) {
+		if err := create(); err != nil {
+			return nil, nil, err
+		}
+		return desired, &EnsureResult{Created: true}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	drift, err := hasDrift(patch, existing)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !drift {
+		return existing, &EnsureResult{}, nil
+	}
+
+	if err := update(existing); err != nil {
+		return nil, nil, err
+	}
+	return existing, &EnsureResult{Updated: true}, nil
+}
+
+// hasDrift returns true if any field set on desired has a different value on
+// live. Fields that are only set on live are ignored, and objects are compared
+// by their fields recursively, so that defaults filled in by the Management
+// API are not considered drift.
+func hasDrift(desired, live interface{}) (bool, error) {
+	d, err := toJSONValue(desired)
+	if err != nil {
+		return false, err
+	}
+
+	l, err := toJSONValue(live)
+	if err != nil {
+		return false, err
+	}
+
+	return !isJSONSubset(d, l), nil
+}
+
+func toJSONValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	err = json.Unmarshal(b, &value)
+	return value, err
+}
+
+func isJSONSubset(desired, live interface{}) bool {
+	desiredObject, ok := desired.(map[string]interface{})
+	if !ok {
+		return reflect.DeepEqual(desired, live)
+	}
+
+	liveObject, ok := live.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	for key, value := range desiredObject {
+		liveValue, ok := liveObject[key]
+		if !ok || !isJSONSubset(value, liveValue) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,68 @@
+package management
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/go-auth0"
+)
+
+func TestHasDrift(t *testing.T) {
+	live := &ResourceServer{
+		ID:         auth0.String("rs_1"),
+		Name:       auth0.String("API"),
+		Identifier: auth0.String("https://api.example.com"),
+		Scopes: &[]ResourceServerScope{
+			{Value: auth0.String("read:things"), Description: auth0.String("Read things")},
+		},
+		TokenLifetime: auth0.Int(86400),
+	}
+
+	for name, test := range map[string]struct {
+		desired *ResourceServer
+		drift   bool
+	}{
+		"empty": {
+			desired: &ResourceServer{},
+		},
+		"same fields": {
+			desired: &ResourceServer{Name: auth0.String("API"), TokenLifetime: auth0.Int(86400)},
+		},
+		"different field": {
+			desired: &ResourceServer{Name: auth0.String("Other API")},
+			drift:   true,
+		},
+		"field missing on live": {
+			desired: &ResourceServer{SigningSecret: auth0.String("secret")},
+			drift:   true,
+		},
+		"same list": {
+			desired: &ResourceServer{Scopes: &[]ResourceServerScope{
+				{Value: auth0.String("read:things"), Description: auth0.String("Read things")},
+			}},
+		},
+		"different list": {
+			desired: &ResourceServer{Scopes: &[]ResourceServerScope{
+				{Value: auth0.String("write:things")},
+			}},
+			drift: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			drift, err := hasDrift(test.desired, live)
+			require.NoError(t, err)
+			assert.Equal(t, test.drift, drift)
+		})
+	}
+}
+
+func TestEnsureResult_HasChanges(t *testing.T) {
+	assert.False(t, (&EnsureResult{ID: "rol_1"}).HasChanges())
+	assert.True(t, (&EnsureResult{Created: true}).HasChanges())
+	assert.True(t, (&EnsureResult{Updated: true}).HasChanges())
+	assert.True(t, (&EnsureResult{Added: []string{"con_1"}}).HasChanges())
+	assert.True(t, (&EnsureResult{Changed: []string{"con_1"}}).HasChanges())
+	assert.True(t, (&EnsureResult{Removed: []string{"con_1"}}).HasChanges())
+}
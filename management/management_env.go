@@ -0,0 +1,81 @@
+package management
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// MissingEnvError is returned by NewFromEnv when environment variables
+// required to configure the management client aren't set.
+type MissingEnvError struct {
+	// Variables are the names of the missing environment variables.
+	Variables []string
+}
+
+// Error formats the error into a string representation.
+func (e *MissingEnvError) Error() string {
+	return fmt.Sprintf("missing environment variables to configure the management client: %s", strings.Join(e.Variables, ", "))
+}
+
+// NewFromEnv creates a new Auth0 Management client configured with the
+// following environment variables:
+//
+//   - AUTH0_DOMAIN, the domain of the tenant, which is required.
+//   - AUTH0_CLIENT_ID and AUTH0_CLIENT_SECRET, the client credentials used to
+//     authenticate, unless AUTH0_API_TOKEN is set.
+//   - AUTH0_API_TOKEN, a static access token used to authenticate instead of
+//     client credentials.
+//   - AUTH0_AUDIENCE, the audience of the access tokens retrieved with client
+//     credentials, which defaults to the Management API of the domain.
+//   - AUTH0_DEBUG, whether to dump the requests and responses to stdout, such
+//     as "true".
+//
+// The access tokens are retrieved with ctx. The options are applied after the
+// ones configured by the environment variables, so they can override them.
+//
+// A *MissingEnvError listing every missing environment variable is returned
+// if some are required but aren't set.
+func NewFromEnv(ctx context.Context, options ...Option) (*Management, error) {
+	var missing []string
+	lookup := func(key string, required bool) string {
+		value := os.Getenv(key)
+		if value == "" && required {
+			missing = append(missing, key)
+		}
+		return value
+	}
+
+	domain := lookup("AUTH0_DOMAIN", true)
+	token := lookup("AUTH0_API_TOKEN", false)
+	clientID := lookup("AUTH0_CLIENT_ID", token == "")
+	clientSecret := lookup("AUTH0_CLIENT_SECRET", token == "")
+	audience := lookup("AUTH0_AUDIENCE", false)
+
+	if len(missing) > 0 {
+		return nil, &MissingEnvError{Variables: missing}
+	}
+
+	envOptions := []Option{WithContext(ctx)}
+
+	if debug := os.Getenv("AUTH0_DEBUG"); debug != "" {
+		enabled, err := strconv.ParseBool(debug)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH0_DEBUG environment variable %q: %w", debug, err)
+		}
+		envOptions = append(envOptions, WithDebug(enabled))
+	}
+
+	switch {
+	case token != "":
+		envOptions = append(envOptions, WithStaticToken(token))
+	case audience != "":
+		envOptions = append(envOptions, WithClientCredentialsAndAudience(clientID, clientSecret, audience))
+	default:
+		envOptions = append(envOptions, WithClientCredentials(clientID, clientSecret))
+	}
+
+	return New(domain, append(envOptions, options...)...)
+}
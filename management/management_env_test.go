@@ -0,0 +1,88 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestNewFromEnv(t *testing.T) {
+	var audience string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			require.NoError(t, r.ParseForm())
+			audience = r.Form.Get("audience")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":86400}`))
+		default:
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			w.Write([]byte(`{"user_id":"123"}`))
+		}
+	})
+	s := httptest.NewTLSServer(h)
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	t.Setenv("AUTH0_DOMAIN", u.Host)
+	t.Setenv("AUTH0_CLIENT_ID", "client-id")
+	t.Setenv("AUTH0_CLIENT_SECRET", "client-secret")
+	t.Setenv("AUTH0_AUDIENCE", "https://api.example.com/")
+	t.Setenv("AUTH0_API_TOKEN", "")
+	t.Setenv("AUTH0_DEBUG", "false")
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, s.Client())
+
+	m, err := NewFromEnv(ctx, WithClient(s.Client()))
+	require.NoError(t, err)
+
+	_, err = m.User.Read("123")
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/", audience)
+}
+
+func TestNewFromEnv_StaticToken(t *testing.T) {
+	t.Setenv("AUTH0_DOMAIN", "example.auth0.com")
+	t.Setenv("AUTH0_CLIENT_ID", "")
+	t.Setenv("AUTH0_CLIENT_SECRET", "")
+	t.Setenv("AUTH0_API_TOKEN", "token")
+
+	m, err := NewFromEnv(context.Background())
+	require.NoError(t, err)
+
+	token, err := m.tokenSource.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token", token.AccessToken)
+}
+
+func TestNewFromEnv_Missing(t *testing.T) {
+	t.Setenv("AUTH0_DOMAIN", "")
+	t.Setenv("AUTH0_CLIENT_ID", "client-id")
+	t.Setenv("AUTH0_CLIENT_SECRET", "")
+	t.Setenv("AUTH0_API_TOKEN", "")
+
+	_, err := NewFromEnv(context.Background())
+
+	var missingErr *MissingEnvError
+	require.True(t, errors.As(err, &missingErr))
+	assert.Equal(t, []string{"AUTH0_DOMAIN", "AUTH0_CLIENT_SECRET"}, missingErr.Variables)
+	assert.EqualError(t, err, "missing environment variables to configure the management client: AUTH0_DOMAIN, AUTH0_CLIENT_SECRET")
+}
+
+func TestNewFromEnv_InvalidDebug(t *testing.T) {
+	t.Setenv("AUTH0_DOMAIN", "example.auth0.com")
+	t.Setenv("AUTH0_API_TOKEN", "token")
+	t.Setenv("AUTH0_DEBUG", "maybe")
+
+	_, err := NewFromEnv(context.Background())
+	assert.ErrorContains(t, err, `invalid AUTH0_DEBUG environment variable "maybe"`)
+}
@@ -2,12 +2,19 @@ package management
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+
+	"github.com/auth0/go-auth0/internal/client"
 )
 
 // Error is an interface describing any error which
 // could be returned by the Auth0 Management API.
+//
+// The errors returned by the Management API are *APIError values, which can
+// be retrieved with errors.As to inspect their error code or payload.
 type Error interface {
 	// Status returns the status code returned by
 	// the server together with the present error.
@@ -15,22 +22,47 @@ type Error interface {
 	error
 }
 
-type managementError struct {
-	StatusCode int    `json:"statusCode"`
-	Err        string `json:"error"`
-	Message    string `json:"message"`
+// APIError is an error returned by the Management API.
+//
+//	var apiErr *management.APIError
+//	if errors.As(err, &apiErr) && apiErr.Code == "insufficient_scope" {
+//		log.Printf("missing scope: %s", apiErr.Message)
+//	}
+type APIError struct {
+	// StatusCode is the status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Err is the text of the status code, such as "Not Found".
+	Err string `json:"error"`
+	// Message describes the error.
+	Message string `json:"message"`
+	// Code identifies the cause of the error, such as "invalid_body" or
+	// "insufficient_scope", when the Management API returned one.
+	Code string `json:"errorCode,omitempty"`
+	// Body is the raw payload of the response, which may hold more details
+	// than the other fields.
+	Body json.RawMessage `json:"-"`
 }
 
 func newError(response *http.Response) error {
-	apiError := &managementError{}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return &APIError{
+			StatusCode: response.StatusCode,
+			Err:        http.StatusText(response.StatusCode),
+			Message:    fmt.Errorf("failed to read the error response payload: %w", err).Error(),
+		}
+	}
 
-	if err := json.NewDecoder(response.Body).Decode(apiError); err != nil {
-		return &managementError{
+	apiError := &APIError{}
+	if err := json.Unmarshal(body, apiError); err != nil {
+		return &APIError{
 			StatusCode: response.StatusCode,
 			Err:        http.StatusText(response.StatusCode),
 			Message:    fmt.Errorf("failed to decode json error response payload: %w", err).Error(),
+			Body:       body,
 		}
 	}
+	apiError.Body = body
 
 	// This can happen in case the error message structure changes.
 	// If that happens we still want to display the correct code.
@@ -42,12 +74,83 @@ func newError(response *http.Response) error {
 	return apiError
 }
 
+// ResponseError returns the error described by a response of the Management
+// API, as returned by Management.Do, or nil if the response is a success. The
+// returned error implements the Error interface, like the errors returned by
+// the methods of the managers.
+//
+// The body of the response is read, but not closed.
+func ResponseError(response *http.Response) error {
+	if response.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+	return newError(response)
+}
+
 // Error formats the error into a string representation.
-func (m *managementError) Error() string {
+func (m *APIError) Error() string {
 	return fmt.Sprintf("%d %s: %s", m.StatusCode, m.Err, m.Message)
 }
 
 // Status returns the status code of the error.
-func (m *managementError) Status() int {
+func (m *APIError) Status() int {
 	return m.StatusCode
 }
+
+// ErrorCode returns the code identifying the cause of the error, such as
+// "inexistent_user", when the Management API returned one.
+func (m *APIError) ErrorCode() string {
+	return m.Code
+}
+
+// IsStatusNotFound returns true if the error was returned by the Management
+// API because the resource doesn't exist.
+func IsStatusNotFound(err error) bool {
+	return errorStatus(err) == http.StatusNotFound
+}
+
+// IsStatusConflict returns true if the error was returned by the Management
+// API because the resource already exists or was changed concurrently.
+func IsStatusConflict(err error) bool {
+	return errorStatus(err) == http.StatusConflict
+}
+
+// IsStatusTooManyRequests returns true if the error was returned by the
+// Management API because the request was rate limited.
+func IsStatusTooManyRequests(err error) bool {
+	return errorStatus(err) == http.StatusTooManyRequests
+}
+
+// errorStatus returns the status code of the error returned by the Management
+// API, or 0 if it wasn't returned by it.
+func errorStatus(err error) int {
+	var managementErr Error
+	if !errors.As(err, &managementErr) {
+		return 0
+	}
+	return managementErr.Status()
+}
+
+// CircuitOpenError is returned when a request is rejected without being sent
+// because the circuit breaker enabled with WithCircuitBreaker is open.
+//
+// Use errors.As to check whether an error was caused by an open circuit:
+//
+//	var circuitErr *management.CircuitOpenError
+//	if errors.As(err, &circuitErr) {
+//		log.Printf("Auth0 is unavailable, retry after %s", circuitErr.RetryAfter)
+//	}
+type CircuitOpenError = client.CircuitOpenError
+
+// RetryDeadlineError is returned when a request isn't attempted again, such as
+// when it's rate limited, because waiting before the next attempt would exceed
+// the deadline of the context of the request.
+//
+// It matches context.DeadlineExceeded with errors.Is, and unwraps to the error
+// of the last attempt:
+//
+//	var deadlineErr *management.RetryDeadlineError
+//	if errors.As(err, &deadlineErr) {
+//		log.Printf("gave up after %d attempts: %v", deadlineErr.Attempts, deadlineErr.Err)
+//	}
+type RetryDeadlineError = client.RetryDeadlineError
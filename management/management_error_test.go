@@ -1,19 +1,22 @@
 package management
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewError(t *testing.T) {
 	var testCases = []struct {
 		name          string
 		givenResponse http.Response
-		expectedError managementError
+		expectedError APIError
 	}{
 		{
 			name: "it fails to decode if body is not a json",
@@ -21,10 +24,11 @@ func TestNewError(t *testing.T) {
 				StatusCode: http.StatusForbidden,
 				Body:       io.NopCloser(strings.NewReader("Hello, I'm not a JSON.")),
 			},
-			expectedError: managementError{
+			expectedError: APIError{
 				StatusCode: 403,
 				Err:        "Forbidden",
 				Message:    "failed to decode json error response payload: invalid character 'H' looking for beginning of value",
+				Body:       []byte("Hello, I'm not a JSON."),
 			},
 		},
 		{
@@ -33,10 +37,11 @@ func TestNewError(t *testing.T) {
 				StatusCode: http.StatusBadRequest,
 				Body:       io.NopCloser(strings.NewReader(`{"statusCode":400,"error":"Bad Request","message":"One of 'client_id' or 'name' is required."}`)),
 			},
-			expectedError: managementError{
+			expectedError: APIError{
 				StatusCode: 400,
 				Err:        "Bad Request",
 				Message:    "One of 'client_id' or 'name' is required.",
+				Body:       []byte(`{"statusCode":400,"error":"Bad Request","message":"One of 'client_id' or 'name' is required."}`),
 			},
 		},
 		{
@@ -45,10 +50,11 @@ func TestNewError(t *testing.T) {
 				StatusCode: http.StatusInternalServerError,
 				Body:       io.NopCloser(strings.NewReader(`{"errorMessage":"wrongStruct"}`)),
 			},
-			expectedError: managementError{
+			expectedError: APIError{
 				StatusCode: 500,
 				Err:        "Internal Server Error",
 				Message:    "",
+				Body:       []byte(`{"errorMessage":"wrongStruct"}`),
 			},
 		},
 	}
@@ -60,3 +66,51 @@ func TestNewError(t *testing.T) {
 		})
 	}
 }
+
+func TestIsStatus(t *testing.T) {
+	notFound := &APIError{StatusCode: http.StatusNotFound, Err: "Not Found", Code: "inexistent_user"}
+	wrapped := fmt.Errorf("failed to read the user: %w", notFound)
+
+	assert.True(t, IsStatusNotFound(notFound))
+	assert.True(t, IsStatusNotFound(wrapped))
+	assert.False(t, IsStatusConflict(wrapped))
+	assert.False(t, IsStatusNotFound(errors.New("404 Not Found")))
+	assert.False(t, IsStatusNotFound(nil))
+	assert.True(t, IsStatusConflict(&APIError{StatusCode: http.StatusConflict}))
+	assert.True(t, IsStatusTooManyRequests(&APIError{StatusCode: http.StatusTooManyRequests}))
+
+	var apiErr *APIError
+	require.ErrorAs(t, wrapped, &apiErr)
+	assert.Equal(t, "inexistent_user", apiErr.Code)
+}
+
+func TestResponseError(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/some-new-endpoint/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"error":"Not Found","message":"The resource does not exist.","errorCode":"inexistent_resource"}`))
+			return
+		}
+		w.Write([]byte(`{"enabled":true}`))
+	})
+	m := newTestManagement(t, h)
+
+	for path, expectedErr := range map[string]string{
+		"found":   "",
+		"missing": "404 Not Found: The resource does not exist.",
+	} {
+		req, err := m.NewRequest(http.MethodGet, m.URI("some-new-endpoint", path), nil)
+		require.NoError(t, err)
+		res, err := m.Do(req)
+		require.NoError(t, err)
+
+		err = ResponseError(res)
+		res.Body.Close()
+		if expectedErr == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		assert.EqualError(t, err, expectedErr)
+		assert.True(t, IsStatusNotFound(err))
+	}
+}
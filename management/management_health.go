@@ -0,0 +1,76 @@
+package management
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// The checks made by Management.Ping, as told by HealthCheckError.Check.
+const (
+	// HealthCheckDNS checks that the domain of the tenant resolves.
+	HealthCheckDNS = "dns"
+	// HealthCheckToken checks that an access token can be obtained.
+	HealthCheckToken = "token"
+	// HealthCheckAPI checks that the Management API accepts the access token.
+	HealthCheckAPI = "api"
+)
+
+// HealthCheckError is returned by Management.Ping when a check failed.
+type HealthCheckError struct {
+	// Check is the failed check, such as HealthCheckDNS.
+	Check string
+	// Err is the error which made the check fail.
+	Err error
+}
+
+// Error formats the error into a string representation.
+func (e *HealthCheckError) Error() string {
+	return fmt.Sprintf("auth0 %s health check failed: %s", e.Check, e.Err)
+}
+
+// Unwrap returns the error which made the check fail.
+func (e *HealthCheckError) Unwrap() error {
+	return e.Err
+}
+
+// Ping checks that the Management API can be used, so that the connectivity to
+// Auth0 can be part of the readiness probes of services. It checks that the
+// domain of the tenant resolves, that an access token can be obtained, and
+// that a cheap authenticated endpoint of the Management API responds.
+//
+// A 403 Forbidden response from the endpoint isn't considered a failure, as it
+// means the access token was accepted but lacks the read:stats scope.
+//
+// If a check fails, a *HealthCheckError telling which one is returned.
+func (m *Management) Ping(ctx context.Context) error {
+	if _, err := net.DefaultResolver.LookupHost(ctx, m.url.Hostname()); err != nil {
+		return &HealthCheckError{Check: HealthCheckDNS, Err: err}
+	}
+
+	if m.actorSource != nil {
+		if _, err := m.actorSource.Token(); err != nil {
+			return &HealthCheckError{Check: HealthCheckToken, Err: err}
+		}
+	}
+
+	request, err := m.NewRequest(http.MethodGet, m.URI("stats", "active-users"), nil, Context(ctx))
+	if err != nil {
+		return &HealthCheckError{Check: HealthCheckAPI, Err: err}
+	}
+
+	// The request isn't sent with Do, so that it isn't refused beforehand
+	// when WithScopePreflight is used.
+	response, err := m.http.Do(request)
+	if err != nil {
+		return &HealthCheckError{Check: HealthCheckAPI, Err: err}
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusBadRequest && response.StatusCode != http.StatusForbidden {
+		return &HealthCheckError{Check: HealthCheckAPI, Err: newError(response)}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagement_Ping(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		status int
+		check  string
+	}{
+		{"healthy", http.StatusOK, ""},
+		{"forbidden", http.StatusForbidden, ""},
+		{"unauthorized", http.StatusUnauthorized, HealthCheckAPI},
+		{"unavailable", http.StatusServiceUnavailable, HealthCheckAPI},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var path string
+			m := newTestManagement(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				w.WriteHeader(test.status)
+				w.Write([]byte(`{}`))
+			}))
+
+			err := m.Ping(context.Background())
+			assert.Equal(t, "/api/v2/stats/active-users", path)
+
+			if test.check == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var healthErr *HealthCheckError
+			require.True(t, errors.As(err, &healthErr))
+			assert.Equal(t, test.check, healthErr.Check)
+
+			var managementErr Error
+			require.True(t, errors.As(err, &managementErr))
+			assert.Equal(t, test.status, managementErr.Status())
+		})
+	}
+}
+
+func TestManagement_PingToken(t *testing.T) {
+	var apiCalled bool
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"access_denied","error_description":"Unauthorized"}`))
+			return
+		}
+		apiCalled = true
+	})
+	m := newTestManagement(t, h, WithClientCredentials("client-id", "wrong-secret"))
+
+	err := m.Ping(context.Background())
+
+	var healthErr *HealthCheckError
+	require.True(t, errors.As(err, &healthErr))
+	assert.Equal(t, HealthCheckToken, healthErr.Check)
+	assert.False(t, apiCalled)
+}
+
+func TestManagement_PingDNS(t *testing.T) {
+	m, err := New("tenant.example.invalid", WithStaticToken("token"))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err = m.Ping(ctx)
+
+	var healthErr *HealthCheckError
+	require.True(t, errors.As(err, &healthErr))
+	assert.Equal(t, HealthCheckDNS, healthErr.Check)
+}
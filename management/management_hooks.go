@@ -0,0 +1,151 @@
+package management
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/auth0/go-auth0/internal/client"
+)
+
+// RequestEvent describes a single attempt at sending a request to the
+// Management API. It is passed to the hooks registered with WithOnRequestStart
+// and WithOnRequestEnd.
+type RequestEvent struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// URL is the URL the request was sent to.
+	URL *url.URL
+	// Endpoint is the collection of the Management API the request was sent
+	// to, such as "users" or "clients".
+	Endpoint string
+	// Attempt is the number of the attempt, starting at 1. Requests are
+	// attempted again when they get rate limited.
+	Attempt int
+	// StatusCode is the status code of the response. It is only set once the
+	// request ended and a response was received.
+	StatusCode int
+	// RateLimit is the rate limit reported by the response. It is only set
+	// once the request ended and a response reporting it was received.
+	RateLimit *RateLimit
+	// Duration is the time it took to receive a response. It is only set once
+	// the request ended.
+	Duration time.Duration
+	// Err is the error which prevented receiving a response, if any. It is only
+	// set once the request ended.
+	Err error
+}
+
+// RetryEvent describes a request about to be attempted again, such as after
+// being rate limited. It is passed to the hooks registered with WithOnRetry.
+type RetryEvent struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// URL is the URL the request is sent to.
+	URL *url.URL
+	// Endpoint is the collection of the Management API the request is sent
+	// to, such as "users" or "clients".
+	Endpoint string
+	// Attempt is the number of the attempt about to be made, starting at 2.
+	Attempt int
+	// Err is the error of the previous attempt, such as the 429 error of a
+	// rate limited request or the 409 error of a conflicting update.
+	Err error
+	// Delay is how long is waited before the attempt.
+	Delay time.Duration
+}
+
+type requestAttemptsKey struct{}
+
+// withRequestAttempts returns a copy of the request whose context counts the
+// attempts made at sending it.
+func withRequestAttempts(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), requestAttemptsKey{}, new(int32)))
+}
+
+// hooksTransport wraps base transport with calls to the hooks at the start and
+// end of every attempt at sending a request.
+func (m *Management) hooksTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return client.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		event := RequestEvent{
+			Method:   req.Method,
+			URL:      req.URL,
+			Endpoint: m.endpoint(req.URL),
+			Attempt:  1,
+		}
+		if attempts, ok := req.Context().Value(requestAttemptsKey{}).(*int32); ok {
+			event.Attempt = int(atomic.AddInt32(attempts, 1))
+		}
+
+		for _, hook := range m.onRequestStart {
+			hook(event)
+		}
+
+		start := time.Now()
+		res, err := base.RoundTrip(req)
+
+		event.Duration = time.Since(start)
+		event.Err = err
+		if res != nil {
+			event.StatusCode = res.StatusCode
+			if rateLimit, ok := ParseRateLimit(res.Header); ok {
+				event.RateLimit = &rateLimit
+			}
+		}
+
+		for _, hook := range m.onRequestEnd {
+			hook(event)
+		}
+
+		return res, err
+	})
+}
+
+// retryHooks returns the hooks of the rate limiting transport, calling the
+// hooks registered with WithOnRetry.
+func (m *Management) retryHooks() []client.RetryHook {
+	if len(m.onRetry) == 0 {
+		return nil
+	}
+
+	return []client.RetryHook{func(req *http.Request, attempt int, res *http.Response, delay time.Duration) {
+		m.retry(RetryEvent{
+			Method:  req.Method,
+			URL:     req.URL,
+			Attempt: attempt,
+			Err:     newError(res),
+			Delay:   delay,
+		})
+	}}
+}
+
+// retry completes the event and passes it to the hooks registered with
+// WithOnRetry.
+func (m *Management) retry(event RetryEvent) {
+	event.Endpoint = m.endpoint(event.URL)
+	for _, hook := range m.onRetry {
+		hook(event)
+	}
+}
+
+// endpoint returns the first path segment following the base path of the
+// Management API.
+func (m *Management) endpoint(u *url.URL) string {
+	path := m.resourcePath(u)
+	if i := strings.IndexByte(path, '/'); i != -1 {
+		path = path[:i]
+	}
+	return path
+}
+
+// resourcePath returns the path following the base path of the Management API.
+func (m *Management) resourcePath(u *url.URL) string {
+	return strings.TrimPrefix(u.Path, strings.TrimSuffix(m.url.Path, "/")+"/"+m.basePath+"/")
+}
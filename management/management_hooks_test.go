@@ -0,0 +1,160 @@
+package management
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/go-auth0"
+)
+
+func TestRequestHooks(t *testing.T) {
+	rateLimited := true
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rateLimited {
+			rateLimited = false
+			w.Header().Set("X-RateLimit-Reset", fmt.Sprint(time.Now().Unix()))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"user_id":"auth0|123"}`))
+	})
+	var started, ended []RequestEvent
+	m := newTestManagement(t, h,
+		WithOnRequestStart(func(e RequestEvent) { started = append(started, e) }),
+		WithOnRequestEnd(func(e RequestEvent) { ended = append(ended, e) }),
+	)
+
+	_, err := m.User.Read("auth0|123")
+	require.NoError(t, err)
+
+	require.Len(t, started, 2)
+	require.Len(t, ended, 2)
+
+	for i, e := range started {
+		assert.Equal(t, http.MethodGet, e.Method)
+		assert.Equal(t, "users", e.Endpoint)
+		assert.Equal(t, "/api/v2/users/auth0|123", e.URL.Path)
+		assert.Equal(t, i+1, e.Attempt)
+		assert.Zero(t, e.StatusCode)
+		assert.Zero(t, e.Duration)
+	}
+
+	assert.Equal(t, 1, ended[0].Attempt)
+	assert.Equal(t, http.StatusTooManyRequests, ended[0].StatusCode)
+	assert.Equal(t, 2, ended[1].Attempt)
+	assert.Equal(t, http.StatusOK, ended[1].StatusCode)
+	for _, e := range ended {
+		assert.Equal(t, "users", e.Endpoint)
+		assert.NotZero(t, e.Duration)
+		assert.NoError(t, e.Err)
+	}
+}
+
+func TestRequestHooks_Error(t *testing.T) {
+	s := httptest.NewServer(http.NotFoundHandler())
+	s.Close()
+
+	var ended []RequestEvent
+	m, err := New(s.URL, WithInsecure(), WithOnRequestEnd(func(e RequestEvent) { ended = append(ended, e) }))
+	require.NoError(t, err)
+
+	_, err = m.Client.Read(context.Background(), "abc")
+	assert.Error(t, err)
+
+	require.Len(t, ended, 1)
+	assert.Equal(t, "clients", ended[0].Endpoint)
+	assert.Equal(t, 1, ended[0].Attempt)
+	assert.Zero(t, ended[0].StatusCode)
+	assert.Error(t, ended[0].Err)
+}
+
+func TestRetryHooks(t *testing.T) {
+	var reads, patches int
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v2/users/auth0|123":
+			// The rate limit is reset immediately after the first attempt,
+			// and after a minute after the following ones.
+			reset := time.Now()
+			if reads++; reads > 1 {
+				reset = reset.Add(time.Minute)
+			}
+			w.Header().Set("X-RateLimit-Reset", fmt.Sprint(reset.Unix()))
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"statusCode":429,"error":"Too Many Requests","message":"Global limit has been reached"}`))
+		case r.Method == http.MethodPatch:
+			patches++
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"statusCode":409,"message":"The client was changed."}`))
+		default:
+			w.Write([]byte(`{"client_id":"123","name":"App"}`))
+		}
+	})
+	var retries []RetryEvent
+	m := newTestManagement(t, h,
+		WithClock(immediateClock{}),
+		WithOnRetry(func(e RetryEvent) { retries = append(retries, e) }),
+	)
+
+	t.Run("rate limited requests", func(t *testing.T) {
+		retries = nil
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		start := time.Now()
+		_, err := m.User.Read("auth0|123", Context(ctx))
+		assert.Less(t, time.Since(start), 10*time.Second)
+
+		var deadlineErr *RetryDeadlineError
+		require.ErrorAs(t, err, &deadlineErr)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 2, deadlineErr.Attempts)
+		assert.Greater(t, deadlineErr.Delay, 30*time.Second)
+		assert.EqualError(t, deadlineErr.Err, "429 Too Many Requests")
+
+		require.Len(t, retries, 1)
+		assert.Equal(t, http.MethodGet, retries[0].Method)
+		assert.Equal(t, "users", retries[0].Endpoint)
+		assert.Equal(t, 2, retries[0].Attempt)
+		assert.LessOrEqual(t, retries[0].Delay, time.Duration(0))
+		assert.EqualError(t, retries[0].Err, "429 Too Many Requests: Global limit has been reached")
+	})
+
+	t.Run("conflicting updates", func(t *testing.T) {
+		retries = nil
+		_, err := m.Client.UpdateWithRetry(context.Background(), "123", func(c *Client) error {
+			c.Description = auth0.String("My app")
+			return nil
+		})
+		assert.Equal(t, http.StatusConflict, err.(Error).Status())
+
+		require.Len(t, retries, maxUpdateAttempts-1)
+		assert.Equal(t, http.MethodPatch, retries[0].Method)
+		assert.Equal(t, "clients", retries[0].Endpoint)
+		assert.Equal(t, 2, retries[0].Attempt)
+		assert.Equal(t, updateRetryDelay, retries[0].Delay)
+		assert.Equal(t, 2*updateRetryDelay, retries[1].Delay)
+		assert.Equal(t, http.StatusConflict, retries[0].Err.(Error).Status())
+
+		ctx, cancel := context.WithTimeout(context.Background(), updateRetryDelay/2)
+		defer cancel()
+		patches = 0
+		_, err = m.Client.UpdateWithRetry(context.Background(), "123", func(c *Client) error {
+			c.Description = auth0.String("My app")
+			return nil
+		}, Context(ctx))
+
+		var deadlineErr *RetryDeadlineError
+		require.ErrorAs(t, err, &deadlineErr)
+		assert.Equal(t, 1, deadlineErr.Attempts)
+		assert.Equal(t, 1, patches)
+		assert.True(t, IsStatusConflict(err))
+	})
+}
@@ -0,0 +1,195 @@
+package management
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// listRequest is how a page of a list was retrieved, which is kept with the
+// page so that the following page can be retrieved the same way with
+// NextPage. It only holds data, so that pages can still be compared.
+type listRequest struct {
+	m      *Management
+	uri    string
+	header http.Header
+}
+
+// pageable is a page of a list of resources, which embeds List.
+type pageable interface {
+	comparable
+	list() *List
+}
+
+func (l *List) list() *List {
+	return l
+}
+
+// listPage retrieves the page of a list of resources at the uri, and
+// remembers how it was retrieved, without the context of the request, so
+// that the following page can be retrieved with NextPage.
+func listPage[L pageable](m *Management, uri string, opts ...RequestOption) (l L, err error) {
+	if err = m.Request(http.MethodGet, uri, &l, opts...); err != nil {
+		return
+	}
+
+	var zero L
+	if l == zero {
+		return
+	}
+
+	r, err := http.NewRequest(http.MethodGet, uri, nil)
+	if err != nil {
+		return
+	}
+	applyRequestOptions(r, opts...)
+
+	l.list().request = &listRequest{m: m, uri: r.URL.String(), header: r.Header}
+
+	return
+}
+
+// nextPage retrieves the page following the page with the given pagination
+// metadata, or returns the zero value if it's the last page.
+func nextPage[L pageable](ctx context.Context, l List) (L, error) {
+	if l.request == nil || !l.HasNext() {
+		var zero L
+		return zero, nil
+	}
+
+	header := newRequestOption(func(r *http.Request) {
+		r.Header = l.request.header.Clone()
+	})
+
+	return listPage[L](l.request.m, l.request.uri, Context(ctx), header, nextPageOption(l))
+}
+
+// nextPageOption configures a request to retrieve the page following the
+// page with the given pagination metadata, using checkpoint pagination when
+// the page has a Next checkpoint and offset pagination otherwise.
+func nextPageOption(l List) RequestOption {
+	if l.Next != "" {
+		return From(l.Next)
+	}
+	return Page(l.Page() + 1)
+}
+
+// listPageFunc retrieves a single page of resources along with the
+// pagination metadata returned by the Management API.
+type listPageFunc[T any] func(opts ...RequestOption) ([]T, List, error)
+
+// listPages returns the function retrieving a single page of resources with
+// list, such as the List method of a manager, and items returning the
+// resources of the page.
+func listPages[T any, L pageable](list func(opts ...RequestOption) (L, error), items func(L) []T) listPageFunc[T] {
+	return func(opts ...RequestOption) ([]T, List, error) {
+		page, err := list(opts...)
+		if err != nil {
+			return nil, List{}, err
+		}
+		var zero L
+		if page == zero {
+			return nil, List{}, nil
+		}
+		return items(page), *page.list(), nil
+	}
+}
+
+// listAll retrieves every resource of a list using offset pagination,
+// starting from the first page, by following the pages with a Paginator.
+//
+// When the first page tells the total number of results, the remaining pages
+// are retrieved concurrently instead, up to the limit configured with
+// WithListConcurrency. Results are returned in the order of the pages.
+func listAll[T any](m *Management, fetch listPageFunc[T], opts ...RequestOption) ([]T, error) {
+	p := &Paginator[T]{fetch: fetch, opts: withPage(opts, 0)}
+	if !p.fetchPage() {
+		return nil, p.err
+	}
+
+	if m.listConcurrency > 1 && p.list.Next == "" && p.list.PageCount() > 1 {
+		return listRemainingPages(m, p)
+	}
+
+	return p.all()
+}
+
+// listRemainingPages retrieves concurrently the pages following the page the
+// paginator is on, and returns their resources after the resources of that
+// page.
+func listRemainingPages[T any](m *Management, p *Paginator[T]) ([]T, error) {
+	first := p.list.Page()
+	pageCount := p.list.PageCount()
+	if first >= pageCount-1 {
+		return p.items, nil
+	}
+
+	pages := make([][]T, pageCount-first)
+	pages[0] = p.items
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		slots    = make(chan struct{}, m.listConcurrency)
+	)
+
+	for page := first + 1; page < pageCount; page++ {
+		slots <- struct{}{}
+
+		mu.Lock()
+		failed := firstErr != nil
+		mu.Unlock()
+		if failed {
+			<-slots
+			break
+		}
+
+		wg.Add(1)
+		go func(page int) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			pageItems, _, err := p.fetch(withPage(p.opts, page)...)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			pages[page-first] = pageItems
+		}(page)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	all := make([]T, 0, p.list.Total)
+	for _, pageItems := range pages {
+		all = append(all, pageItems...)
+	}
+
+	return all, nil
+}
+
+// withPage returns a copy of the options requesting the given page, which is
+// safe to use concurrently with other copies.
+func withPage(opts []RequestOption, page int) []RequestOption {
+	return withOptions(opts, Page(page))
+}
+
+// withOptions returns a copy of the options with more options appended, so
+// that the backing array of the options of the caller is never written to.
+func withOptions(opts []RequestOption, more ...RequestOption) []RequestOption {
+	all := make([]RequestOption, 0, len(opts)+len(more))
+	all = append(all, opts...)
+	return append(all, more...)
+}
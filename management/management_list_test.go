@@ -0,0 +1,195 @@
+package management
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRolePagesHandler(t *testing.T, total int, failingPage int) (http.Handler, *int32) {
+	t.Helper()
+
+	var inFlight, maxInFlight int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			highest := atomic.LoadInt32(&maxInFlight)
+			if current <= highest || atomic.CompareAndSwapInt32(&maxInFlight, highest, current) {
+				break
+			}
+		}
+
+		var page int
+		if p := r.URL.Query().Get("page"); p != "" {
+			var err error
+			page, err = strconv.Atoi(p)
+			require.NoError(t, err)
+		}
+		perPage, err := strconv.Atoi(r.URL.Query().Get("per_page"))
+		require.NoError(t, err)
+		assert.Equal(t, "true", r.URL.Query().Get("include_totals"))
+
+		if page == failingPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"statusCode":500,"error":"Internal Server Error","message":"Something went wrong"}`))
+			return
+		}
+
+		// Make later pages respond sooner, to check the order of the results.
+		time.Sleep(time.Duration(total/perPage-page) * 5 * time.Millisecond)
+
+		roles := `[`
+		for i := page * perPage; i < total && i < (page+1)*perPage; i++ {
+			if i > page*perPage {
+				roles += ","
+			}
+			roles += fmt.Sprintf(`{"id":"rol_%d"}`, i)
+		}
+		roles += `]`
+
+		fmt.Fprintf(w, `{"start":%d,"limit":%d,"total":%d,"roles":%s}`, page*perPage, perPage, total, roles)
+	})
+
+	return h, &maxInFlight
+}
+
+func TestListAll(t *testing.T) {
+	for _, concurrency := range []int{0, 3} {
+		t.Run(fmt.Sprintf("with a concurrency of %d", concurrency), func(t *testing.T) {
+			h, maxInFlight := newRolePagesHandler(t, 230, -1)
+			m := newTestManagement(t, h, WithListConcurrency(concurrency))
+
+			roles, err := m.Role.ListAll()
+			require.NoError(t, err)
+			require.Len(t, roles, 230)
+			for i, role := range roles {
+				assert.Equal(t, fmt.Sprintf("rol_%d", i), role.GetID())
+			}
+
+			expectedMaxInFlight := int32(concurrency)
+			if concurrency == 0 {
+				expectedMaxInFlight = 1
+			}
+			assert.Equal(t, expectedMaxInFlight, atomic.LoadInt32(maxInFlight))
+		})
+	}
+}
+
+func TestListAll_PerPage(t *testing.T) {
+	h, _ := newRolePagesHandler(t, 25, -1)
+	m := newTestManagement(t, h, WithListConcurrency(4))
+
+	roles, err := m.Role.ListAll(PerPage(10))
+	require.NoError(t, err)
+	assert.Len(t, roles, 25)
+}
+
+func TestListAll_Error(t *testing.T) {
+	for _, concurrency := range []int{0, 3} {
+		t.Run(fmt.Sprintf("with a concurrency of %d", concurrency), func(t *testing.T) {
+			h, _ := newRolePagesHandler(t, 230, 2)
+			m := newTestManagement(t, h, WithListConcurrency(concurrency))
+
+			roles, err := m.Role.ListAll()
+			assert.Nil(t, roles)
+
+			var managementErr Error
+			require.ErrorAs(t, err, &managementErr)
+			assert.Equal(t, http.StatusInternalServerError, managementErr.Status())
+		})
+	}
+}
+
+func TestList_Pagination(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		list      List
+		hasNext   bool
+		page      int
+		pageCount int
+	}{
+		{"first page", List{Start: 0, Limit: 50, Length: 50, Total: 120}, true, 0, 3},
+		{"last page", List{Start: 100, Limit: 50, Length: 20, Total: 120}, false, 2, 3},
+		{"without totals", List{Start: 50, Limit: 50, Length: 50}, false, 1, 0},
+		{"checkpoint", List{Next: "cursor"}, true, 0, 0},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.hasNext, test.list.HasNext())
+			assert.Equal(t, test.page, test.list.Page())
+			assert.Equal(t, test.pageCount, test.list.PageCount())
+		})
+	}
+}
+
+func TestList_NextPage(t *testing.T) {
+	h, _ := newRolePagesHandler(t, 120, -1)
+	m := newTestManagement(t, h)
+
+	var ids []string
+	roles, err := m.Role.List(Page(0), PerPage(50))
+	for roles != nil && err == nil {
+		for _, role := range roles.Roles {
+			ids = append(ids, role.GetID())
+		}
+		roles, err = roles.NextPage(context.Background())
+	}
+	require.NoError(t, err)
+
+	require.Len(t, ids, 120)
+	assert.Equal(t, "rol_0", ids[0])
+	assert.Equal(t, "rol_119", ids[119])
+}
+
+func TestList_NextPageFromCheckpoint(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/organizations/org_123/members", r.URL.Path)
+		assert.Equal(t, "10", r.URL.Query().Get("take"))
+
+		switch r.URL.Query().Get("from") {
+		case "":
+			w.Write([]byte(`{"members":[{"user_id":"1"}],"next":"cursor"}`))
+		case "cursor":
+			w.Write([]byte(`{"members":[{"user_id":"2"}]}`))
+		default:
+			t.Errorf("unexpected checkpoint %q", r.URL.Query().Get("from"))
+		}
+	})
+	m := newTestManagement(t, h)
+
+	members, err := m.Organization.Members("org_123", Take(10))
+	require.NoError(t, err)
+	assert.Equal(t, "1", members.Members[0].GetUserID())
+
+	members, err = members.NextPage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "2", members.Members[0].GetUserID())
+
+	members, err = members.NextPage(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, members)
+}
+
+func TestList_PagesCanBeCompared(t *testing.T) {
+	h, _ := newRolePagesHandler(t, 120, -1)
+	m := newTestManagement(t, h)
+
+	first, err := m.Role.List(Page(0), PerPage(50))
+	require.NoError(t, err)
+
+	again, err := m.Role.List(Page(0), PerPage(50))
+	require.NoError(t, err)
+
+	assert.Equal(t, first, again)
+
+	next, err := first.NextPage(context.Background())
+	require.NoError(t, err)
+	assert.NotEqual(t, first, next)
+}
@@ -0,0 +1,83 @@
+package management
+
+import (
+	"expvar"
+	"net/http"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// metricNames are the counters published by WithExpvarMetrics.
+var metricNames = []string{
+	"requests",
+	"errors",
+	"retries",
+	"rate_limited",
+	"token_cache_hits",
+}
+
+// expvarMetricsMu makes looking up and publishing the expvar map of a name
+// atomic, as clients sharing a name can be created at the same time, and
+// publishing a name twice panics.
+var expvarMetricsMu sync.Mutex
+
+// expvarMetrics returns the expvar map holding the counters of the management
+// clients published under name, publishing it if needed.
+func expvarMetrics(name string) *expvar.Map {
+	expvarMetricsMu.Lock()
+	defer expvarMetricsMu.Unlock()
+
+	metrics, ok := expvar.Get(name).(*expvar.Map)
+	if !ok {
+		// Publish panics if a variable of another type has the same name,
+		// which is a programming error.
+		metrics = expvar.NewMap(name)
+	}
+
+	for _, metric := range metricNames {
+		metrics.Add(metric, 0)
+	}
+
+	return metrics
+}
+
+// recordRequest counts an attempt at sending a request to the Management API.
+func recordRequest(metrics *expvar.Map, event RequestEvent) {
+	metrics.Add("requests", 1)
+	if event.Attempt > 1 {
+		metrics.Add("retries", 1)
+	}
+	if event.Err != nil || event.StatusCode >= http.StatusBadRequest {
+		metrics.Add("errors", 1)
+	}
+	if event.StatusCode == http.StatusTooManyRequests {
+		metrics.Add("rate_limited", 1)
+	}
+}
+
+// countingTokenSource counts the tokens returned by source which were
+// returned before, as they were cached.
+type countingTokenSource struct {
+	source  oauth2.TokenSource
+	metrics *expvar.Map
+
+	mu   sync.Mutex
+	last *oauth2.Token
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.source.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	if token == s.last {
+		s.metrics.Add("token_cache_hits", 1)
+	}
+	s.last = token
+	s.mu.Unlock()
+
+	return token, nil
+}
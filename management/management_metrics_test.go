@@ -0,0 +1,82 @@
+package management
+
+import (
+	"expvar"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_WithExpvarMetrics(t *testing.T) {
+	requests := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch requests {
+		case 1:
+			w.Header().Set("X-RateLimit-Reset", fmt.Sprint(time.Now().Unix()))
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.Write([]byte(`{"user_id":"123"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	m := newTestManagement(t, h, WithExpvarMetrics("auth0_management_test"))
+
+	_, err := m.User.Read("123")
+	require.NoError(t, err)
+	_, err = m.User.Read("456")
+	require.Error(t, err)
+
+	metrics := expvar.Get("auth0_management_test").(*expvar.Map)
+	for metric, expected := range map[string]string{
+		"requests":         "3",
+		"errors":           "2",
+		"retries":          "1",
+		"rate_limited":     "1",
+		"token_cache_hits": "2",
+	} {
+		assert.Equal(t, expected, metrics.Get(metric).String(), metric)
+	}
+}
+
+func TestExpvarMetrics_Concurrently(t *testing.T) {
+	// Clients sharing a name, such as those of a Pool, can be created at the
+	// same time, which mustn't publish the name twice.
+	for i := 0; i < 100; i++ {
+		name := fmt.Sprintf("auth0_management_concurrent_test_%d", i)
+
+		start := make(chan struct{})
+		var wg sync.WaitGroup
+		for j := 0; j < 50; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				expvarMetrics(name)
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		metrics, ok := expvar.Get(name).(*expvar.Map)
+		require.True(t, ok)
+		assert.Equal(t, "0", metrics.Get("requests").String())
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := New("example.auth0.com", WithStaticToken("token"), WithExpvarMetrics("auth0_management_concurrent_test"))
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,113 @@
+package management
+
+// Paginator iterates over the resources of a paginated list one at a time,
+// retrieving the pages as they're needed. It follows both offset pagination,
+// with Page and PerPage, and checkpoint pagination, with From and Take,
+// depending on the pagination metadata returned with each page.
+//
+//	p := management.NewPaginator(func(opts ...management.RequestOption) (*management.ClientList, error) {
+//		return api.Client.List(ctx, opts...)
+//	}, func(l *management.ClientList) []*management.Client { return l.Clients })
+//	for p.Next() {
+//		c := p.Item()
+//		// ...
+//	}
+//	if err := p.Err(); err != nil {
+//		// ...
+//	}
+//
+// When the total number of results isn't included with the pages, the pages
+// are retrieved until one isn't full.
+//
+// A Paginator isn't safe for concurrent use.
+type Paginator[T any] struct {
+	fetch listPageFunc[T]
+	opts  []RequestOption
+
+	items   []T
+	item    T
+	size    int
+	list    List
+	fetched bool
+	err     error
+}
+
+// NewPaginator returns a Paginator over the resources retrieved with list,
+// such as the List method of a manager, and returned by items for each page.
+//
+// The first page is retrieved with the options, which can use Page, PerPage,
+// From or Take to choose where to start and the size of the pages.
+func NewPaginator[T any, L pageable](
+	list func(opts ...RequestOption) (L, error),
+	items func(L) []T,
+	opts ...RequestOption,
+) *Paginator[T] {
+	return &Paginator[T]{fetch: listPages(list, items), opts: opts}
+}
+
+// Next advances to the next resource, retrieving the next page when needed.
+// It returns false when there are no more resources or when retrieving a page
+// failed, in which case Err returns the error.
+func (p *Paginator[T]) Next() bool {
+	for len(p.items) == 0 {
+		if !p.fetchPage() {
+			return false
+		}
+	}
+
+	p.item, p.items = p.items[0], p.items[1:]
+	return true
+}
+
+// Item returns the current resource, as advanced to by Next.
+func (p *Paginator[T]) Item() T {
+	return p.item
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (p *Paginator[T]) Err() error {
+	return p.err
+}
+
+// fetchPage retrieves the next page, if any. It returns false when there are
+// no more pages or when retrieving the page failed.
+func (p *Paginator[T]) fetchPage() bool {
+	if p.err != nil || (p.fetched && !p.hasNext()) {
+		return false
+	}
+
+	opts := p.opts
+	if p.fetched {
+		opts = withOptions(p.opts, nextPageOption(p.list))
+	}
+
+	p.items, p.list, p.err = p.fetch(opts...)
+	p.size = len(p.items)
+	p.fetched = true
+
+	return p.err == nil && len(p.items) > 0
+}
+
+// hasNext returns true if a page may follow the current page, which is
+// assumed when the current page is full and the total isn't known.
+func (p *Paginator[T]) hasNext() bool {
+	if p.size == 0 {
+		return false
+	}
+	if p.list.HasNext() {
+		return true
+	}
+	return p.list.Next == "" && p.list.Total == 0 && p.list.Limit > 0 && p.size >= p.list.Limit
+}
+
+// all returns the resources of the current page and of every following page.
+func (p *Paginator[T]) all() ([]T, error) {
+	var all []T
+	for p.Next() {
+		all = append(all, p.Item())
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return all, nil
+}
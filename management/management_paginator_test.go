@@ -0,0 +1,70 @@
+package management
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginator(t *testing.T) {
+	h, _ := newRolePagesHandler(t, 120, -1)
+	m := newTestManagement(t, h)
+
+	var ids []string
+	p := m.Role.Paginate(PerPage(50))
+	for p.Next() {
+		ids = append(ids, p.Item().GetID())
+	}
+	require.NoError(t, p.Err())
+
+	require.Len(t, ids, 120)
+	assert.Equal(t, "rol_0", ids[0])
+	assert.Equal(t, "rol_119", ids[119])
+	assert.False(t, p.Next())
+}
+
+func TestPaginator_Checkpoint(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "10", r.URL.Query().Get("take"))
+
+		switch r.URL.Query().Get("from") {
+		case "":
+			w.Write([]byte(`{"members":[{"user_id":"1"},{"user_id":"2"}],"next":"cursor"}`))
+		case "cursor":
+			w.Write([]byte(`{"members":[{"user_id":"3"}],"next":"last"}`))
+		case "last":
+			w.Write([]byte(`{"members":[]}`))
+		default:
+			t.Errorf("unexpected checkpoint %q", r.URL.Query().Get("from"))
+		}
+	})
+	m := newTestManagement(t, h)
+
+	p := NewPaginator(func(opts ...RequestOption) (*OrganizationMemberList, error) {
+		return m.Organization.Members("org_123", opts...)
+	}, func(l *OrganizationMemberList) []OrganizationMember { return l.Members }, Take(10))
+
+	var ids []string
+	for p.Next() {
+		member := p.Item()
+		ids = append(ids, member.GetUserID())
+	}
+	require.NoError(t, p.Err())
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+}
+
+func TestPaginator_Error(t *testing.T) {
+	h, _ := newRolePagesHandler(t, 120, 1)
+	m := newTestManagement(t, h)
+
+	var count int
+	p := m.Role.Paginate(PerPage(50))
+	for p.Next() {
+		count++
+	}
+	assert.Equal(t, 50, count)
+	assert.Equal(t, http.StatusInternalServerError, p.Err().(Error).Status())
+	assert.False(t, p.Next())
+}
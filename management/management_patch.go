@@ -0,0 +1,40 @@
+package management
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// patchFields holds the fields set on a patch, by their JSON name. Fields
+// cleared with the Clear methods hold nil, which is encoded as null.
+type patchFields map[string]interface{}
+
+func (f patchFields) set(name string, value interface{}) patchFields {
+	if f == nil {
+		f = patchFields{}
+	}
+	f[name] = value
+	return f
+}
+
+// MarshalJSON encodes the fields, or an empty object if none was set.
+func (f patchFields) MarshalJSON() ([]byte, error) {
+	if f == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(map[string]interface{}(f))
+}
+
+func (f patchFields) names() []string {
+	return sortedKeys(f)
+}
+
+// patchResource sends the patch to uri, and returns the updated resource.
+func patchResource[T any](m *Management, uri string, patch interface{}, opts ...RequestOption) (*T, error) {
+	updated := new(T)
+	err := m.Request(http.MethodPatch, uri, &patchPayload[T]{patch: patch, result: updated}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
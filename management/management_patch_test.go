@@ -0,0 +1,53 @@
+package management
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientPatch_MarshalJSON(t *testing.T) {
+	p := (&ClientPatch{}).
+		SetName("My App").
+		SetIsFirstParty(false).
+		SetCallbacks([]string{}).
+		ClearDescription()
+
+	assert.Equal(t, []string{"callbacks", "description", "is_first_party", "name"}, p.Fields())
+
+	b, err := json.Marshal(p)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "My App",
+		"is_first_party": false,
+		"callbacks": [],
+		"description": null
+	}`, string(b))
+
+	b, err = json.Marshal(&ClientPatch{})
+	require.NoError(t, err)
+	assert.Equal(t, `{}`, string(b))
+}
+
+func TestUserManager_Patch(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/api/v2/users/auth0|123", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"email_verified":true,"picture":null}`, string(body))
+
+		w.Write([]byte(`{"user_id":"auth0|123","email_verified":true}`))
+	})
+	m := newTestManagement(t, h)
+
+	u, err := m.User.Patch("auth0|123", (&UserPatch{}).SetEmailVerified(true).ClearPicture())
+	require.NoError(t, err)
+	assert.Equal(t, "auth0|123", u.GetID())
+	assert.True(t, u.GetEmailVerified())
+}
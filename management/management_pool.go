@@ -0,0 +1,185 @@
+package management
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/auth0/go-auth0/internal/client"
+)
+
+// Pool manages the management clients of several tenants, such as for a
+// control plane managing many Auth0 tenants.
+//
+// Clients are created the first time they're needed, and then reused. Each
+// client retrieves and reuses its own access tokens, which can also be
+// persisted for all of them with WithTokenCache, as tokens are cached per
+// tenant. Clients share the same transport, and so the same connection pool,
+// which keeps track of the rate limits reported by each tenant.
+//
+//	pool := management.NewPool(func(domain string) ([]management.Option, error) {
+//		creds, err := secrets.Lookup(domain)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return []management.Option{
+//			management.WithClientCredentials(creds.ClientID, creds.ClientSecret),
+//		}, nil
+//	})
+//
+//	m, err := pool.Get("tenant.eu.auth0.com")
+type Pool struct {
+	tenantOptions func(domain string) ([]Option, error)
+	options       []Option
+	http          *http.Client
+	transport     *http.Transport
+
+	mu      sync.Mutex
+	clients map[string]*Management
+
+	rateLimitsMu sync.Mutex
+	rateLimits   map[string]RateLimit
+}
+
+// RateLimit is the state of the rate limit of a tenant, as reported by the
+// X-RateLimit headers of the responses of its Management API.
+type RateLimit struct {
+	// Limit is the maximum number of requests in the rate limit window.
+	Limit int
+	// Remaining is the number of requests remaining in the window.
+	Remaining int
+	// Reset is when the window resets.
+	Reset time.Time
+}
+
+// NewPool returns a Pool creating the client of each tenant with the options,
+// followed by the options returned by tenantOptions for its domain, such as
+// the credentials of the tenant. tenantOptions can be nil if all tenants use
+// the same options.
+func NewPool(tenantOptions func(domain string) ([]Option, error), options ...Option) *Pool {
+	p := &Pool{
+		tenantOptions: tenantOptions,
+		options:       options,
+		transport:     http.DefaultTransport.(*http.Transport).Clone(),
+		clients:       map[string]*Management{},
+		rateLimits:    map[string]RateLimit{},
+	}
+	p.http = &http.Client{Transport: p.rateLimitTransport(p.transport)}
+
+	return p
+}
+
+// Get returns the client of the tenant with the given domain, creating it if
+// needed.
+//
+// Clients are created without holding the lock of the pool, so that calling
+// tenantOptions, which may take a while, doesn't hold up the other tenants.
+// When several calls create the client of a tenant at the same time, the
+// client created first is kept and returned by all of them.
+func (p *Pool) Get(domain string) (*Management, error) {
+	domain = poolDomain(domain)
+
+	p.mu.Lock()
+	m, ok := p.clients[domain]
+	p.mu.Unlock()
+	if ok {
+		return m, nil
+	}
+
+	m, err := p.newClient(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if existing, ok := p.clients[domain]; ok {
+		return existing, nil
+	}
+	p.clients[domain] = m
+
+	return m, nil
+}
+
+// newClient creates the client of the tenant with the given domain.
+func (p *Pool) newClient(domain string) (*Management, error) {
+	options := append([]Option{WithClient(p.http)}, p.options...)
+	if p.tenantOptions != nil {
+		tenantOptions, err := p.tenantOptions(domain)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, tenantOptions...)
+	}
+
+	return New(domain, options...)
+}
+
+// Remove drops the client of the tenant with the given domain, so that it's
+// created again the next time it's needed, such as after its credentials
+// were rotated.
+func (p *Pool) Remove(domain string) {
+	domain = poolDomain(domain)
+
+	p.mu.Lock()
+	delete(p.clients, domain)
+	p.mu.Unlock()
+
+	p.rateLimitsMu.Lock()
+	delete(p.rateLimits, domain)
+	p.rateLimitsMu.Unlock()
+}
+
+// Domains returns the domains of the tenants whose client was created, sorted.
+func (p *Pool) Domains() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return sortedKeys(p.clients)
+}
+
+// RateLimit returns the state of the rate limit of the tenant with the given
+// domain, and whether any was reported yet.
+func (p *Pool) RateLimit(domain string) (RateLimit, bool) {
+	p.rateLimitsMu.Lock()
+	defer p.rateLimitsMu.Unlock()
+
+	rateLimit, ok := p.rateLimits[poolDomain(domain)]
+	return rateLimit, ok
+}
+
+// CloseIdleConnections closes the idle connections of the transport shared by
+// the clients.
+func (p *Pool) CloseIdleConnections() {
+	p.transport.CloseIdleConnections()
+}
+
+// rateLimitTransport wraps base transport to keep track of the rate limits
+// reported by the responses of each tenant.
+func (p *Pool) rateLimitTransport(base http.RoundTripper) http.RoundTripper {
+	return client.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		res, err := base.RoundTrip(req)
+		if err != nil {
+			return res, err
+		}
+
+		if rateLimit, ok := ParseRateLimit(res.Header); ok {
+			p.rateLimitsMu.Lock()
+			p.rateLimits[req.URL.Host] = rateLimit
+			p.rateLimitsMu.Unlock()
+		}
+
+		return res, nil
+	})
+}
+
+// poolDomain returns the domain without scheme, as clients are keyed by their
+// domain.
+func poolDomain(domain string) string {
+	if i := strings.Index(domain, "//"); i != -1 {
+		domain = domain[i+2:]
+	}
+	return strings.TrimSuffix(domain, "/")
+}
@@ -0,0 +1,105 @@
+package management
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool(t *testing.T) {
+	var requests int32
+	newTenant := func() *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			remaining := 100 - atomic.AddInt32(&requests, 1)
+			w.Header().Set("X-RateLimit-Limit", "100")
+			w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(remaining))
+			w.Header().Set("X-RateLimit-Reset", "1700000000")
+			fmt.Fprintf(w, `{"friendly_name":%q}`, r.Header.Get("Authorization"))
+		}))
+	}
+	tenant1, tenant2 := newTenant(), newTenant()
+	defer tenant1.Close()
+	defer tenant2.Close()
+
+	var created []string
+	pool := NewPool(func(domain string) ([]Option, error) {
+		created = append(created, domain)
+		if domain == "unknown.example.com" {
+			return nil, fmt.Errorf("no credentials for %s", domain)
+		}
+		return []Option{WithStaticToken("token-for-" + strings.Split(domain, ":")[1])}, nil
+	}, WithInsecure())
+	defer pool.CloseIdleConnections()
+
+	domain1 := strings.TrimPrefix(tenant1.URL, "http://")
+	domain2 := strings.TrimPrefix(tenant2.URL, "http://")
+
+	for i := 0; i < 2; i++ {
+		for _, domain := range []string{domain1, tenant2.URL} {
+			m, err := pool.Get(domain)
+			require.NoError(t, err)
+
+			settings, err := m.Tenant.Read()
+			require.NoError(t, err)
+			assert.Equal(t, "Bearer token-for-"+strings.Split(poolDomain(domain), ":")[1], settings.GetFriendlyName())
+		}
+	}
+
+	assert.Equal(t, []string{domain1, domain2}, created)
+	assert.ElementsMatch(t, []string{domain1, domain2}, pool.Domains())
+
+	rateLimit, ok := pool.RateLimit(domain1)
+	require.True(t, ok)
+	assert.Equal(t, 100, rateLimit.Limit)
+	assert.Less(t, rateLimit.Remaining, 100)
+	assert.Equal(t, int64(1700000000), rateLimit.Reset.Unix())
+
+	_, err := pool.Get("unknown.example.com")
+	assert.EqualError(t, err, "no credentials for unknown.example.com")
+
+	pool.Remove(domain1)
+	assert.Equal(t, []string{domain2}, pool.Domains())
+	_, ok = pool.RateLimit(domain1)
+	assert.False(t, ok)
+}
+
+func TestPool_GetWithoutHoldingTheLock(t *testing.T) {
+	var pool *Pool
+	pool = NewPool(func(domain string) ([]Option, error) {
+		// Reading the pool while creating a client doesn't deadlock.
+		if domain == "tenant.example.com" {
+			if _, err := pool.Get("other.example.com"); err != nil {
+				return nil, err
+			}
+		}
+		pool.RateLimit(domain)
+		return []Option{WithStaticToken("token")}, nil
+	})
+	defer pool.CloseIdleConnections()
+
+	m, err := pool.Get("tenant.example.com")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"other.example.com", "tenant.example.com"}, pool.Domains())
+
+	var wg sync.WaitGroup
+	clients := make([]*Management, 10)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			clients[i], _ = pool.Get("https://tenant.example.com/")
+		}(i)
+	}
+	wg.Wait()
+
+	for _, c := range clients {
+		assert.Same(t, m, c)
+	}
+}
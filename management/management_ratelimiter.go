@@ -0,0 +1,97 @@
+package management
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/auth0/go-auth0/internal/client"
+)
+
+// RateLimiter limits the rate of the requests sent to the Management API by
+// every management client using it, so that independent components of a
+// program calling the same tenant don't collectively exceed its rate limit.
+//
+// When a request is rate limited by Auth0 anyway, every client using the rate
+// limiter waits until the rate limit resets before sending more requests.
+//
+// See WithRateLimiter and WithSharedRateLimit.
+type RateLimiter = client.RateLimiter
+
+// NewRateLimiter returns a rate limiter allowing requestsPerSecond requests
+// per second, with bursts of up to burst requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	return client.NewRateLimiter(requestsPerSecond, burst, client.SystemClock)
+}
+
+var (
+	sharedRateLimitersMu sync.Mutex
+	sharedRateLimiters   = map[string]*RateLimiter{}
+)
+
+// sharedRateLimiter returns the rate limiter shared by the management clients
+// of the process calling the tenant with the given domain, creating it with
+// the given limits if it doesn't exist yet.
+func sharedRateLimiter(domain string, requestsPerSecond float64, burst int) *RateLimiter {
+	sharedRateLimitersMu.Lock()
+	defer sharedRateLimitersMu.Unlock()
+
+	limiter, ok := sharedRateLimiters[domain]
+	if !ok {
+		limiter = NewRateLimiter(requestsPerSecond, burst)
+		sharedRateLimiters[domain] = limiter
+	}
+
+	return limiter
+}
+
+// ParseRateLimit returns the rate limit reported by the header of a response
+// of the Management API, such as one returned by Management.Do, or false if
+// the header doesn't report it.
+func ParseRateLimit(header http.Header) (RateLimit, bool) {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return RateLimit{}, false
+	}
+
+	return RateLimit{Limit: limit, Remaining: remaining, Reset: time.Unix(reset, 0)}, true
+}
+
+// RateLimit returns the rate limit reported by the last response received
+// from the Management API, or false if none reported it yet, so that bulk
+// operations can slow down before getting rate limited.
+//
+// As requests may be sent concurrently, use the RateLimit of the events
+// passed to the hooks registered with WithOnRequestEnd to get the rate limit
+// reported for a specific request.
+func (m *Management) RateLimit() (RateLimit, bool) {
+	m.rateLimitMu.Lock()
+	defer m.rateLimitMu.Unlock()
+
+	if m.rateLimit == nil {
+		return RateLimit{}, false
+	}
+	return *m.rateLimit, true
+}
+
+// recordRateLimit remembers the rate limit reported by the header of a
+// response, if any.
+func (m *Management) recordRateLimit(header http.Header) {
+	rateLimit, ok := ParseRateLimit(header)
+	if !ok {
+		return
+	}
+
+	m.rateLimitMu.Lock()
+	defer m.rateLimitMu.Unlock()
+	m.rateLimit = &rateLimit
+}
@@ -14,26 +14,64 @@ import (
 
 // URI returns the absolute URL of the Management API with any path segments
 // appended to the end.
+//
+// Each segment is escaped on its own, so that identifiers containing reserved
+// characters are sent as a single path segment. For example:
+//
+//	m.URI("users", "auth0|abc/def")            // https://{domain}/api/v2/users/auth0%7Cabc%2Fdef
+//	m.URI("users-by-email")                    // https://{domain}/api/v2/users-by-email
+//	m.URI("connections", "My Connection", "x") // https://{domain}/api/v2/connections/My%20Connection/x
+//
+// Segments made only of dots, such as "..", are escaped as well so that they
+// can't be used to traverse to another endpoint.
 func (m *Management) URI(path ...string) string {
+	baseURI := m.baseURI
+	if baseURI == "" {
+		baseURI = m.buildBaseURI()
+	}
+
+	var uri strings.Builder
+	size := len(baseURI)
+	for _, segment := range path {
+		size += len(segment) + 1
+	}
+	uri.Grow(size)
+
+	uri.WriteString(baseURI)
+	for i, segment := range path {
+		if i > 0 {
+			uri.WriteByte('/')
+		}
+		uri.WriteString(escapePathSegment(segment))
+	}
+
+	return uri.String()
+}
+
+// buildBaseURI returns the absolute URL of the Management API, which all
+// URIs returned by URI start with.
+func (m *Management) buildBaseURI() string {
 	baseURL := &url.URL{
 		Scheme: m.url.Scheme,
 		Host:   m.url.Host,
-		Path:   m.basePath + "/",
+		Path:   strings.TrimSuffix(m.url.Path, "/") + "/" + m.basePath + "/",
 	}
 
-	const escapedForwardSlash = "%2F"
-	var escapedPath []string
-	for _, unescapedPath := range path {
-		// Go's url.PathEscape will not escape "/", but some user IDs do have a valid "/" in them.
-		// See https://github.com/golang/go/blob/b55a2fb3b0d67b346bac871737b862f16e5a6447/src/net/url/url.go#L141.
-		defaultPathEscaped := url.PathEscape(unescapedPath)
-		escapedPath = append(
-			escapedPath,
-			strings.ReplaceAll(defaultPathEscaped, "/", escapedForwardSlash),
-		)
+	return baseURL.String()
+}
+
+// escapePathSegment escapes a single path segment of a URL.
+func escapePathSegment(segment string) string {
+	// Dot segments would be resolved by proxies and servers,
+	// making the request target a different path altogether.
+	if segment == "." || segment == ".." {
+		return strings.Repeat("%2E", len(segment))
 	}
 
-	return baseURL.String() + strings.Join(escapedPath, "/")
+	// Go's url.PathEscape will not escape "/", but some user IDs do have a valid "/" in them.
+	// See https://github.com/golang/go/blob/b55a2fb3b0d67b346bac871737b862f16e5a6447/src/net/url/url.go#L141.
+	const escapedForwardSlash = "%2F"
+	return strings.ReplaceAll(url.PathEscape(segment), "/", escapedForwardSlash)
 }
 
 // NewRequest returns a new HTTP request. If the payload is not nil it will be
@@ -44,6 +82,14 @@ func (m *Management) NewRequest(
 	payload interface{},
 	options ...RequestOption,
 ) (*http.Request, error) {
+	if m.validate && method != http.MethodGet && method != http.MethodDelete {
+		if v, ok := payload.(Validator); ok {
+			if err := v.Validate(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	var body bytes.Buffer
 	if payload != nil {
 		if err := json.NewEncoder(&body).Encode(payload); err != nil {
@@ -58,19 +104,39 @@ func (m *Management) NewRequest(
 
 	request.Header.Add("Content-Type", "application/json")
 
-	for _, option := range options {
-		option.apply(request)
-	}
+	applyRequestOptions(request, options...)
 
 	return request, nil
 }
 
 // Do triggers an HTTP request and returns an HTTP response,
 // handling any context cancellations or timeouts.
-func (m *Management) Do(req *http.Request) (*http.Response, error) {
+func (m *Management) Do(req *http.Request) (response *http.Response, err error) {
 	ctx := req.Context()
 
-	response, err := m.http.Do(req)
+	if len(m.auditSinks) > 0 && isMutatingMethod(req.Method) {
+		event := m.newAuditEvent(req)
+		defer func() {
+			m.audit(event, response, err)
+		}()
+	}
+
+	if m.scopePreflight {
+		if err := m.checkScopes(req.Method, req.URL); err != nil {
+			// Like http.Client.Do, close the body of requests which aren't
+			// sent, so that streamed bodies stop being written.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+
+	if len(m.onRequestStart) > 0 || len(m.onRequestEnd) > 0 {
+		req = withRequestAttempts(req)
+	}
+
+	response, err = m.http.Do(req)
 	if err != nil {
 		select {
 		case <-ctx.Done():
@@ -80,6 +146,8 @@ func (m *Management) Do(req *http.Request) (*http.Response, error) {
 		}
 	}
 
+	m.recordRateLimit(response.Header)
+
 	return response, nil
 }
 
@@ -97,8 +165,8 @@ func (m *Management) Request(method, uri string, payload interface{}, options ..
 	defer response.Body.Close()
 
 	// If the response contains a client or a server error then return the error.
-	if response.StatusCode >= http.StatusBadRequest {
-		return newError(response)
+	if err := ResponseError(response); err != nil {
+		return err
 	}
 
 	responseBody, err := io.ReadAll(response.Body)
@@ -123,14 +191,36 @@ func (m *Management) Request(method, uri string, payload interface{}, options ..
 // Specific implementations embed this struct, therefore its direct use is not
 // useful. Rather it has been made public in order to aid documentation.
 type List struct {
-	Start  int    `json:"start"`
-	Limit  int    `json:"limit"`
-	Length int    `json:"length"`
-	Total  int    `json:"total"`
-	Next   string `json:"next"`
+	// Start is the index of the first result of the page among all the
+	// results, when using offset pagination.
+	Start int `json:"start"`
+
+	// Limit is the number of results per page, when using offset
+	// pagination.
+	Limit int `json:"limit"`
+
+	// Length is the number of results in the page, when using offset
+	// pagination.
+	Length int `json:"length"`
+
+	// Total is the number of results across all the pages, when using
+	// offset pagination with IncludeTotals(true).
+	Total int `json:"total"`
+
+	// Next is the checkpoint from which to retrieve the next page, when using
+	// checkpoint pagination with From and Take.
+	Next string `json:"next"`
+
+	// request is how the page was retrieved, as remembered by listPage, so
+	// that NextPage can retrieve the following page.
+	request *listRequest
 }
 
 // HasNext returns true if the list has more results.
+//
+// With checkpoint pagination, it's true when the page has a Next checkpoint.
+// With offset pagination, it's true when more results are known to follow the
+// page, which requires the totals to be included with IncludeTotals(true).
 func (l List) HasNext() bool {
 	if l.Next != "" {
 		return true
@@ -139,6 +229,24 @@ func (l List) HasNext() bool {
 	return l.Total > l.Start+l.Limit
 }
 
+// Page returns the zero-based index of the page, when using offset
+// pagination.
+func (l List) Page() int {
+	if l.Limit <= 0 {
+		return 0
+	}
+	return l.Start / l.Limit
+}
+
+// PageCount returns the number of pages of results, when using offset
+// pagination with IncludeTotals(true), or 0 if it's unknown.
+func (l List) PageCount() int {
+	if l.Total <= 0 || l.Limit <= 0 {
+		return 0
+	}
+	return (l.Total + l.Limit - 1) / l.Limit
+}
+
 // RequestOption configures a call (typically to retrieve a resource) to Auth0 with
 // query parameters.
 type RequestOption interface {
@@ -149,22 +257,81 @@ func newRequestOption(fn func(r *http.Request)) *requestOption {
 	return &requestOption{applyFn: fn}
 }
 
+// newQueryOption returns a RequestOption which only sets query parameters.
+// Consecutive query options are applied to a request at once, sparing the
+// parsing and encoding of the query string for each of them.
+func newQueryOption(fn func(q url.Values)) *requestOption {
+	return &requestOption{queryFn: fn}
+}
+
+// newRequestOptions combines several options into a single one.
+func newRequestOptions(options ...RequestOption) *requestOption {
+	return &requestOption{options: options}
+}
+
 type requestOption struct {
 	applyFn func(r *http.Request)
+	queryFn func(q url.Values)
+	options []RequestOption
 }
 
 func (o *requestOption) apply(r *http.Request) {
-	o.applyFn(r)
+	applyRequestOptions(r, o)
 }
 
-func applyListDefaults(options []RequestOption) RequestOption {
-	return newRequestOption(func(r *http.Request) {
-		PerPage(50).apply(r)
-		IncludeTotals(true).apply(r)
+// applyRequestOptions applies the options to the request in order, parsing
+// and encoding the query string only when needed.
+func applyRequestOptions(r *http.Request, options ...RequestOption) {
+	var query url.Values
+
+	flushQuery := func() {
+		if query != nil {
+			r.URL.RawQuery = query.Encode()
+			query = nil
+		}
+	}
+
+	var apply func(options []RequestOption)
+	apply = func(options []RequestOption) {
 		for _, option := range options {
-			option.apply(r)
+			o, ok := option.(*requestOption)
+			switch {
+			case !ok:
+				flushQuery()
+				option.apply(r)
+			case o.queryFn != nil:
+				if query == nil {
+					query = r.URL.Query()
+				}
+				o.queryFn(query)
+			case o.options != nil:
+				apply(o.options)
+			case o.applyFn != nil:
+				flushQuery()
+				o.applyFn(r)
+			}
 		}
-	})
+	}
+
+	apply(options)
+	flushQuery()
+}
+
+// withContext returns the options with the context applied first, so that a
+// Context option among them still takes precedence.
+func withContext(ctx context.Context, options []RequestOption) []RequestOption {
+	return append([]RequestOption{Context(ctx)}, options...)
+}
+
+// requestContext returns the context the options configure requests to use.
+func requestContext(options []RequestOption) context.Context {
+	r := &http.Request{URL: &url.URL{}, Header: http.Header{}}
+	applyRequestOptions(r, options...)
+	return r.Context()
+}
+
+func applyListDefaults(options []RequestOption) RequestOption {
+	return newRequestOptions(append([]RequestOption{PerPage(50), IncludeTotals(true)}, options...)...)
 }
 
 // Context configures a request to use the specified context.
@@ -176,67 +343,53 @@ func Context(ctx context.Context) RequestOption {
 
 // IncludeFields configures a request to include the desired fields.
 func IncludeFields(fields ...string) RequestOption {
-	return newRequestOption(func(r *http.Request) {
-		q := r.URL.Query()
+	return newQueryOption(func(q url.Values) {
 		q.Set("fields", strings.Join(fields, ","))
 		q.Set("include_fields", "true")
-		r.URL.RawQuery = q.Encode()
 	})
 }
 
 // ExcludeFields configures a request to exclude the desired fields.
 func ExcludeFields(fields ...string) RequestOption {
-	return newRequestOption(func(r *http.Request) {
-		q := r.URL.Query()
+	return newQueryOption(func(q url.Values) {
 		q.Set("fields", strings.Join(fields, ","))
 		q.Set("include_fields", "false")
-		r.URL.RawQuery = q.Encode()
 	})
 }
 
 // Page configures a request to receive a specific page, if the results where
 // concatenated.
 func Page(page int) RequestOption {
-	return newRequestOption(func(r *http.Request) {
-		q := r.URL.Query()
+	return newQueryOption(func(q url.Values) {
 		q.Set("page", strconv.FormatInt(int64(page), 10))
-		r.URL.RawQuery = q.Encode()
 	})
 }
 
 // PerPage configures a request to limit the amount of items in the result.
 func PerPage(items int) RequestOption {
-	return newRequestOption(func(r *http.Request) {
-		q := r.URL.Query()
+	return newQueryOption(func(q url.Values) {
 		q.Set("per_page", strconv.FormatInt(int64(items), 10))
-		r.URL.RawQuery = q.Encode()
 	})
 }
 
 // IncludeTotals configures a request to include totals.
 func IncludeTotals(include bool) RequestOption {
-	return newRequestOption(func(r *http.Request) {
-		q := r.URL.Query()
+	return newQueryOption(func(q url.Values) {
 		q.Set("include_totals", strconv.FormatBool(include))
-		r.URL.RawQuery = q.Encode()
 	})
 }
 
 // From configures a request to start from the specified checkpoint.
 func From(checkpoint string) RequestOption {
-	return newRequestOption(func(r *http.Request) {
-		q := r.URL.Query()
+	return newQueryOption(func(q url.Values) {
 		q.Set("from", checkpoint)
-		r.URL.RawQuery = q.Encode()
 	})
 }
 
 // Take configures a request to limit the amount of items in the result for a checkpoint based request.
 func Take(items int) RequestOption {
-	return newRequestOption(func(r *http.Request) {
-		q := r.URL.Query()
+	return newQueryOption(func(q url.Values) {
 		q.Set("take", strconv.FormatInt(int64(items), 10))
-		r.URL.RawQuery = q.Encode()
 	})
 }
 
@@ -251,21 +404,17 @@ func Take(items int) RequestOption {
 //
 // See: https://auth0.com/docs/users/search/v3/query-syntax
 func Query(s string) RequestOption {
-	return newRequestOption(func(r *http.Request) {
-		q := r.URL.Query()
+	return newQueryOption(func(q url.Values) {
 		q.Set("search_engine", "v3")
 		q.Set("q", s)
-		r.URL.RawQuery = q.Encode()
 	})
 }
 
 // Parameter configures a request to add arbitrary query parameters to requests
 // made to Auth0.
 func Parameter(key, value string) RequestOption {
-	return newRequestOption(func(r *http.Request) {
-		q := r.URL.Query()
+	return newQueryOption(func(q url.Values) {
 		q.Set(key, value)
-		r.URL.RawQuery = q.Encode()
 	})
 }
 
@@ -0,0 +1,64 @@
+package management
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/auth0/go-auth0"
+)
+
+func newBenchmarkManagement(b *testing.B) *Management {
+	b.Helper()
+
+	m, err := New("example.auth0.com", WithStaticToken("token"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return m
+}
+
+func BenchmarkManagement_URI(b *testing.B) {
+	m := newBenchmarkManagement(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.URI("users", "auth0|123", "roles")
+	}
+}
+
+func BenchmarkManagement_NewRequest(b *testing.B) {
+	m := newBenchmarkManagement(b)
+	uri := m.URI("clients", "abc")
+	payload := &Client{Name: auth0.String("Test Client"), Description: auth0.String("Description")}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.NewRequest(http.MethodPatch, uri, payload); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkManagement_NewRequestOptions(b *testing.B) {
+	m := newBenchmarkManagement(b)
+	uri := m.URI("users")
+	opts := []RequestOption{
+		Page(2),
+		PerPage(50),
+		IncludeTotals(true),
+		IncludeFields("user_id", "email"),
+		Query(`email:"john@example.com"`),
+		Parameter("search_engine", "v3"),
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.NewRequest(http.MethodGet, uri, nil, opts...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package management
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// scopeRule tells the scopes required by the endpoints matching its method and
+// path pattern, whose "*" segments match any segment. The scopes are either
+// listed, or made of the action of the method on the resource.
+type scopeRule struct {
+	method   string
+	pattern  string
+	scopes   []string
+	resource string
+}
+
+// scopeRules lists the endpoints whose required scopes don't follow the
+// convention of the Management API, which is the action of the method on the
+// collection, such as "read:clients" for GET clients/{id}. The first matching
+// rule applies.
+var scopeRules = []scopeRule{
+	{method: http.MethodGet, pattern: "users/*/roles", scopes: []string{"read:users", "read:roles"}},
+	{pattern: "users/*/roles", scopes: []string{"update:users"}},
+	{method: http.MethodGet, pattern: "users/*/permissions", scopes: []string{"read:users"}},
+	{pattern: "users/*/permissions", scopes: []string{"update:users"}},
+	// Either read:logs or read:logs_users is enough, read:logs being the one
+	// granted along with the scopes of the other log endpoints.
+	{method: http.MethodGet, pattern: "users/*/logs", scopes: []string{"read:logs"}},
+	{pattern: "users/*/identities", scopes: []string{"update:users"}},
+	{pattern: "users/*/identities/*/*", scopes: []string{"update:users"}},
+	{pattern: "users/*/recovery-code-regeneration", scopes: []string{"update:users"}},
+	{pattern: "users/*/multifactor/*", scopes: []string{"update:users"}},
+	{pattern: "users/*/authenticators", scopes: []string{"delete:guardian_enrollments"}},
+	{pattern: "users/*/authentication-methods", resource: "authentication_methods"},
+	{pattern: "users/*/authentication-methods/*", resource: "authentication_methods"},
+	{method: http.MethodDelete, pattern: "user-blocks", scopes: []string{"update:users"}},
+	{method: http.MethodDelete, pattern: "user-blocks/*", scopes: []string{"update:users"}},
+	{method: http.MethodGet, pattern: "roles/*/users", scopes: []string{"read:users", "read:roles"}},
+	{pattern: "roles/*/users", scopes: []string{"create:role_members"}},
+	{method: http.MethodGet, pattern: "roles/*/permissions", scopes: []string{"read:roles"}},
+	{pattern: "roles/*/permissions", scopes: []string{"update:roles"}},
+	{pattern: "organizations/name/*", scopes: []string{"read:organizations"}},
+	{pattern: "organizations/*/members", resource: "organization_members"},
+	{pattern: "organizations/*/members/*/roles", resource: "organization_member_roles"},
+	{pattern: "organizations/*/enabled_connections", resource: "organization_connections"},
+	{pattern: "organizations/*/enabled_connections/*", resource: "organization_connections"},
+	{pattern: "organizations/*/invitations", resource: "organization_invitations"},
+	{pattern: "organizations/*/invitations/*", resource: "organization_invitations"},
+	{pattern: "organizations/*/client-grants", resource: "organization_client_grants"},
+	{pattern: "organizations/*/client-grants/*", resource: "organization_client_grants"},
+	{pattern: "clients/*/rotate-secret", scopes: []string{"update:client_keys"}},
+	{pattern: "clients/*/credentials", resource: "client_credentials"},
+	{pattern: "clients/*/credentials/*", resource: "client_credentials"},
+	{pattern: "guardian/enrollments/ticket", scopes: []string{"create:guardian_enrollment_tickets"}},
+	{pattern: "guardian/enrollments/*", resource: "guardian_enrollments"},
+	{pattern: "jobs/users-imports", scopes: []string{"create:users"}},
+	{pattern: "jobs/users-exports", scopes: []string{"read:users"}},
+	{pattern: "jobs/verification-email", scopes: []string{"update:users"}},
+	{pattern: "jobs/*", scopes: []string{"read:users"}},
+	{pattern: "jobs/*/errors", scopes: []string{"read:users"}},
+	{pattern: "tickets/*", scopes: []string{"create:user_tickets"}},
+	{method: http.MethodPost, pattern: "blacklists/tokens", scopes: []string{"blacklist:tokens"}},
+	{pattern: "blacklists/tokens", resource: "blacklisted_tokens"},
+	{method: http.MethodPost, pattern: "keys/signing/rotate", scopes: []string{"create:signing_keys"}},
+}
+
+// scopeResources lists the collections whose scopes are named after another
+// resource.
+var scopeResources = map[string]string{
+	"anomaly":        "anomaly_blocks",
+	"emails":         "email_provider",
+	"guardian":       "guardian_factors",
+	"keys":           "signing_keys",
+	"tenants":        "tenant_settings",
+	"user-blocks":    "users",
+	"users-by-email": "users",
+}
+
+var scopeActions = map[string]string{
+	http.MethodGet:    "read",
+	http.MethodPost:   "create",
+	http.MethodPut:    "update",
+	http.MethodPatch:  "update",
+	http.MethodDelete: "delete",
+}
+
+// RequiredScopes returns the scopes of the Management API required to send a
+// request with the method to the path, relative to the Management API, such
+// as "users/{id}/roles".
+//
+// The scopes are the ones documented for each endpoint of the Management API,
+// and are the ones required by the methods of the managers sending requests
+// to them. For example the scopes required by UserManager.Roles are the ones
+// returned for GET users/{id}/roles.
+func RequiredScopes(method, path string) []string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	resource := ""
+	for _, rule := range scopeRules {
+		if (rule.method == "" || rule.method == method) && matchesScopePattern(segments, rule.pattern) {
+			if rule.scopes != nil {
+				return append([]string(nil), rule.scopes...)
+			}
+			resource = rule.resource
+			break
+		}
+	}
+
+	action, ok := scopeActions[method]
+	if !ok || segments[0] == "" {
+		return nil
+	}
+
+	if resource == "" {
+		resource = scopeResources[segments[0]]
+	}
+	if resource == "" {
+		resource = strings.ReplaceAll(segments[0], "-", "_")
+	}
+
+	return []string{action + ":" + resource}
+}
+
+func matchesScopePattern(segments []string, pattern string) bool {
+	patternSegments := strings.Split(pattern, "/")
+	if len(segments) != len(patternSegments) {
+		return false
+	}
+
+	for i, segment := range patternSegments {
+		if segment != "*" && segment != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// InsufficientScopeError is returned without sending the request when
+// WithScopePreflight is used and the access token lacks scopes required by
+// the request.
+type InsufficientScopeError struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Path is the path of the request, relative to the Management API.
+	Path string
+	// Required are the scopes required by the request.
+	Required []string
+	// Missing are the required scopes the access token lacks.
+	Missing []string
+}
+
+// Error formats the error into a string representation.
+func (e *InsufficientScopeError) Error() string {
+	return fmt.Sprintf(
+		"403 Forbidden: %s %s requires the scopes %s, but the access token lacks %s",
+		e.Method, e.Path, strings.Join(e.Required, ", "), strings.Join(e.Missing, ", "),
+	)
+}
+
+// Status returns the status code the Management API would have returned.
+func (e *InsufficientScopeError) Status() int {
+	return http.StatusForbidden
+}
+
+// ErrorCode returns the error code the Management API would have returned.
+func (e *InsufficientScopeError) ErrorCode() string {
+	return "insufficient_scope"
+}
+
+// checkScopes returns an InsufficientScopeError if the access token of the
+// management client lacks any scope required to send a request with the
+// method to u. The scopes aren't checked when the access token isn't a JWT
+// with a scope claim.
+func (m *Management) checkScopes(method string, u *url.URL) error {
+	claims, ok := m.tokenClaims()
+	if !ok || claims.Scope == nil {
+		return nil
+	}
+
+	granted := map[string]bool{}
+	for _, scope := range strings.Fields(*claims.Scope) {
+		granted[scope] = true
+	}
+
+	path := strings.TrimPrefix(u.EscapedPath(), strings.TrimSuffix(m.url.Path, "/")+"/"+m.basePath+"/")
+	required := RequiredScopes(method, path)
+
+	var missing []string
+	for _, scope := range required {
+		if !granted[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return &InsufficientScopeError{Method: method, Path: path, Required: required, Missing: missing}
+}
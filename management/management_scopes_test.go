@@ -0,0 +1,132 @@
+package management
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredScopes(t *testing.T) {
+	for _, test := range []struct {
+		method string
+		path   string
+		scopes []string
+	}{
+		{http.MethodGet, "clients", []string{"read:clients"}},
+		{http.MethodPatch, "clients/123", []string{"update:clients"}},
+		{http.MethodPost, "clients/123/rotate-secret", []string{"update:client_keys"}},
+		{http.MethodDelete, "resource-servers/123", []string{"delete:resource_servers"}},
+		{http.MethodPatch, "tenants/settings", []string{"update:tenant_settings"}},
+		{http.MethodGet, "users/auth0%7C123/roles", []string{"read:users", "read:roles"}},
+		{http.MethodPost, "users/auth0%7C123/roles", []string{"update:users"}},
+		{http.MethodGet, "users-by-email", []string{"read:users"}},
+		{http.MethodGet, "users/auth0%7C123/logs", []string{"read:logs"}},
+		{http.MethodPost, "roles/123/users", []string{"create:role_members"}},
+		{http.MethodGet, "organizations/name/acme", []string{"read:organizations"}},
+		{http.MethodPost, "organizations/123/members", []string{"create:organization_members"}},
+		{http.MethodDelete, "organizations/123/members/456/roles", []string{"delete:organization_member_roles"}},
+		{http.MethodPost, "guardian/enrollments/ticket", []string{"create:guardian_enrollment_tickets"}},
+		{http.MethodGet, "jobs/123", []string{"read:users"}},
+		{http.MethodPost, "tickets/password-change", []string{"create:user_tickets"}},
+		{http.MethodPost, "blacklists/tokens", []string{"blacklist:tokens"}},
+		{http.MethodGet, "blacklists/tokens", []string{"read:blacklisted_tokens"}},
+		{http.MethodOptions, "clients", nil},
+	} {
+		t.Run(test.method+" "+test.path, func(t *testing.T) {
+			assert.Equal(t, test.scopes, RequiredScopes(test.method, test.path))
+		})
+	}
+}
+
+func TestScopePreflight(t *testing.T) {
+	var requests int
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"client_id":"123"}`))
+	})
+	token := "eyJhbGciOiJSUzI1NiJ9." +
+		base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"my-client@clients","scope":"read:clients"}`)) +
+		".signature"
+
+	m := newTestManagement(t, h, WithStaticToken(token), WithScopePreflight())
+
+	_, err := m.Client.Read(context.Background(), "123")
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	err = m.Client.Delete(context.Background(), "123")
+	require.Error(t, err)
+	assert.Equal(t, 1, requests)
+
+	var scopeErr *InsufficientScopeError
+	require.True(t, errors.As(err, &scopeErr))
+	assert.Equal(t, "clients/123", scopeErr.Path)
+	assert.Equal(t, []string{"delete:clients"}, scopeErr.Missing)
+	assert.Equal(t, http.StatusForbidden, scopeErr.Status())
+	assert.Contains(t, err.Error(), "DELETE clients/123 requires the scopes delete:clients")
+
+	var managementErr Error
+	require.True(t, errors.As(err, &managementErr))
+	assert.Equal(t, http.StatusForbidden, managementErr.Status())
+}
+
+func TestScopePreflight_UserLogsWithReadLogs(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	})
+	token := "eyJhbGciOiJSUzI1NiJ9." +
+		base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"my-client@clients","scope":"read:logs"}`)) +
+		".signature"
+	m := newTestManagement(t, h, WithStaticToken(token), WithScopePreflight())
+
+	var logs []*Log
+	err := m.Request(http.MethodGet, m.URI("users", "auth0|123", "logs"), &logs)
+	assert.NoError(t, err)
+}
+
+// closeRecorder is a request body recording whether it was closed.
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestScopePreflight_ClosesTheBody(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the request shouldn't be sent")
+	})
+	token := "eyJhbGciOiJSUzI1NiJ9." +
+		base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"my-client@clients","scope":"read:users"}`)) +
+		".signature"
+	m := newTestManagement(t, h, WithStaticToken(token), WithScopePreflight())
+
+	body := &closeRecorder{Reader: strings.NewReader(`{"users":[]}`)}
+	req, err := http.NewRequest(http.MethodPost, m.URI("jobs", "users-imports"), body)
+	require.NoError(t, err)
+
+	_, err = m.Do(req)
+	var scopeErr *InsufficientScopeError
+	require.True(t, errors.As(err, &scopeErr))
+	assert.True(t, body.closed)
+}
+
+func TestScopePreflight_WithoutScopeClaim(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	m := newTestManagement(t, h, WithStaticToken("opaque-token"), WithScopePreflight())
+
+	err := m.Client.Delete(context.Background(), "123")
+	assert.NoError(t, err)
+}
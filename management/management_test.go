@@ -5,17 +5,24 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"runtime"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
 
+	"github.com/auth0/go-auth0"
 	"github.com/auth0/go-auth0/internal/client"
 )
 
@@ -54,6 +61,21 @@ func initializeTestClient() {
 	}
 }
 
+// newTestManagement returns a management client sending its requests to a
+// test server handling them with h, which is closed at the end of the test.
+// The client is configured with the options, after WithInsecure.
+func newTestManagement(t *testing.T, h http.Handler, options ...Option) *Management {
+	t.Helper()
+
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+
+	m, err := New(s.URL, append([]Option{WithInsecure()}, options...)...)
+	require.NoError(t, err)
+
+	return m
+}
+
 func TestNew(t *testing.T) {
 	for _, domain := range []string{
 		"example.com ",
@@ -158,6 +180,39 @@ func TestOptionDefaults(t *testing.T) {
 	assert.Equal(t, "false", includeTotals)
 }
 
+func TestOptionsAppliedTogether(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/?q=raw%20query", nil)
+
+	applyRequestOptions(
+		r,
+		applyListDefaults([]RequestOption{Page(2), Parameter("foo", "bar")}),
+		newRequestOption(func(r *http.Request) {
+			// Query options applied before this one must be visible.
+			assert.Equal(t, "2", r.URL.Query().Get("page"))
+			r.URL.RawQuery += "&added=true"
+		}),
+		PerPage(10),
+		Header("X-Foo", "bar"),
+	)
+
+	v := r.URL.Query()
+	assert.Equal(t, "raw query", v.Get("q"))
+	assert.Equal(t, "2", v.Get("page"))
+	assert.Equal(t, "10", v.Get("per_page"))
+	assert.Equal(t, "true", v.Get("include_totals"))
+	assert.Equal(t, "bar", v.Get("foo"))
+	assert.Equal(t, "true", v.Get("added"))
+	assert.Equal(t, "bar", r.Header.Get("X-Foo"))
+}
+
+func TestOptionsWithoutQuery(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/?b=2&a=1", nil)
+
+	applyRequestOptions(r, Header("X-Foo", "bar"))
+
+	assert.Equal(t, "b=2&a=1", r.URL.RawQuery)
+}
+
 func TestStringify(t *testing.T) {
 	expected := `{
   "foo": "bar"
@@ -250,6 +305,36 @@ func TestManagement_URI(t *testing.T) {
 			given:    []string{"users", "anotherUserId/secret%23"},
 			expected: "https://" + domain + "/api/v2/users/anotherUserId%2Fsecret%2523",
 		},
+		{
+			name:     "encodes an email",
+			given:    []string{"users", "email|john+doe@example.com"},
+			expected: "https://" + domain + "/api/v2/users/email%7Cjohn+doe@example.com",
+		},
+		{
+			name:     "encodes a connection name with spaces",
+			given:    []string{"connections", "My Connection", "status"},
+			expected: "https://" + domain + "/api/v2/connections/My%20Connection/status",
+		},
+		{
+			name:     "encodes query and fragment delimiters",
+			given:    []string{"users", "abc?fields=email#top"},
+			expected: "https://" + domain + "/api/v2/users/abc%3Ffields=email%23top",
+		},
+		{
+			name:     "encodes unicode characters",
+			given:    []string{"roles", "rôle"},
+			expected: "https://" + domain + "/api/v2/roles/r%C3%B4le",
+		},
+		{
+			name:     "encodes dot segments",
+			given:    []string{"users", "..", "."},
+			expected: "https://" + domain + "/api/v2/users/%2E%2E/%2E",
+		},
+		{
+			name:     "does not encode dots within a segment",
+			given:    []string{"users", "..abc"},
+			expected: "https://" + domain + "/api/v2/users/..abc",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -260,6 +345,24 @@ func TestManagement_URI(t *testing.T) {
 	}
 }
 
+func TestManagement_URI_DomainWithPath(t *testing.T) {
+	m, err := New("example.com/auth0/")
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://example.com/auth0/api/v2/users/123", m.URI("users", "123"))
+}
+
+func TestManagement_URI_Request(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/users/auth0%7Cabc%2F..%2Fdef/roles", r.URL.EscapedPath())
+		w.Write([]byte(`{}`))
+	})
+	m := newTestManagement(t, h)
+
+	err := m.Request(http.MethodGet, m.URI("users", "auth0|abc/../def", "roles"), nil)
+	assert.NoError(t, err)
+}
+
 func TestAuth0Client(t *testing.T) {
 	t.Run("Defaults to the default data", func(t *testing.T) {
 		h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -372,6 +475,12 @@ func TestAuth0Client(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Does not modify the default client info when passing extra env info", func(t *testing.T) {
+		_, err := New("example.auth0.com", WithAuth0ClientEnvEntry("foo", "bar"))
+		assert.NoError(t, err)
+		assert.NotContains(t, client.DefaultAuth0ClientInfo.Env, "foo")
+	})
+
 	t.Run("Handles when client info has been disabled", func(t *testing.T) {
 		h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			header := r.Header.Get("Auth0-Client")
@@ -390,3 +499,437 @@ func TestAuth0Client(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestNew_WithAppendedUserAgent(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, client.UserAgent+" my-product/2.1.0", r.Header.Get("User-Agent"))
+		w.Write([]byte(`{"user_id":"123"}`))
+	})
+	m := newTestManagement(t, h, WithAppendedUserAgent("my-product", "2.1.0"))
+
+	_, err := m.User.Read("123")
+	assert.NoError(t, err)
+}
+
+func TestNew_WithProxy(t *testing.T) {
+	var requestedPaths []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "auth0.example.com", r.URL.Host)
+
+		username, password, ok := parseProxyAuthorization(r.Header.Get("Proxy-Authorization"))
+		assert.True(t, ok)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "pass", password)
+
+		requestedPaths = append(requestedPaths, r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/oauth/token":
+			w.Write([]byte(`{"access_token":"someToken","token_type":"Bearer","expires_in":86400}`))
+		case "/api/v2/users/123":
+			assert.Equal(t, "Bearer someToken", r.Header.Get("Authorization"))
+			w.Write([]byte(`{"user_id":"123"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	proxy := httptest.NewServer(h)
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+	proxyURL.User = url.UserPassword("user", "pass")
+
+	m, err := New(
+		"auth0.example.com",
+		WithInsecure(),
+		WithProxy(proxyURL),
+		WithClientCredentials("clientID", "clientSecret"),
+	)
+	require.NoError(t, err)
+
+	u, err := m.User.Read("123")
+	assert.NoError(t, err)
+	assert.Equal(t, "123", u.GetID())
+	assert.Equal(t, []string{"/oauth/token", "/api/v2/users/123"}, requestedPaths)
+}
+
+func TestNew_WithProxyAndCustomTransport(t *testing.T) {
+	proxyURL, err := url.Parse("http://proxy.example.com:3128")
+	require.NoError(t, err)
+
+	customClient := &http.Client{
+		Transport: client.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("unexpected request")
+		}),
+	}
+
+	_, err = New("auth0.example.com", WithClient(customClient), WithProxy(proxyURL))
+	assert.EqualError(t, err, "cannot configure a transport of type client.RoundTripFunc")
+}
+
+func parseProxyAuthorization(header string) (username, password string, ok bool) {
+	r := &http.Request{Header: http.Header{"Authorization": []string{header}}}
+	return r.BasicAuth()
+}
+
+func TestNew_WithCircuitBreaker(t *testing.T) {
+	requests := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	m := newTestManagement(t, h, WithCircuitBreaker(2, time.Minute))
+
+	for i := 0; i < 2; i++ {
+		_, err := m.User.Read("123")
+		var managementErr Error
+		require.ErrorAs(t, err, &managementErr)
+		assert.Equal(t, http.StatusServiceUnavailable, managementErr.Status())
+	}
+
+	_, err := m.User.Read("123")
+	var circuitErr *CircuitOpenError
+	require.ErrorAs(t, err, &circuitErr)
+	assert.Equal(t, 2, circuitErr.Failures)
+	assert.Equal(t, 2, requests)
+}
+
+func TestNew_WithRetries(t *testing.T) {
+	requests := map[string]int{}
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method]++
+		switch {
+		case r.URL.Path == "/api/v2/users/limited":
+			w.Header().Set("X-RateLimit-Reset", fmt.Sprint(time.Now().Unix()))
+			w.WriteHeader(http.StatusTooManyRequests)
+		case requests[r.Method] < 3:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Write([]byte(`{"user_id":"123"}`))
+		}
+	})
+	var retries []RetryEvent
+	m := newTestManagement(t, h,
+		WithClock(immediateClock{}),
+		WithRetries(2, []int{http.StatusServiceUnavailable}),
+		WithOnRetry(func(e RetryEvent) { retries = append(retries, e) }),
+	)
+
+	user, err := m.User.Read("123")
+	require.NoError(t, err)
+	assert.Equal(t, "123", user.GetID())
+	assert.Equal(t, 3, requests[http.MethodGet])
+	require.Len(t, retries, 2)
+	assert.Equal(t, http.StatusServiceUnavailable, retries[0].Err.(Error).Status())
+	assert.Less(t, retries[0].Delay, 500*time.Millisecond)
+	assert.GreaterOrEqual(t, retries[1].Delay, 500*time.Millisecond)
+
+	// Non-idempotent requests are only retried when rate limited.
+	err = m.User.Update("123", &User{})
+	assert.Equal(t, http.StatusServiceUnavailable, err.(Error).Status())
+	assert.Equal(t, 1, requests[http.MethodPatch])
+
+	requests = map[string]int{}
+	_, err = m.User.Read("limited")
+	assert.Equal(t, http.StatusTooManyRequests, err.(Error).Status())
+	assert.Equal(t, 3, requests[http.MethodGet])
+
+	m = newTestManagement(t, h, WithRetries(0, nil))
+
+	requests = map[string]int{}
+	_, err = m.User.Read("limited")
+	assert.Equal(t, http.StatusTooManyRequests, err.(Error).Status())
+	assert.Equal(t, 1, requests[http.MethodGet])
+}
+
+func TestManagement_RateLimit(t *testing.T) {
+	reset := time.Now().Add(time.Minute).Truncate(time.Second)
+	remaining := 10
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remaining--
+		w.Header().Set("X-RateLimit-Limit", "10")
+		w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(remaining))
+		w.Header().Set("X-RateLimit-Reset", fmt.Sprint(reset.Unix()))
+		w.Write([]byte(`{"client_id":"123"}`))
+	})
+	var ended []RequestEvent
+	m := newTestManagement(t, h,
+		WithReadCache(time.Minute),
+		WithOnRequestEnd(func(e RequestEvent) { ended = append(ended, e) }),
+	)
+
+	_, ok := m.RateLimit()
+	assert.False(t, ok)
+
+	_, err := m.User.Read("123")
+	require.NoError(t, err)
+	_, err = m.Client.Read(context.Background(), "123")
+	require.NoError(t, err)
+
+	rateLimit, ok := m.RateLimit()
+	require.True(t, ok)
+	assert.Equal(t, RateLimit{Limit: 10, Remaining: 8, Reset: reset}, rateLimit)
+
+	require.Len(t, ended, 2)
+	assert.Equal(t, 9, ended[0].GetRateLimit().Remaining)
+	assert.Equal(t, 8, ended[1].GetRateLimit().Remaining)
+
+	// Responses served from the read cache don't report a stale rate limit.
+	_, err = m.User.Read("123")
+	require.NoError(t, err)
+	_, err = m.Client.Read(context.Background(), "123")
+	require.NoError(t, err)
+	rateLimit, _ = m.RateLimit()
+	assert.Equal(t, 7, rateLimit.Remaining)
+}
+
+func TestNew_WithFailoverDomains(t *testing.T) {
+	var requests []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		switch r.URL.Path {
+		case "/oauth/token":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":86400}`))
+		default:
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			w.Write([]byte(`{"user_id":"123"}`))
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	down := httptest.NewServer(h)
+	down.Close()
+
+	m, err := New(
+		down.URL,
+		WithInsecure(),
+		WithClientCredentials("client-id", "client-secret"),
+		WithFailoverDomains(s.URL),
+	)
+	require.NoError(t, err)
+
+	u, err := m.User.Read("123")
+	require.NoError(t, err)
+	assert.Equal(t, "123", u.GetID())
+	assert.Equal(t, []string{"/oauth/token", "/api/v2/users/123"}, requests)
+}
+
+func TestNew_WithHedging(t *testing.T) {
+	var requests int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+		w.Write([]byte(`{"user_id":"123"}`))
+	})
+	m := newTestManagement(t, h, WithHedging(20*time.Millisecond))
+
+	start := time.Now()
+	u, err := m.User.Read("123")
+	require.NoError(t, err)
+	assert.Equal(t, "123", u.GetID())
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestNew_WithReadCache(t *testing.T) {
+	requests := map[string]int{}
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+		w.Write([]byte(`{"client_id":"123","name":"App"}`))
+	})
+	m := newTestManagement(t, h, WithReadCache(time.Minute))
+
+	for i := 0; i < 2; i++ {
+		c, err := m.Client.Read(context.Background(), "123")
+		require.NoError(t, err)
+		assert.Equal(t, "App", c.GetName())
+
+		_, err = m.User.Read("123")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, requests["GET /api/v2/clients/123"])
+	assert.Equal(t, 2, requests["GET /api/v2/users/123"])
+
+	err := m.Client.Update(context.Background(), "123", &Client{Name: auth0.String("App")})
+	require.NoError(t, err)
+	_, err = m.Client.Read(context.Background(), "123")
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests["GET /api/v2/clients/123"])
+
+	m.InvalidateReadCache()
+	_, err = m.Client.Read(context.Background(), "123")
+	require.NoError(t, err)
+	assert.Equal(t, 3, requests["GET /api/v2/clients/123"])
+}
+
+type memoryTokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]*oauth2.Token
+}
+
+func (c *memoryTokenCache) Get(key string) (*oauth2.Token, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.tokens[key], nil
+}
+
+func (c *memoryTokenCache) Set(key string, token *oauth2.Token) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokens[key] = token
+	return nil
+}
+
+func (c *memoryTokenCache) Delete(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tokens, key)
+	return nil
+}
+
+func TestNew_WithTokenCache(t *testing.T) {
+	tokenRequests := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			tokenRequests++
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":86400}`, tokenRequests)
+			return
+		}
+		assert.Equal(t, "Bearer token-1", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"user_id":"123"}`))
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	cache := &memoryTokenCache{tokens: map[string]*oauth2.Token{}}
+	for i := 0; i < 2; i++ {
+		m, err := New(s.URL, WithInsecure(), WithClientCredentials("id", "secret"), WithTokenCache(cache))
+		require.NoError(t, err)
+
+		_, err = m.User.Read("123")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, tokenRequests)
+	assert.Len(t, cache.tokens, 1)
+}
+
+func TestNew_WithMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			highest := atomic.LoadInt32(&maxInFlight)
+			if current <= highest || atomic.CompareAndSwapInt32(&maxInFlight, highest, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(`{"user_id":"123"}`))
+	})
+	m := newTestManagement(t, h, WithMaxConcurrentRequests(3))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 12; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := m.User.Read("123")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(3), maxInFlight)
+}
+
+func TestNew_WithSharedRateLimit(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"user_id":"123"}`))
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	first, err := New(s.URL, WithInsecure(), WithSharedRateLimit(20, 1))
+	require.NoError(t, err)
+
+	second, err := New(s.URL, WithInsecure(), WithSharedRateLimit(1000, 100))
+	require.NoError(t, err)
+
+	other, err := New("other.auth0.com", WithSharedRateLimit(20, 1))
+	require.NoError(t, err)
+
+	assert.Same(t, first.rateLimiter, second.rateLimiter)
+	assert.NotSame(t, first.rateLimiter, other.rateLimiter)
+
+	start := time.Now()
+	for _, m := range []*Management{first, second, first, second} {
+		_, err := m.User.Read("123")
+		require.NoError(t, err)
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), 140*time.Millisecond)
+}
+
+func TestNew_WithTransportTuning(t *testing.T) {
+	m, err := New(
+		"example.auth0.com",
+		WithMaxIdleConnsPerHost(64),
+		WithIdleConnTimeout(2*time.Minute),
+		WithHTTP2(false),
+		WithTLSSessionCache(32),
+	)
+	require.NoError(t, err)
+
+	transport, ok := m.transport.(*http.Transport)
+	require.True(t, ok)
+	assert.Equal(t, 64, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 100, transport.MaxIdleConns)
+	assert.Equal(t, 2*time.Minute, transport.IdleConnTimeout)
+	assert.False(t, transport.ForceAttemptHTTP2)
+	assert.NotNil(t, transport.TLSNextProto)
+	assert.Empty(t, transport.TLSNextProto)
+	require.NotNil(t, transport.TLSClientConfig)
+	assert.NotNil(t, transport.TLSClientConfig.ClientSessionCache)
+
+	defaultTransport := http.DefaultTransport.(*http.Transport)
+	assert.NotEqual(t, 64, defaultTransport.MaxIdleConnsPerHost)
+	assert.NotEqual(t, 2*time.Minute, defaultTransport.IdleConnTimeout)
+	assert.True(t, defaultTransport.ForceAttemptHTTP2)
+}
+
+func TestNew_WithTLSSessionCache(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"user_id":"123"}`))
+	})
+	s := httptest.NewTLSServer(h)
+	defer s.Close()
+
+	m, err := New(
+		s.URL,
+		WithStaticToken("token"),
+		WithClient(s.Client()),
+		WithTLSSessionCache(8),
+	)
+	require.NoError(t, err)
+
+	transport := m.transport.(*http.Transport)
+	assert.NotNil(t, transport.TLSClientConfig.RootCAs, "the TLS configuration of the client is kept")
+
+	u, err := m.User.Read("123")
+	assert.NoError(t, err)
+	assert.Equal(t, "123", u.GetID())
+}
@@ -0,0 +1,130 @@
+package management
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/auth0/go-auth0/internal/client"
+)
+
+// maxUpdateAttempts is how many times UpdateWithRetry methods read, mutate and
+// update a resource before giving up on conflicts.
+const maxUpdateAttempts = 5
+
+// updateRetryDelay is how long UpdateWithRetry methods wait after the first
+// conflict before starting over, doubled after each conflict.
+const updateRetryDelay = 100 * time.Millisecond
+
+// updateWithRetry reads the resource, applies mutate to it and patches the
+// top-level fields mutate changed at uri. When the update fails because of a
+// concurrent change, with a 409 or 412 error, it starts over from reading the
+// resource, up to maxUpdateAttempts times. It returns the updated resource.
+// A *RetryDeadlineError is returned if waiting before starting over would
+// exceed the deadline of the context of the request.
+//
+// Fields cleared by mutate are left out of the update, as they can't be told
+// apart from fields the Management API doesn't return.
+func updateWithRetry[T any](
+	m *Management,
+	uri string,
+	read func() (*T, error),
+	mutate func(*T) error,
+	opts ...RequestOption,
+) (*T, error) {
+	ctx := requestContext(opts)
+	delay := updateRetryDelay
+
+	for attempt := 1; ; attempt++ {
+		resource, err := read()
+		if err != nil {
+			return nil, err
+		}
+
+		before, err := jsonFields(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := mutate(resource); err != nil {
+			return nil, err
+		}
+
+		after, err := jsonFields(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		patch := map[string]json.RawMessage{}
+		for field, value := range after {
+			if !bytes.Equal(before[field], value) {
+				patch[field] = value
+			}
+		}
+		if len(patch) == 0 {
+			return resource, nil
+		}
+
+		updated, err := patchResource[T](m, uri, patch, opts...)
+		if err == nil {
+			return updated, nil
+		}
+		if !isUpdateConflict(err) || attempt == maxUpdateAttempts {
+			return nil, err
+		}
+
+		if err := client.CheckRetryDeadline(ctx, m.clock, attempt, delay, err); err != nil {
+			return nil, err
+		}
+		if len(m.onRetry) > 0 {
+			if u, parseErr := url.Parse(uri); parseErr == nil {
+				m.retry(RetryEvent{Method: http.MethodPatch, URL: u, Attempt: attempt + 1, Err: err, Delay: delay})
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-m.clock.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// patchPayload is the payload of a partial update, which is encoded as the
+// patch and decoded into the updated resource.
+type patchPayload[T any] struct {
+	patch  interface{}
+	result *T
+}
+
+func (p *patchPayload[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.patch)
+}
+
+func (p *patchPayload[T]) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, p.result)
+}
+
+// jsonFields returns the JSON encoding of the top-level fields of v.
+func jsonFields(v interface{}) (map[string]json.RawMessage, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(b, &fields)
+	return fields, err
+}
+
+// isUpdateConflict returns true if the error was returned by the Management
+// API because the resource was changed concurrently.
+func isUpdateConflict(err error) bool {
+	var managementErr Error
+	return errors.As(err, &managementErr) &&
+		(managementErr.Status() == http.StatusConflict || managementErr.Status() == http.StatusPreconditionFailed)
+}
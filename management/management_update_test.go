@@ -0,0 +1,97 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/go-auth0"
+)
+
+func TestConnectionManager_UpdateWithRetry(t *testing.T) {
+	var patches []map[string]interface{}
+	reads := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			reads++
+			// The connection is changed concurrently between the first read
+			// and the first update.
+			w.Write([]byte(`{
+				"id": "con_123",
+				"name": "my-connection",
+				"strategy": "auth0",
+				"display_name": "Connection ` + string(rune('0'+reads)) + `",
+				"enabled_clients": ["client_1"]
+			}`))
+		case http.MethodPatch:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var patch map[string]interface{}
+			require.NoError(t, json.Unmarshal(body, &patch))
+			patches = append(patches, patch)
+
+			if len(patches) == 1 {
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"statusCode":409,"message":"The connection was changed."}`))
+				return
+			}
+			w.Write([]byte(`{"id":"con_123","name":"my-connection","enabled_clients":["client_1","client_2"]}`))
+		}
+	})
+	m := newTestManagement(t, h)
+
+	var displayNames []string
+	c, err := m.Connection.UpdateWithRetry("con_123", func(c *Connection) error {
+		displayNames = append(displayNames, c.GetDisplayName())
+		c.EnabledClients = &[]string{"client_1", "client_2"}
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Connection 1", "Connection 2"}, displayNames)
+	assert.Equal(t, []string{"client_1", "client_2"}, c.GetEnabledClients())
+
+	require.Len(t, patches, 2)
+	for _, patch := range patches {
+		assert.Equal(t, map[string]interface{}{
+			"enabled_clients": []interface{}{"client_1", "client_2"},
+		}, patch)
+	}
+}
+
+func TestClientManager_UpdateWithRetry(t *testing.T) {
+	patches := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			patches++
+			w.WriteHeader(http.StatusPreconditionFailed)
+			w.Write([]byte(`{"statusCode":412,"message":"The client was changed."}`))
+			return
+		}
+		w.Write([]byte(`{"client_id":"123","name":"App"}`))
+	})
+	m := newTestManagement(t, h)
+
+	t.Run("gives up after too many conflicts", func(t *testing.T) {
+		_, err := m.Client.UpdateWithRetry(context.Background(), "123", func(c *Client) error {
+			c.Description = auth0.String("My app")
+			return nil
+		})
+		assert.Equal(t, http.StatusPreconditionFailed, err.(Error).Status())
+		assert.Equal(t, maxUpdateAttempts, patches)
+	})
+
+	t.Run("skips the update without changes", func(t *testing.T) {
+		patches = 0
+		c, err := m.Client.UpdateWithRetry(context.Background(), "123", func(c *Client) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, "App", c.GetName())
+		assert.Zero(t, patches)
+	})
+}
@@ -0,0 +1,89 @@
+package managementtest
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is a management.Clock whose time only changes when advanced, to
+// control when access tokens expire and when the waits for retries and
+// polling end in tests.
+//
+//	clock := managementtest.NewClock(time.Now())
+//	m, err := management.New(domain, management.WithClock(clock), ...)
+//	...
+//	clock.Advance(24 * time.Hour)
+type Clock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []*clockWaiter
+}
+
+type clockWaiter struct {
+	until time.Time
+	ch    chan time.Time
+}
+
+// NewClock returns a Clock whose time is now.
+func NewClock(now time.Time) *Clock {
+	c := &Clock{now: now}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the current time of the clock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// After returns a channel on which the time of the clock is sent once the
+// clock was advanced by d.
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+
+	c.waiters = append(c.waiters, &clockWaiter{until: c.now.Add(d), ch: ch})
+	c.cond.Broadcast()
+
+	return ch
+}
+
+// Advance moves the time of the clock forward by d, ending the waits that
+// are due.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	waiters := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.until.After(c.now) {
+			waiters = append(waiters, w)
+			continue
+		}
+		w.ch <- c.now
+	}
+	c.waiters = waiters
+}
+
+// BlockUntil blocks until at least n goroutines are waiting on the clock, so
+// that advancing it ends their waits.
+func (c *Clock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for len(c.waiters) < n {
+		c.cond.Wait()
+	}
+}
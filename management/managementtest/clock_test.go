@@ -0,0 +1,107 @@
+package managementtest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/management"
+)
+
+func TestClock_TokenExpiry(t *testing.T) {
+	var tokens int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			n := atomic.AddInt32(&tokens, 1)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":86400}`, n)
+		default:
+			fmt.Fprintf(w, `{"user_id":%q}`, r.Header.Get("Authorization"))
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	clock := NewClock(time.Now())
+	m, err := management.New(
+		s.URL,
+		management.WithInsecure(),
+		management.WithClientCredentials("client-id", "client-secret"),
+		management.WithClock(clock),
+	)
+	require.NoError(t, err)
+
+	u, err := m.User.Read("123")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-1", u.GetID())
+
+	clock.Advance(23 * time.Hour)
+	u, err = m.User.Read("123")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-1", u.GetID())
+
+	clock.Advance(time.Hour)
+	u, err = m.User.Read("123")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-2", u.GetID())
+}
+
+func TestClock_RateLimitAndPolling(t *testing.T) {
+	clock := NewClock(time.Unix(1700000000, 0))
+
+	var requests []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch len(requests) {
+		case 1:
+			w.Header().Set("X-RateLimit-Reset", fmt.Sprint(clock.Now().Add(time.Minute).Unix()))
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.Write([]byte(`{"id":"job_1","status":"pending"}`))
+		default:
+			w.Write([]byte(`{"id":"job_1","status":"completed"}`))
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	m, err := management.New(s.URL, management.WithInsecure(), management.WithClock(clock))
+	require.NoError(t, err)
+
+	importer := m.Job.NewUserImporter(&management.Job{ConnectionID: auth0.String("con_1")})
+	require.NoError(t, importer.Add(map[string]interface{}{"email": "jane@example.com"}))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		jobs, err := importer.Wait(context.Background())
+		assert.NoError(t, err)
+		if assert.Len(t, jobs, 1) {
+			assert.Equal(t, "completed", jobs[0].GetStatus())
+		}
+	}()
+
+	// The import is rate limited for a minute.
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+
+	// The job is then checked again after the poll interval.
+	clock.BlockUntil(1)
+	clock.Advance(management.DefaultUserImportPollInterval)
+	<-done
+
+	assert.Equal(t, []string{
+		"POST /api/v2/jobs/users-imports",
+		"POST /api/v2/jobs/users-imports",
+		"GET /api/v2/jobs/job_1",
+	}, requests)
+}
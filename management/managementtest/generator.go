@@ -0,0 +1,176 @@
+package managementtest
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/management"
+)
+
+var (
+	firstNames = []string{"Ada", "Alan", "Grace", "Linus", "Margaret", "Dennis", "Barbara", "Ken", "Radia", "Edsger"}
+	lastNames  = []string{"Lovelace", "Turing", "Hopper", "Torvalds", "Hamilton", "Ritchie", "Liskov", "Thompson", "Perlman", "Dijkstra"}
+	words      = []string{"acme", "blue", "cloud", "delta", "echo", "fox", "green", "harbor", "iris", "jade", "kite", "lima"}
+	appTypes   = []string{"native", "spa", "regular_web", "non_interactive"}
+)
+
+// Generator produces random values of the resources of the management
+// package, such as clients and users, which satisfy the constraints of the
+// Management API on their fields so that they can be created as is.
+//
+// A Generator is deterministic: generators created with the same seed produce
+// the same values. It isn't safe for concurrent use.
+type Generator struct {
+	rand *rand.Rand
+	ids  int
+}
+
+// NewGenerator returns a Generator seeded with seed.
+func NewGenerator(seed int64) *Generator {
+	return &Generator{rand: rand.New(rand.NewSource(seed))}
+}
+
+// Client returns a client with a random application type, along with the
+// grant types, token endpoint authentication method and URLs valid for it.
+func (g *Generator) Client() *management.Client {
+	name := g.name()
+	appType := g.pick(appTypes)
+
+	c := &management.Client{
+		Name:        auth0.String(title(name)),
+		Description: auth0.String(fmt.Sprintf("The %s application.", name)),
+		AppType:     auth0.String(appType),
+	}
+
+	switch appType {
+	case "non_interactive":
+		c.GrantTypes = &[]string{"client_credentials"}
+		c.TokenEndpointAuthMethod = auth0.String("client_secret_post")
+		return c
+	case "native", "spa":
+		c.GrantTypes = &[]string{"authorization_code", "refresh_token"}
+		c.TokenEndpointAuthMethod = auth0.String("none")
+	default:
+		c.GrantTypes = &[]string{"authorization_code", "refresh_token", "client_credentials"}
+		c.TokenEndpointAuthMethod = auth0.String("client_secret_basic")
+	}
+
+	origin := fmt.Sprintf("https://%s.example.com", name)
+	c.Callbacks = &[]string{origin + "/callback"}
+	c.AllowedLogoutURLs = &[]string{origin}
+	if appType == "spa" {
+		c.WebOrigins = &[]string{origin}
+	}
+
+	return c
+}
+
+// User returns a user of the Username-Password-Authentication database
+// connection, with a password satisfying the strongest password policy.
+//
+// The user has no username, as the default database connection doesn't
+// require usernames and rejects users which have one.
+func (g *Generator) User() *management.User {
+	givenName := g.pick(firstNames)
+	familyName := g.pick(lastNames)
+	localPart := fmt.Sprintf("%s.%s%d", strings.ToLower(familyName), strings.ToLower(givenName), g.id())
+
+	return &management.User{
+		Connection:    auth0.String("Username-Password-Authentication"),
+		Email:         auth0.String(localPart + "@example.com"),
+		Password:      auth0.String(g.password()),
+		GivenName:     auth0.String(givenName),
+		FamilyName:    auth0.String(familyName),
+		Name:          auth0.String(givenName + " " + familyName),
+		Nickname:      auth0.String(strings.ToLower(givenName)),
+		EmailVerified: auth0.Bool(g.rand.Intn(2) == 0),
+		UserMetadata:  management.NullableValue(map[string]interface{}{"favorite_color": g.pick(words)}),
+	}
+}
+
+// Connection returns a database connection, with a random password policy.
+func (g *Generator) Connection() *management.Connection {
+	name := g.name()
+
+	return &management.Connection{
+		Name:        auth0.String(name),
+		DisplayName: auth0.String(title(name)),
+		Strategy:    auth0.String(management.ConnectionStrategyAuth0),
+		Options: &management.ConnectionOptions{
+			PasswordPolicy:       auth0.String(g.pick([]string{"none", "low", "fair", "good", "excellent"})),
+			BruteForceProtection: auth0.Bool(true),
+			DisableSignup:        auth0.Bool(g.rand.Intn(2) == 0),
+		},
+	}
+}
+
+// Organization returns an organization, with a random branding.
+func (g *Generator) Organization() *management.Organization {
+	name := g.name()
+
+	return &management.Organization{
+		Name:        auth0.String(name),
+		DisplayName: auth0.String(title(name)),
+		Branding: &management.OrganizationBranding{
+			LogoURL: auth0.String(fmt.Sprintf("https://%s.example.com/logo.png", name)),
+			Colors: &map[string]string{
+				"primary":         g.color(),
+				"page_background": g.color(),
+			},
+		},
+		Metadata: &map[string]string{"tier": g.pick([]string{"free", "pro", "enterprise"})},
+	}
+}
+
+// name returns a unique name made of lowercase letters, digits and dashes,
+// which is valid for any resource.
+func (g *Generator) name() string {
+	return fmt.Sprintf("%s-%s-%d", g.pick(words), g.pick(words), g.id())
+}
+
+func (g *Generator) id() int {
+	g.ids++
+	return g.ids
+}
+
+func (g *Generator) pick(values []string) string {
+	return values[g.rand.Intn(len(values))]
+}
+
+// password returns a password of 16 characters with lowercase and uppercase
+// letters, digits and special characters.
+func (g *Generator) password() string {
+	const (
+		lower   = "abcdefghijklmnopqrstuvwxyz"
+		upper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		digits  = "0123456789"
+		special = "!@#$%^&*"
+	)
+
+	sets := []string{lower, upper, digits, special}
+	password := make([]byte, 16)
+	for i := range password {
+		set := sets[i%len(sets)]
+		password[i] = set[g.rand.Intn(len(set))]
+	}
+	g.rand.Shuffle(len(password), func(i, j int) {
+		password[i], password[j] = password[j], password[i]
+	})
+
+	return string(password)
+}
+
+// title returns the words of a name in title case.
+func title(name string) string {
+	parts := strings.Split(name, "-")
+	for i, part := range parts {
+		parts[i] = strings.ToUpper(part[:1]) + part[1:]
+	}
+	return strings.Join(parts, " ")
+}
+
+func (g *Generator) color() string {
+	return fmt.Sprintf("#%06x", g.rand.Intn(0x1000000))
+}
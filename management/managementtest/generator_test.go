@@ -0,0 +1,47 @@
+package managementtest
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator(t *testing.T) {
+	assert.Equal(t, NewGenerator(42).User(), NewGenerator(42).User())
+
+	_, m := newManagement(t)
+	g := NewGenerator(1)
+
+	name := regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+	for i := 0; i < 20; i++ {
+		c := g.Client()
+		require.NoError(t, m.Client.Create(context.Background(), c))
+		assert.LessOrEqual(t, len(c.GetDescription()), 140)
+		if c.GetAppType() != "non_interactive" {
+			assert.NotEmpty(t, c.GetCallbacks())
+		}
+
+		u := g.User()
+		require.NoError(t, m.User.Create(u))
+		assert.Empty(t, u.GetUsername())
+		assert.Len(t, u.GetPassword(), 16)
+		assert.Regexp(t, `[a-z]`, u.GetPassword())
+		assert.Regexp(t, `[A-Z]`, u.GetPassword())
+		assert.Regexp(t, `[0-9]`, u.GetPassword())
+		assert.Regexp(t, `[!@#$%^&*]`, u.GetPassword())
+
+		conn := g.Connection()
+		require.NoError(t, m.Connection.Create(conn))
+		assert.Regexp(t, name, conn.GetName())
+
+		o := g.Organization()
+		require.NoError(t, m.Organization.Create(o))
+		assert.Regexp(t, name, o.GetName())
+		assert.LessOrEqual(t, len(o.GetName()), 50)
+		assert.Regexp(t, `^#[0-9a-f]{6}$`, (*o.Branding.Colors)["primary"])
+	}
+}
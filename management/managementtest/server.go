@@ -0,0 +1,351 @@
+// Package managementtest provides an in-memory fake of the Auth0 Management
+// API, to test code using the management package without an Auth0 tenant.
+//
+// The fake is stateful: resources created through it can then be read, listed,
+// updated and deleted, like they would with the Management API.
+//
+//	s := managementtest.NewServer()
+//	defer s.Close()
+//
+//	m, err := management.New(s.URL, management.WithInsecure())
+//
+// Clients, connections, resource servers, roles, users and organizations are
+// supported, with the following endpoints for each of them:
+//
+//   - POST to create a resource, which fails with a 409 error when a resource
+//     with the same name already exists, or the same identifier for resource
+//     servers and the same email for users.
+//   - GET to list the resources in the order they were created, with page
+//     based pagination, or checkpoint pagination for organizations. Resources
+//     can be filtered with some of the parameters of the Management API, such
+//     as name and strategy for connections or name_filter for roles.
+//   - GET, PATCH and DELETE on a resource by ID, which fail with a 404 error
+//     when the resource doesn't exist.
+//
+// Updates replace the fields of the resource with the fields of the payload,
+// without merging objects. Fields can be selected with the fields and
+// include_fields parameters.
+package managementtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type resourceType struct {
+	// Path of the resources in the Management API, after /api/v2/.
+	path string
+
+	// Key of the resources in the list responses.
+	listKey string
+
+	// Field holding the ID of the resources, and the prefix of new IDs.
+	idField, idPrefix string
+
+	// Field that must be unique across resources, if any.
+	uniqueField string
+
+	// Query parameters filtering the resources by the field of the same name.
+	filters []string
+
+	// Whether the resources are listed with checkpoint pagination when the
+	// take parameter is given.
+	checkpoint bool
+}
+
+var resourceTypes = []*resourceType{
+	{path: "clients", listKey: "clients", idField: "client_id", idPrefix: "client_", filters: []string{"app_type"}},
+	{path: "connections", listKey: "connections", idField: "id", idPrefix: "con_", uniqueField: "name", filters: []string{"name", "strategy"}},
+	{path: "resource-servers", listKey: "resource_servers", idField: "id", idPrefix: "rs_", uniqueField: "identifier", filters: []string{"identifier"}},
+	{path: "roles", listKey: "roles", idField: "id", idPrefix: "rol_", uniqueField: "name", filters: []string{"name_filter"}},
+	{path: "users", listKey: "users", idField: "user_id", idPrefix: "auth0|", uniqueField: "email", filters: []string{"connection"}},
+	{path: "organizations", listKey: "organizations", idField: "id", idPrefix: "org_", uniqueField: "name", filters: []string{"name"}, checkpoint: true},
+}
+
+// Server is a fake of the Management API, listening on a system-chosen port
+// on the local loopback interface.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	resources map[string][]map[string]interface{}
+	ids       int
+}
+
+// NewServer starts and returns a new Server, with no resources. The caller
+// should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{resources: map[string][]map[string]interface{}{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Reset deletes every resource of the server.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resources = map[string][]map[string]interface{}{}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v2/"), "/")
+
+	for _, t := range resourceTypes {
+		if path == t.path {
+			switch r.Method {
+			case http.MethodGet:
+				s.list(w, r, t)
+			case http.MethodPost:
+				s.create(w, r, t)
+			default:
+				writeError(w, http.StatusMethodNotAllowed, "Method not allowed.")
+			}
+			return
+		}
+
+		if id := strings.TrimPrefix(path, t.path+"/"); id != path && !strings.Contains(id, "/") {
+			switch r.Method {
+			case http.MethodGet:
+				s.read(w, r, t, id)
+			case http.MethodPatch:
+				s.update(w, r, t, id)
+			case http.MethodDelete:
+				s.delete(w, t, id)
+			default:
+				writeError(w, http.StatusMethodNotAllowed, "Method not allowed.")
+			}
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "Not found.")
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request, t *resourceType) {
+	var resource map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&resource); err != nil || resource == nil {
+		writeError(w, http.StatusBadRequest, "Payload validation error: invalid JSON.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if t.uniqueField != "" && resource[t.uniqueField] != nil {
+		if s.find(t, func(existing map[string]interface{}) bool {
+			return existing[t.uniqueField] == resource[t.uniqueField]
+		}) != -1 {
+			writeError(w, http.StatusConflict, fmt.Sprintf("A resource with the same %s already exists.", t.uniqueField))
+			return
+		}
+	}
+
+	s.ids++
+	id := strconv.Itoa(s.ids)
+	if userID, ok := resource["user_id"].(string); ok && t.path == "users" {
+		id = userID
+	}
+	resource[t.idField] = t.idPrefix + id
+	if t.path == "clients" {
+		resource["client_secret"] = fmt.Sprintf("secret_%d", s.ids)
+	}
+
+	s.resources[t.path] = append(s.resources[t.path], resource)
+
+	writeJSON(w, http.StatusCreated, resource)
+}
+
+func (s *Server) read(w http.ResponseWriter, r *http.Request, t *resourceType, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.findByID(t, id)
+	if i == -1 {
+		writeError(w, http.StatusNotFound, "The resource does not exist.")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, selectFields(s.resources[t.path][i], r))
+}
+
+func (s *Server) update(w http.ResponseWriter, r *http.Request, t *resourceType, id string) {
+	var patch map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		writeError(w, http.StatusBadRequest, "Payload validation error: invalid JSON.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.findByID(t, id)
+	if i == -1 {
+		writeError(w, http.StatusNotFound, "The resource does not exist.")
+		return
+	}
+
+	resource := s.resources[t.path][i]
+	for key, value := range patch {
+		if key == t.idField {
+			continue
+		}
+		resource[key] = value
+	}
+
+	writeJSON(w, http.StatusOK, resource)
+}
+
+func (s *Server) delete(w http.ResponseWriter, t *resourceType, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i := s.findByID(t, id); i != -1 {
+		resources := s.resources[t.path]
+		s.resources[t.path] = append(resources[:i:i], resources[i+1:]...)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, t *resourceType) {
+	q := r.URL.Query()
+
+	s.mu.Lock()
+	var resources []map[string]interface{}
+	for _, resource := range s.resources[t.path] {
+		if matchesFilters(resource, t, q) {
+			resources = append(resources, selectFields(resource, r))
+		}
+	}
+	s.mu.Unlock()
+
+	if resources == nil {
+		resources = []map[string]interface{}{}
+	}
+
+	if t.checkpoint && q.Has("take") {
+		take := intParameter(q.Get("take"), 50)
+		from := intParameter(q.Get("from"), 0)
+		page, next := paginate(resources, from, take)
+
+		body := map[string]interface{}{t.listKey: page}
+		if next < len(resources) {
+			body["next"] = strconv.Itoa(next)
+		}
+		writeJSON(w, http.StatusOK, body)
+		return
+	}
+
+	perPage := intParameter(q.Get("per_page"), 50)
+	start := intParameter(q.Get("page"), 0) * perPage
+	page, _ := paginate(resources, start, perPage)
+
+	if q.Get("include_totals") != "true" {
+		writeJSON(w, http.StatusOK, page)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		t.listKey: page,
+		"start":   start,
+		"limit":   perPage,
+		"length":  len(page),
+		"total":   len(resources),
+	})
+}
+
+func (s *Server) find(t *resourceType, match func(map[string]interface{}) bool) int {
+	for i, resource := range s.resources[t.path] {
+		if match(resource) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Server) findByID(t *resourceType, id string) int {
+	return s.find(t, func(resource map[string]interface{}) bool {
+		return resource[t.idField] == id
+	})
+}
+
+func matchesFilters(resource map[string]interface{}, t *resourceType, q map[string][]string) bool {
+	for _, filter := range t.filters {
+		values, ok := q[filter]
+		if !ok || len(values) == 0 {
+			continue
+		}
+
+		field := strings.TrimSuffix(filter, "_filter")
+		value, _ := resource[field].(string)
+		if strings.HasSuffix(filter, "_filter") {
+			if !strings.Contains(strings.ToLower(value), strings.ToLower(values[0])) {
+				return false
+			}
+		} else if value != values[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// selectFields returns the fields of resource selected by the fields and
+// include_fields parameters of the request.
+func selectFields(resource map[string]interface{}, r *http.Request) map[string]interface{} {
+	fields := r.URL.Query().Get("fields")
+	include := r.URL.Query().Get("include_fields") != "false"
+	selected := map[string]bool{}
+	for _, field := range strings.Split(fields, ",") {
+		selected[field] = true
+	}
+
+	// The resource is copied even when all its fields are selected, so that
+	// it can be encoded while it's being updated.
+	result := map[string]interface{}{}
+	for key, value := range resource {
+		if fields == "" || selected[key] == include {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+func paginate(resources []map[string]interface{}, start, size int) ([]map[string]interface{}, int) {
+	if start > len(resources) {
+		start = len(resources)
+	}
+	end := start + size
+	if end > len(resources) {
+		end = len(resources)
+	}
+	return resources[start:end], end
+}
+
+func intParameter(value string, defaultValue int) int {
+	i, err := strconv.Atoi(value)
+	if err != nil || i < 0 {
+		return defaultValue
+	}
+	return i
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error in the format of the Management API errors.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"statusCode": status,
+		"error":      http.StatusText(status),
+		"message":    message,
+	})
+}
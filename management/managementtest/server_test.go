@@ -0,0 +1,108 @@
+package managementtest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/management"
+)
+
+func newManagement(t *testing.T) (*Server, *management.Management) {
+	t.Helper()
+
+	s := NewServer()
+	t.Cleanup(s.Close)
+
+	m, err := management.New(s.URL, management.WithInsecure())
+	require.NoError(t, err)
+
+	return s, m
+}
+
+func TestServer_CRUD(t *testing.T) {
+	_, m := newManagement(t)
+
+	c := &management.Client{Name: auth0.String("App"), AppType: auth0.String("spa")}
+	require.NoError(t, m.Client.Create(context.Background(), c))
+	assert.NotEmpty(t, c.GetClientID())
+	assert.NotEmpty(t, c.GetClientSecret())
+
+	read, err := m.Client.Read(context.Background(), c.GetClientID())
+	require.NoError(t, err)
+	assert.Equal(t, "App", read.GetName())
+
+	require.NoError(t, m.Client.Update(context.Background(), c.GetClientID(), &management.Client{Description: auth0.String("My app")}))
+	read, err = m.Client.Read(context.Background(), c.GetClientID(), management.IncludeFields("name", "description"))
+	require.NoError(t, err)
+	assert.Equal(t, "App", read.GetName())
+	assert.Equal(t, "My app", read.GetDescription())
+	assert.Empty(t, read.GetAppType())
+
+	require.NoError(t, m.Client.Delete(context.Background(), c.GetClientID()))
+	_, err = m.Client.Read(context.Background(), c.GetClientID())
+	var managementErr management.Error
+	require.ErrorAs(t, err, &managementErr)
+	assert.Equal(t, http.StatusNotFound, managementErr.Status())
+}
+
+func TestServer_Conflicts(t *testing.T) {
+	_, m := newManagement(t)
+
+	require.NoError(t, m.Connection.Create(&management.Connection{Name: auth0.String("db"), Strategy: auth0.String("auth0")}))
+
+	err := m.Connection.Create(&management.Connection{Name: auth0.String("db"), Strategy: auth0.String("auth0")})
+	var managementErr management.Error
+	require.ErrorAs(t, err, &managementErr)
+	assert.Equal(t, http.StatusConflict, managementErr.Status())
+
+	c, err := m.Connection.ReadByName("db")
+	require.NoError(t, err)
+	assert.Equal(t, "auth0", c.GetStrategy())
+}
+
+func TestServer_Pagination(t *testing.T) {
+	s, m := newManagement(t)
+
+	for i := 0; i < 120; i++ {
+		require.NoError(t, m.Role.Create(&management.Role{Name: auth0.String(fmt.Sprintf("role-%d", i))}))
+		require.NoError(t, m.Organization.Create(&management.Organization{Name: auth0.String(fmt.Sprintf("org-%d", i))}))
+	}
+
+	roles, err := m.Role.List(management.Page(1))
+	require.NoError(t, err)
+	assert.Len(t, roles.Roles, 50)
+	assert.Equal(t, "role-50", roles.Roles[0].GetName())
+	assert.Equal(t, 120, roles.Total)
+	assert.True(t, roles.HasNext())
+
+	roles, err = m.Role.List(management.Parameter("name_filter", "role-11"))
+	require.NoError(t, err)
+	assert.Equal(t, 11, roles.Total)
+
+	var names []string
+	var from string
+	for {
+		orgs, err := m.Organization.List(management.From(from), management.Take(100))
+		require.NoError(t, err)
+		for _, o := range orgs.Organizations {
+			names = append(names, o.GetName())
+		}
+		if !orgs.HasNext() {
+			break
+		}
+		from = orgs.Next
+	}
+	assert.Len(t, names, 120)
+	assert.Equal(t, "org-119", names[119])
+
+	s.Reset()
+	roles, err = m.Role.List()
+	require.NoError(t, err)
+	assert.Empty(t, roles.Roles)
+}
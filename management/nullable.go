@@ -0,0 +1,75 @@
+package management
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Nullable is the value of a field which the Management API clears when it's
+// set to null, such as the picture of a user.
+//
+// Nullable fields are pointers, which gives them three distinct states:
+//
+//   - nil, the field is absent and left unchanged by updates.
+//   - Null, the field is sent as null and cleared by updates.
+//   - NullableValue, the field is sent with the value.
+//
+// For example, to clear the picture of a user:
+//
+//	err := m.User.Update(id, &management.User{Picture: management.Null[string]()})
+//
+// Fields which are null are decoded as absent, as the Management API doesn't
+// return cleared fields.
+type Nullable[T any] struct {
+	value T
+	valid bool
+}
+
+// NullableValue returns a Nullable holding the value.
+func NullableValue[T any](value T) *Nullable[T] {
+	return &Nullable[T]{value: value, valid: true}
+}
+
+// Null returns a Nullable sent as null.
+func Null[T any]() *Nullable[T] {
+	return &Nullable[T]{}
+}
+
+// Get returns the value, and whether the Nullable holds one rather than being
+// nil or null.
+func (n *Nullable[T]) Get() (T, bool) {
+	if n == nil || !n.valid {
+		var zero T
+		return zero, false
+	}
+	return n.value, true
+}
+
+// IsNull returns true if the Nullable is sent as null.
+func (n *Nullable[T]) IsNull() bool {
+	return n != nil && !n.valid
+}
+
+// MarshalJSON encodes the value, or null.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON decodes the value, or null.
+func (n *Nullable[T]) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		*n = Nullable[T]{}
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(b, &value); err != nil {
+		return err
+	}
+	*n = Nullable[T]{value: value, valid: true}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package management
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullable(t *testing.T) {
+	for name, test := range map[string]struct {
+		user     *User
+		expected string
+	}{
+		"absent": {
+			user:     &User{},
+			expected: `{}`,
+		},
+		"null": {
+			user:     &User{Picture: Null[string](), UserMetadata: Null[map[string]interface{}]()},
+			expected: `{"picture":null,"user_metadata":null}`,
+		},
+		"value": {
+			user: &User{
+				Picture:      NullableValue("https://example.com/picture.png"),
+				UserMetadata: NullableValue(map[string]interface{}{"color": "blue", "removed": nil}),
+			},
+			expected: `{"picture":"https://example.com/picture.png","user_metadata":{"color":"blue","removed":null}}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b, err := json.Marshal(test.user)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(b))
+		})
+	}
+
+	t.Run("decoding", func(t *testing.T) {
+		var u User
+		require.NoError(t, json.Unmarshal([]byte(`{"picture":"https://example.com/picture.png"}`), &u))
+		assert.Equal(t, "https://example.com/picture.png", u.GetPicture())
+		assert.False(t, u.Picture.IsNull())
+		assert.Nil(t, u.UserMetadata)
+		assert.Equal(t, map[string]interface{}{}, u.GetUserMetadata())
+
+		var n Nullable[int]
+		require.NoError(t, json.Unmarshal([]byte(`null`), &n))
+		assert.True(t, n.IsNull())
+		_, ok := n.Get()
+		assert.False(t, ok)
+	})
+}
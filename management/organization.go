@@ -1,5 +1,10 @@
 package management
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // Organization is used to allow B2B customers to better manage
 // their partners and customers, and to customize the ways that
 // end-users access their applications.
@@ -21,6 +26,33 @@ type Organization struct {
 	// Metadata associated with the organization, in the form of an object with
 	// string values (max 255 chars). Maximum of 10 metadata properties allowed.
 	Metadata *map[string]string `json:"metadata,omitempty"`
+
+	// Extras holds the fields returned by the Management API that this SDK
+	// doesn't support yet, so that they are preserved when the organization is sent
+	// back to Auth0. Fields unknown to the API should not be added here.
+	Extras map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (o *Organization) MarshalJSON() ([]byte, error) {
+	type organization Organization
+	return marshalWithExtras((*organization)(o), o.Extras)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (o *Organization) UnmarshalJSON(b []byte) error {
+	type organization Organization
+	if err := json.Unmarshal(b, (*organization)(o)); err != nil {
+		return err
+	}
+
+	extras, err := unmarshalExtras(b, (*organization)(o))
+	if err != nil {
+		return err
+	}
+	o.Extras = extras
+
+	return nil
 }
 
 // OrganizationBranding holds branding information for an Organization.
@@ -172,6 +204,28 @@ type OrganizationList struct {
 	Organizations []*Organization `json:"organizations"`
 }
 
+// OrganizationState is the desired state of an organization, used with
+// OrganizationManager.Ensure.
+type OrganizationState struct {
+	// The organization, which is looked up by its name.
+	Organization *Organization
+
+	// The connections enabled for the organization, identified by their
+	// ConnectionID.
+	Connections []*OrganizationConnection
+
+	// When true, the connections enabled for the organization that aren't
+	// listed in Connections are disabled.
+	RemoveExtraConnections bool
+}
+
+// OrganizationClientGrantList is a list of the ClientGrants associated with an
+// Organization.
+type OrganizationClientGrantList struct {
+	List
+	ClientGrants []*ClientGrant `json:"client_grants"`
+}
+
 // OrganizationManager is used for managing an Organization.
 type OrganizationManager struct {
 	*Management
@@ -185,7 +239,7 @@ func newOrganizationManager(m *Management) *OrganizationManager {
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_organizations
 func (m *OrganizationManager) List(opts ...RequestOption) (o *OrganizationList, err error) {
-	err = m.Request("GET", m.URI("organizations"), &o, applyListDefaults(opts))
+	o, err = listPage[*OrganizationList](m.Management, m.URI("organizations"), applyListDefaults(opts))
 	return
 }
 
@@ -221,6 +275,30 @@ func (m *OrganizationManager) Update(id string, o *Organization, opts ...Request
 	return
 }
 
+// Patch updates the fields of the organization set on the patch, which unlike Update
+// can set fields to their zero value or clear them with null, and returns the
+// updated organization.
+func (m *OrganizationManager) Patch(id string, p *OrganizationPatch, opts ...RequestOption) (*Organization, error) {
+	return patchResource[Organization](m.Management, m.URI("organizations", id), p, opts...)
+}
+
+// UpdateWithRetry reads the organization, applies mutate to it and updates the
+// fields mutate changed. When the update conflicts with a concurrent change,
+// with a 409 or 412 error, the organization is read again and mutate applied
+// again, up to 5 times, so that concurrent changes aren't overwritten. It
+// returns the updated organization.
+//
+// Fields cleared by mutate are left out of the update.
+//
+// The options are applied to every request made.
+func (m *OrganizationManager) UpdateWithRetry(id string, mutate func(o *Organization) error, opts ...RequestOption) (*Organization, error) {
+	return updateWithRetry(m.Management, m.URI("organizations", id),
+		func() (*Organization, error) { return m.Read(id, opts...) },
+		mutate,
+		opts...,
+	)
+}
+
 // ReadByName retrieves a specific organization by name.
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_name_by_name
@@ -229,11 +307,93 @@ func (m *OrganizationManager) ReadByName(name string, opts ...RequestOption) (o
 	return
 }
 
+// Ensure converges the organization to the desired state. The organization is
+// created if none exists with the same name, or patched if it has drifted.
+// Missing connections are then enabled, the ones whose membership assignment
+// has drifted are patched, and the connections that aren't desired are
+// disabled if RemoveExtraConnections is set.
+//
+// Connections are reported in the result by their ID.
+//
+// The options are applied to every request made.
+func (m *OrganizationManager) Ensure(s *OrganizationState, opts ...RequestOption) (*EnsureResult, error) {
+	o := s.Organization
+	if o.GetName() == "" {
+		return nil, &APIError{StatusCode: 400, Err: "Bad Request", Message: "Name cannot be empty"}
+	}
+
+	existing, result, err := ensure(o, o,
+		func() (*Organization, error) { return m.ReadByName(o.GetName(), opts...) },
+		func() error { return m.Create(o, opts...) },
+		func(existing *Organization) error { return m.Update(existing.GetID(), o, opts...) },
+	)
+	if err != nil {
+		return nil, err
+	}
+	result.ID = existing.GetID()
+
+	enabled, err := listAll(m.Management, listPages(
+		func(opts ...RequestOption) (*OrganizationConnectionList, error) {
+			return m.Connections(result.ID, opts...)
+		},
+		(*OrganizationConnectionList).items,
+	), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	enabledByID := make(map[string]*OrganizationConnection, len(enabled))
+	for _, c := range enabled {
+		enabledByID[c.GetConnectionID()] = c
+	}
+
+	for _, c := range s.Connections {
+		current, ok := enabledByID[c.GetConnectionID()]
+		switch {
+		case !ok:
+			err = m.AddConnection(result.ID, &OrganizationConnection{
+				ConnectionID:            c.ConnectionID,
+				AssignMembershipOnLogin: c.AssignMembershipOnLogin,
+			}, opts...)
+			result.Added = append(result.Added, c.GetConnectionID())
+		case c.AssignMembershipOnLogin != nil && c.GetAssignMembershipOnLogin() != current.GetAssignMembershipOnLogin():
+			err = m.UpdateConnection(result.ID, c.GetConnectionID(), &OrganizationConnection{
+				AssignMembershipOnLogin: c.AssignMembershipOnLogin,
+			}, opts...)
+			result.Changed = append(result.Changed, c.GetConnectionID())
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !s.RemoveExtraConnections {
+		return result, nil
+	}
+
+	desired := make(map[string]bool, len(s.Connections))
+	for _, c := range s.Connections {
+		desired[c.GetConnectionID()] = true
+	}
+
+	for _, c := range enabled {
+		if desired[c.GetConnectionID()] {
+			continue
+		}
+		if err := m.DeleteConnection(result.ID, c.GetConnectionID(), opts...); err != nil {
+			return nil, err
+		}
+		result.Removed = append(result.Removed, c.GetConnectionID())
+	}
+
+	return result, nil
+}
+
 // Connections retrieves connections enabled for an organization.
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_enabled_connections
 func (m *OrganizationManager) Connections(id string, opts ...RequestOption) (c *OrganizationConnectionList, err error) {
-	err = m.Request("GET", m.URI("organizations", id, "enabled_connections"), &c, applyListDefaults(opts))
+	c, err = listPage[*OrganizationConnectionList](m.Management, m.URI("organizations", id, "enabled_connections"), applyListDefaults(opts))
 	return
 }
 
@@ -275,10 +435,26 @@ func (m *OrganizationManager) UpdateConnection(id string, connectionID string, c
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_invitations
 func (m *OrganizationManager) Invitations(id string, opts ...RequestOption) (i *OrganizationInvitationList, err error) {
-	err = m.Request("GET", m.URI("organizations", id, "invitations"), &i, applyListDefaults(opts))
+	i, err = listPage[*OrganizationInvitationList](m.Management, m.URI("organizations", id, "invitations"), applyListDefaults(opts))
 	return
 }
 
+// AllInvitations retrieves all invitations to an organization by going
+// through every page of results.
+//
+// The invitations don't support checkpoint pagination nor include the total
+// number of invitations, so pages are retrieved until one isn't full.
+//
+// See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_invitations
+func (m *OrganizationManager) AllInvitations(id string, opts ...RequestOption) ([]*OrganizationInvitation, error) {
+	return listAll(m.Management, listPages(
+		func(opts ...RequestOption) (*OrganizationInvitationList, error) {
+			return m.Invitations(id, opts...)
+		},
+		(*OrganizationInvitationList).items,
+	), opts...)
+}
+
 // CreateInvitation creates invitations to an organization.
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/post_invitations
@@ -307,10 +483,25 @@ func (m *OrganizationManager) DeleteInvitation(id string, invitationID string, o
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_members
 func (m *OrganizationManager) Members(id string, opts ...RequestOption) (o *OrganizationMemberList, err error) {
-	err = m.Request("GET", m.URI("organizations", id, "members"), &o, applyListDefaults(opts))
+	o, err = listPage[*OrganizationMemberList](m.Management, m.URI("organizations", id, "members"), applyListDefaults(opts))
 	return
 }
 
+// AllMembers retrieves all members of an organization by following the next
+// cursor of checkpoint pagination, which isn't limited to the first 1000
+// results like page based pagination.
+//
+// Pages of 50 members are retrieved, unless the options use Take.
+//
+// See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_members
+func (m *OrganizationManager) AllMembers(id string, opts ...RequestOption) ([]OrganizationMember, error) {
+	return NewPaginator(func(opts ...RequestOption) (*OrganizationMemberList, error) {
+		return m.Members(id, opts...)
+	}, (*OrganizationMemberList).items,
+		append([]RequestOption{Take(50)}, opts...)...,
+	).all()
+}
+
 // AddMembers adds members to an organization.
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/post_members
@@ -324,6 +515,108 @@ func (m *OrganizationManager) AddMembers(id string, memberIDs []string, opts ...
 	return
 }
 
+// organizationMembersBatchSize is the number of members added to an
+// organization by each request of ImportMembers.
+const organizationMembersBatchSize = 10
+
+// OrganizationMemberImport configures OrganizationManager.ImportMembers.
+type OrganizationMemberImport struct {
+	// Roles, if not empty, are the ids of the roles assigned to every member
+	// added to the organization.
+	Roles []string
+
+	// OnProgress, if not nil, is called after each batch of members with the
+	// number of members processed so far, whether they failed or not.
+	OnProgress func(done int)
+}
+
+// OrganizationMemberImportError reports members which failed to be added to
+// an organization, or to be assigned their roles.
+type OrganizationMemberImportError struct {
+	// MemberIDs are the ids of the users which failed to be imported.
+	MemberIDs []string
+
+	// Err is the reason why the members failed to be imported.
+	Err error
+}
+
+// Error formats the error into a string representation.
+func (e *OrganizationMemberImportError) Error() string {
+	return fmt.Sprintf("failed to import %d members: %s", len(e.MemberIDs), e.Err)
+}
+
+// Unwrap returns the reason why the members failed to be imported.
+func (e *OrganizationMemberImportError) Unwrap() error {
+	return e.Err
+}
+
+// OrganizationMemberImportResult is the outcome of
+// OrganizationManager.ImportMembers.
+type OrganizationMemberImportResult struct {
+	// Added are the ids of the users added to the organization and assigned
+	// their roles.
+	Added []string
+
+	// Errors are the batches of members which failed to be added, and the
+	// members which failed to be assigned their roles.
+	Errors []*OrganizationMemberImportError
+}
+
+// Succeeded returns true if every member was added and assigned their roles.
+func (r *OrganizationMemberImportResult) Succeeded() bool {
+	return len(r.Errors) == 0
+}
+
+// ImportMembers adds any number of users to an organization, in batches of at
+// most 10 members per request, and assigns them the roles of the import if
+// any. The batches which fail are reported in the result, rather than
+// stopping the import.
+//
+// The options are applied to every request made.
+//
+// See: https://auth0.com/docs/api/management/v2/#!/Organizations/post_members
+func (m *OrganizationManager) ImportMembers(id string, memberIDs []string, i *OrganizationMemberImport, opts ...RequestOption) *OrganizationMemberImportResult {
+	if i == nil {
+		i = &OrganizationMemberImport{}
+	}
+
+	result := &OrganizationMemberImportResult{}
+	for done := 0; done < len(memberIDs); {
+		n := organizationMembersBatchSize
+		if n > len(memberIDs)-done {
+			n = len(memberIDs) - done
+		}
+		batch := memberIDs[done : done+n]
+
+		if err := m.AddMembers(id, batch, opts...); err != nil {
+			result.Errors = append(result.Errors, &OrganizationMemberImportError{MemberIDs: batch, Err: err})
+		} else {
+			for _, memberID := range batch {
+				if len(i.Roles) == 0 {
+					result.Added = append(result.Added, memberID)
+					continue
+				}
+
+				if err := m.AssignMemberRoles(id, memberID, i.Roles, opts...); err != nil {
+					result.Errors = append(result.Errors, &OrganizationMemberImportError{
+						MemberIDs: []string{memberID},
+						Err:       fmt.Errorf("failed to assign roles: %w", err),
+					})
+					continue
+				}
+				result.Added = append(result.Added, memberID)
+			}
+		}
+
+		done += n
+		if i.OnProgress != nil {
+			i.OnProgress(done)
+		}
+	}
+
+	return result
+}
+
 // DeleteMember deletes members from an organization.
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/delete_members
@@ -341,7 +634,7 @@ func (m *OrganizationManager) DeleteMember(id string, memberIDs []string, opts .
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_organization_member_roles
 func (m *OrganizationManager) MemberRoles(id string, memberID string, opts ...RequestOption) (r *OrganizationMemberRoleList, err error) {
-	err = m.Request("GET", m.URI("organizations", id, "members", memberID, "roles"), &r, applyListDefaults(opts))
+	r, err = listPage[*OrganizationMemberRoleList](m.Management, m.URI("organizations", id, "members", memberID, "roles"), applyListDefaults(opts))
 	return
 }
 
@@ -370,3 +663,32 @@ func (m *OrganizationManager) DeleteMemberRoles(id string, memberID string, role
 	err = m.Request("DELETE", m.URI("organizations", id, "members", memberID, "roles"), &body, opts...)
 	return
 }
+
+// ClientGrants retrieves the client grants associated with an organization.
+//
+// See: https://auth0.com/docs/api/management/v2/organizations/get-organization-client-grants
+func (m *OrganizationManager) ClientGrants(id string, opts ...RequestOption) (g *OrganizationClientGrantList, err error) {
+	g, err = listPage[*OrganizationClientGrantList](m.Management, m.URI("organizations", id, "client-grants"), applyListDefaults(opts))
+	return
+}
+
+// AssociateClientGrant associates a client grant with an organization.
+//
+// See: https://auth0.com/docs/api/management/v2/organizations/create-organization-client-grant
+func (m *OrganizationManager) AssociateClientGrant(id string, grantID string, opts ...RequestOption) (err error) {
+	body := struct {
+		GrantID string `json:"grant_id"`
+	}{
+		GrantID: grantID,
+	}
+	err = m.Request("POST", m.URI("organizations", id, "client-grants"), &body, opts...)
+	return
+}
+
+// RemoveClientGrant removes a client grant from an organization.
+//
+// See: https://auth0.com/docs/api/management/v2/organizations/delete-client-grants-by-grant-id
+func (m *OrganizationManager) RemoveClientGrant(id string, grantID string, opts ...RequestOption) (err error) {
+	err = m.Request("DELETE", m.URI("organizations", id, "client-grants", grantID), nil, opts...)
+	return
+}
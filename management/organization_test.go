@@ -1,7 +1,9 @@
 package management
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
 	"os"
@@ -263,6 +265,72 @@ func TestOrganizationManager_AddMembers(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestOrganizationManager_ImportMembers(t *testing.T) {
+	var batches [][]string
+	var assigned []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/organizations/org_123/members":
+			var body struct {
+				Members []string `json:"members"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			batches = append(batches, body.Members)
+
+			if len(batches) == 2 {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"statusCode":400,"error":"Bad Request","message":"User not found"}`))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/organizations/org_123/members/user_3/roles":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"error":"Not Found","message":"Role not found"}`))
+		case r.Method == http.MethodPost:
+			var body struct {
+				Roles []string `json:"roles"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []string{"rol_1"}, body.Roles)
+			assigned = append(assigned, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	memberIDs := make([]string, 25)
+	for i := range memberIDs {
+		memberIDs[i] = fmt.Sprintf("user_%d", i)
+	}
+
+	var progress []int
+	result := m.Organization.ImportMembers("org_123", memberIDs, &OrganizationMemberImport{
+		Roles: []string{"rol_1"},
+		OnProgress: func(done int) {
+			progress = append(progress, done)
+		},
+	})
+
+	require.Len(t, batches, 3)
+	assert.Len(t, batches[0], 10)
+	assert.Len(t, batches[2], 5)
+	assert.Equal(t, []int{10, 20, 25}, progress)
+	assert.Len(t, assigned, 14)
+
+	assert.False(t, result.Succeeded())
+	assert.Len(t, result.Added, 14)
+	require.Len(t, result.Errors, 2)
+	assert.Equal(t, []string{"user_3"}, result.Errors[0].MemberIDs)
+	assert.EqualError(t, result.Errors[0], "failed to import 1 members: failed to assign roles: 404 Not Found: Role not found")
+	assert.Equal(t, memberIDs[10:20], result.Errors[1].MemberIDs)
+
+	var managementErr Error
+	require.ErrorAs(t, result.Errors[1], &managementErr)
+	assert.Equal(t, http.StatusBadRequest, managementErr.Status())
+}
+
 func TestOrganizationManager_DeleteMembers(t *testing.T) {
 	configureHTTPTestRecordings(t)
 
@@ -426,3 +494,146 @@ func cleanupOrganization(t *testing.T, orgID string) {
 		}
 	}
 }
+
+func TestOrganizationManager_Ensure(t *testing.T) {
+	var requests []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/organizations/name/acme":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"error":"Not Found","message":"No organization found by that name"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/organizations":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"org_1","name":"acme"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/organizations/org_1/enabled_connections":
+			w.Write([]byte(`{"start":0,"limit":50,"total":2,"enabled_connections":[
+				{"connection_id":"con_1","assign_membership_on_login":false},
+				{"connection_id":"con_2","assign_membership_on_login":false}
+			]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v2/organizations/org_1/enabled_connections/con_1":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"assign_membership_on_login":true}`, string(body))
+			w.Write([]byte(`{"connection_id":"con_1","assign_membership_on_login":true}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/organizations/org_1/enabled_connections":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"connection_id":"con_3"}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"connection_id":"con_3","assign_membership_on_login":false}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v2/organizations/org_1/enabled_connections/con_2":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	result, err := m.Organization.Ensure(&OrganizationState{
+		Organization: &Organization{Name: auth0.String("acme")},
+		Connections: []*OrganizationConnection{
+			{ConnectionID: auth0.String("con_1"), AssignMembershipOnLogin: auth0.Bool(true)},
+			{ConnectionID: auth0.String("con_3")},
+		},
+		RemoveExtraConnections: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &EnsureResult{
+		ID:      "org_1",
+		Created: true,
+		Added:   []string{"con_3"},
+		Changed: []string{"con_1"},
+		Removed: []string{"con_2"},
+	}, result)
+
+	assert.Equal(t, []string{
+		"GET /api/v2/organizations/name/acme",
+		"POST /api/v2/organizations",
+		"GET /api/v2/organizations/org_1/enabled_connections",
+		"PATCH /api/v2/organizations/org_1/enabled_connections/con_1",
+		"POST /api/v2/organizations/org_1/enabled_connections",
+		"DELETE /api/v2/organizations/org_1/enabled_connections/con_2",
+	}, requests)
+}
+
+func TestOrganizationManager_ClientGrants(t *testing.T) {
+	var requests []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := r.Method + " " + r.URL.Path
+		if r.Method == http.MethodPost {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			request += " " + string(body)
+		}
+		requests = append(requests, request)
+
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"cgr_1","client_id":"abc","audience":"https://api","scope":["read:things"],"organization_usage":"require","allow_any_organization":false}`))
+		case http.MethodGet:
+			w.Write([]byte(`{"start":0,"limit":50,"total":1,"client_grants":[{"id":"cgr_1","client_id":"abc","audience":"https://api","scope":["read:things"],"organization_usage":"require","allow_any_organization":false}]}`))
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	err := m.Organization.AssociateClientGrant("org_1", "cgr_1")
+	require.NoError(t, err)
+
+	grants, err := m.Organization.ClientGrants("org_1")
+	require.NoError(t, err)
+	require.Len(t, grants.ClientGrants, 1)
+	assert.Equal(t, ClientGrantOrganizationUsageRequire, grants.ClientGrants[0].GetOrganizationUsage())
+	assert.False(t, grants.ClientGrants[0].GetAllowAnyOrganization())
+
+	err = m.Organization.RemoveClientGrant("org_1", "cgr_1")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`POST /api/v2/organizations/org_1/client-grants {"grant_id":"cgr_1"}` + "\n",
+		"GET /api/v2/organizations/org_1/client-grants",
+		"DELETE /api/v2/organizations/org_1/client-grants/cgr_1",
+	}, requests)
+}
+
+func TestOrganizationManager_AllMembersAndInvitations(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		switch r.URL.Path {
+		case "/api/v2/organizations/org_1/members":
+			assert.Equal(t, "2", q.Get("take"))
+			switch q.Get("from") {
+			case "":
+				w.Write([]byte(`{"members":[{"user_id":"auth0|1"},{"user_id":"auth0|2"}],"next":"cursor_1"}`))
+			case "cursor_1":
+				w.Write([]byte(`{"members":[{"user_id":"auth0|3"}],"next":"cursor_2"}`))
+			case "cursor_2":
+				w.Write([]byte(`{"members":[]}`))
+			}
+		case "/api/v2/organizations/org_1/invitations":
+			switch q.Get("page") {
+			case "0":
+				w.Write([]byte(`{"start":0,"limit":50,"invitations":[{"id":"uinv_1"},{"id":"uinv_2"}]}`))
+			default:
+				w.Write([]byte(`{"start":0,"limit":50,"invitations":[]}`))
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	members, err := m.Organization.AllMembers("org_1", Take(2))
+	require.NoError(t, err)
+	require.Len(t, members, 3)
+	assert.Equal(t, "auth0|1", members[0].GetUserID())
+	assert.Equal(t, "auth0|3", members[2].GetUserID())
+
+	invitations, err := m.Organization.AllInvitations("org_1")
+	require.NoError(t, err)
+	require.Len(t, invitations, 2)
+	assert.Equal(t, "uinv_2", invitations[1].GetID())
+}
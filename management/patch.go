@@ -0,0 +1,109 @@
+package management
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Patch holds the minimal set of changes needed to turn a resource into
+// another version of itself, using the JSON merge patch format (RFC 7386)
+// accepted by the PATCH endpoints of the Management API.
+type Patch map[string]interface{}
+
+// NewPatch compares a resource as fetched from Auth0 with the desired state of
+// that resource and returns a Patch holding only the fields that changed.
+//
+// Fields which are present in current but missing from desired are set to an
+// explicit null so that they get removed. Nested objects are compared
+// recursively, while arrays are replaced as a whole whenever they differ. This
+// means desired is expected to be a modified copy of current, rather than a
+// sparsely populated struct.
+//
+// Some objects, such as the options of a connection, are replaced as a whole by
+// the API instead of being merged. These are sent in full whenever anything
+// inside them changes, so that applying the patch doesn't wipe the rest.
+//
+// As read-only fields are left untouched they are never part of the patch,
+// which makes it safe to send to the API:
+//
+//	c, err := api.Client.Read(ctx, id)
+//	desired := *c
+//	desired.Description = auth0.String("New description")
+//
+//	patch, err := management.NewPatch(c, &desired)
+//	if err == nil && !patch.IsEmpty() {
+//		err = api.Request("PATCH", api.URI("clients", id), patch)
+//	}
+func NewPatch(current, desired interface{}) (Patch, error) {
+	currentFields, err := toJSONObject(current)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert the current resource: %w", err)
+	}
+
+	desiredFields, err := toJSONObject(desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert the desired resource: %w", err)
+	}
+
+	return diffJSONObjects(currentFields, desiredFields, replacedFields), nil
+}
+
+// replacedFields lists the top level objects which the PATCH endpoints replace
+// as a whole rather than merge, like the options of connections and resource
+// servers.
+var replacedFields = map[string]bool{
+	"options": true,
+}
+
+// IsEmpty returns true if the patch holds no changes.
+func (p Patch) IsEmpty() bool {
+	return len(p) == 0
+}
+
+func toJSONObject(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal(b, &object); err != nil {
+		return nil, err
+	}
+
+	return object, nil
+}
+
+func diffJSONObjects(current, desired map[string]interface{}, replaced map[string]bool) Patch {
+	patch := Patch{}
+
+	for key := range current {
+		if _, ok := desired[key]; !ok {
+			patch[key] = nil
+		}
+	}
+
+	for key, desiredValue := range desired {
+		currentValue, ok := current[key]
+		if !ok {
+			patch[key] = desiredValue
+			continue
+		}
+
+		currentObject, currentIsObject := currentValue.(map[string]interface{})
+		desiredObject, desiredIsObject := desiredValue.(map[string]interface{})
+		if currentIsObject && desiredIsObject && !replaced[key] {
+			if nested := diffJSONObjects(currentObject, desiredObject, nil); !nested.IsEmpty() {
+				patch[key] = map[string]interface{}(nested)
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(currentValue, desiredValue) {
+			patch[key] = desiredValue
+		}
+	}
+
+	return patch
+}
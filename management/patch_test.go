@@ -0,0 +1,142 @@
+package management
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/go-auth0"
+)
+
+func TestNewPatch(t *testing.T) {
+	current := &Client{
+		ClientID:    auth0.String("abc"),
+		Name:        auth0.String("Test Client"),
+		Description: auth0.String("Old description"),
+		Callbacks:   &[]string{"https://example.com/callback"},
+		ClientMetadata: &map[string]interface{}{
+			"foo": "bar",
+			"baz": "qux",
+		},
+		JWTConfiguration: &ClientJWTConfiguration{
+			LifetimeInSeconds: auth0.Int(3600),
+			Algorithm:         auth0.String("RS256"),
+		},
+	}
+
+	desired := &Client{
+		ClientID:    auth0.String("abc"),
+		Name:        auth0.String("Test Client"),
+		Description: auth0.String("New description"),
+		Callbacks:   &[]string{"https://example.com/callback", "https://example.com/other"},
+		ClientMetadata: &map[string]interface{}{
+			"foo": "bar",
+		},
+		JWTConfiguration: &ClientJWTConfiguration{
+			LifetimeInSeconds: auth0.Int(7200),
+			Algorithm:         auth0.String("RS256"),
+		},
+	}
+
+	patch, err := NewPatch(current, desired)
+	require.NoError(t, err)
+
+	expected := `{
+		"description": "New description",
+		"callbacks": ["https://example.com/callback", "https://example.com/other"],
+		"client_metadata": {"baz": null},
+		"jwt_configuration": {"lifetime_in_seconds": 7200}
+	}`
+
+	actual, err := json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func TestNewPatch_RemovedFields(t *testing.T) {
+	current := &Connection{
+		Name:        auth0.String("my-connection"),
+		DisplayName: auth0.String("My Connection"),
+	}
+	desired := &Connection{
+		Name: auth0.String("my-connection"),
+	}
+
+	patch, err := NewPatch(current, desired)
+	require.NoError(t, err)
+	assert.Equal(t, Patch{"display_name": nil}, patch)
+}
+
+func TestNewPatch_ConnectionOptions(t *testing.T) {
+	current := &Connection{
+		Name: auth0.String("my-connection"),
+		Options: &ConnectionOptions{
+			PasswordPolicy:       auth0.String("fair"),
+			BruteForceProtection: auth0.Bool(true),
+		},
+	}
+	desired := &Connection{
+		Name: auth0.String("my-connection"),
+		Options: &ConnectionOptions{
+			PasswordPolicy:       auth0.String("good"),
+			BruteForceProtection: auth0.Bool(true),
+		},
+	}
+
+	patch, err := NewPatch(current, desired)
+	require.NoError(t, err)
+
+	actual, err := json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"options":{"passwordPolicy":"good","brute_force_protection":true}}`, string(actual))
+
+	patch, err = NewPatch(current, current)
+	require.NoError(t, err)
+	assert.True(t, patch.IsEmpty())
+}
+
+func TestNewPatch_NoChanges(t *testing.T) {
+	c := &Client{
+		Name:        auth0.String("Test Client"),
+		Description: auth0.String("Description"),
+	}
+
+	patch, err := NewPatch(c, c)
+	require.NoError(t, err)
+	assert.True(t, patch.IsEmpty())
+}
+
+func TestNewPatch_InvalidResource(t *testing.T) {
+	_, err := NewPatch([]string{"not", "an", "object"}, &Client{})
+	assert.Error(t, err)
+
+	_, err = NewPatch(&Client{}, make(chan int))
+	assert.Error(t, err)
+}
+
+func TestPatch_Request(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/api/v2/clients/abc", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"description": "New description"}, body)
+
+		w.Write([]byte(`{"client_id":"abc","description":"New description"}`))
+	})
+	m := newTestManagement(t, h)
+
+	current := &Client{ClientID: auth0.String("abc"), Description: auth0.String("Old description")}
+	desired := &Client{ClientID: auth0.String("abc"), Description: auth0.String("New description")}
+
+	patch, err := NewPatch(current, desired)
+	require.NoError(t, err)
+
+	err = m.Request("PATCH", m.URI("clients", "abc"), patch)
+	assert.NoError(t, err)
+}
@@ -1,5 +1,10 @@
 package management
 
+import (
+	"reflect"
+	"sort"
+)
+
 // Prompt is used within the Login Page.
 //
 // See: https://auth0.com/docs/customize/universal-login-pages/customize-login-text-prompts
@@ -53,3 +58,104 @@ func (m *PromptManager) SetCustomText(p string, l string, b map[string]interface
 	err = m.Request("PUT", m.URI("prompts", p, "custom-text", l), &b, opts...)
 	return
 }
+
+// CustomTextPrompts holds the prompts whose custom text can be set.
+var CustomTextPrompts = []string{
+	"login", "login-id", "login-password", "login-passwordless", "login-email-verification",
+	"signup", "signup-id", "signup-password", "phone-identifier-enrollment", "phone-identifier-challenge",
+	"reset-password", "consent", "logout", "mfa-push", "mfa-otp", "mfa-voice", "mfa-phone", "mfa-webauthn",
+	"mfa-sms", "mfa-email", "mfa-recovery-code", "mfa", "status", "device-flow", "email-verification",
+	"email-otp-challenge", "organizations", "invitation", "common",
+}
+
+// PromptCustomTexts holds the custom text of prompts by prompt and language,
+// such as texts["login"]["en"], each holding the custom text of the screens of
+// the prompt.
+type PromptCustomTexts map[string]map[string]map[string]interface{}
+
+// Changes returns the custom texts of t that are different from the ones of
+// previous, such as the texts exported by AllCustomText, so that only those
+// are set by SetAllCustomText.
+//
+// The custom text of a prompt and language is replaced as a whole when set,
+// which is why the custom text of every screen is kept when any screen
+// changed.
+func (t PromptCustomTexts) Changes(previous PromptCustomTexts) PromptCustomTexts {
+	changes := PromptCustomTexts{}
+	for prompt, languages := range t {
+		for language, text := range languages {
+			if reflect.DeepEqual(text, previous[prompt][language]) {
+				continue
+			}
+			if changes[prompt] == nil {
+				changes[prompt] = map[string]map[string]interface{}{}
+			}
+			changes[prompt][language] = text
+		}
+	}
+	return changes
+}
+
+// AllCustomText retrieves the custom text of every prompt of
+// CustomTextPrompts, for each of the languages. The languages default to the
+// enabled locales of the tenant when none are given.
+//
+// Prompts and languages without custom text are left out.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Prompts/get_custom_text_by_language
+func (m *PromptManager) AllCustomText(languages []string, opts ...RequestOption) (PromptCustomTexts, error) {
+	if len(languages) == 0 {
+		tenant, err := m.Tenant.Read(IncludeFields("enabled_locales"))
+		if err != nil {
+			return nil, err
+		}
+		languages = tenant.GetEnabledLocales()
+	}
+
+	texts := PromptCustomTexts{}
+	for _, prompt := range CustomTextPrompts {
+		for _, language := range languages {
+			text, err := m.CustomText(prompt, language, opts...)
+			if err != nil {
+				return nil, err
+			}
+			if len(text) == 0 {
+				continue
+			}
+			if texts[prompt] == nil {
+				texts[prompt] = map[string]map[string]interface{}{}
+			}
+			texts[prompt][language] = text
+		}
+	}
+
+	return texts, nil
+}
+
+// SetAllCustomText sets the custom text of every prompt and language of
+// texts, replacing their existing custom text. Use PromptCustomTexts.Changes
+// to only set the custom texts that were edited.
+//
+// Prompts and languages are set in order, and the first error is returned.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Prompts/put_custom_text_by_language
+func (m *PromptManager) SetAllCustomText(texts PromptCustomTexts, opts ...RequestOption) error {
+	for _, prompt := range sortedKeys(texts) {
+		for _, language := range sortedKeys(texts[prompt]) {
+			if err := m.SetCustomText(prompt, language, texts[prompt][language], opts...); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -1,6 +1,9 @@
 package management
 
 import (
+	"encoding/json"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -77,3 +80,54 @@ func TestPromptCustomText(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Welcome", texts["login"].(map[string]interface{})["title"])
 }
+
+func TestPromptManager_AllCustomText(t *testing.T) {
+	var puts []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v2/tenants/settings":
+			w.Write([]byte(`{"enabled_locales":["en","fr"]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/prompts/login/custom-text/en":
+			w.Write([]byte(`{"login":{"title":"Welcome"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/prompts/login/custom-text/fr":
+			w.Write([]byte(`{"login":{"title":"Bienvenue"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/prompts/signup/custom-text/fr":
+			w.Write([]byte(`{"signup":{"title":"Inscription"}}`))
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v2/prompts/"):
+			w.Write([]byte(`{}`))
+		case r.Method == http.MethodPut:
+			var text map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&text))
+			puts = append(puts, r.URL.Path)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	exported, err := m.Prompt.AllCustomText(nil)
+	require.NoError(t, err)
+	assert.Equal(t, PromptCustomTexts{
+		"login": {
+			"en": {"login": map[string]interface{}{"title": "Welcome"}},
+			"fr": {"login": map[string]interface{}{"title": "Bienvenue"}},
+		},
+		"signup": {
+			"fr": {"signup": map[string]interface{}{"title": "Inscription"}},
+		},
+	}, exported)
+
+	var edited PromptCustomTexts
+	b, err := json.Marshal(exported)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b, &edited))
+	edited["login"]["fr"]["login"] = map[string]interface{}{"title": "Bonjour"}
+	edited["consent"] = map[string]map[string]interface{}{"en": {"consent": map[string]interface{}{"title": "Allow"}}}
+
+	err = m.Prompt.SetAllCustomText(edited.Changes(exported))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/api/v2/prompts/consent/custom-text/en",
+		"/api/v2/prompts/login/custom-text/fr",
+	}, puts)
+}
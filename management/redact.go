@@ -0,0 +1,167 @@
+package management
+
+import (
+	"encoding/json"
+)
+
+// redactedValue is used in place of secrets when redacting a resource.
+const redactedValue = "[REDACTED]"
+
+// sensitiveFields holds the JSON keys of fields containing secrets wherever
+// they are, such as client secrets and API keys.
+var sensitiveFields = map[string]bool{
+	"access_token_secret": true,
+	"api_key":             true,
+	"app_secret":          true,
+	"auth_token":          true,
+	"client_secret":       true,
+	"datadogApiKey":       true,
+	"httpAuthorization":   true,
+	"password":            true,
+	"private_key":         true,
+	"secretAccessKey":     true,
+	"segmentWriteKey":     true,
+	"server_key":          true,
+	"signing_secret":      true,
+	"skey":                true,
+	"smtp_pass":           true,
+	"splunkToken":         true,
+	"totp_secret":         true,
+	"twilio_token":        true,
+}
+
+// The JSON keys of fields containing secrets only within some objects, as the
+// same keys hold public data elsewhere, such as the public certificates of
+// signing keys or the public key encrypting the tokens of resource servers.
+var (
+	// credentialFields are the sensitive fields of the credentials of clients.
+	credentialFields = map[string]bool{"pem": true}
+
+	// signingKeyFields are the sensitive fields of the signing key of SAML
+	// connections.
+	signingKeyFields = map[string]bool{"cert": true, "key": true}
+
+	// providerSecretFields are the sensitive fields of the settings of third
+	// party providers, such as CAPTCHA providers or SMS gateways.
+	providerSecretFields = map[string]bool{"secret": true}
+)
+
+// scopedSensitiveFields holds the sensitive fields of the objects held by
+// each JSON key.
+var scopedSensitiveFields = map[string]map[string]bool{
+	"credentials":            credentialFields,
+	"signing_key":            signingKeyFields,
+	"gateway_authentication": providerSecretFields,
+	"recaptcha_v2":           providerSecretFields,
+	"hcaptcha":               providerSecretFields,
+	"friendly_captcha":       providerSecretFields,
+	"arkose":                 providerSecretFields,
+}
+
+// rootSensitiveFields returns the sensitive fields of v itself, when it's one
+// of the objects whose fields are only sensitive within them.
+func rootSensitiveFields(v interface{}) map[string]bool {
+	switch v.(type) {
+	case *Credential, Credential, *[]Credential, []Credential:
+		return credentialFields
+	case *ConnectionOptionsSAMLSigningKey:
+		return signingKeyFields
+	case *ConnectionGatewayAuthentication, *CaptchaProvider, *CaptchaArkose:
+		return providerSecretFields
+	default:
+		return nil
+	}
+}
+
+// sensitiveStringFields holds the JSON keys of fields containing secrets when
+// their value is a string, such as the tokens of user identities, but not when
+// it's an object, such as the refresh token settings of clients.
+var sensitiveStringFields = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+}
+
+// MarshalRedacted returns the JSON encoding of v like json.Marshal, having
+// replaced the values of the fields containing secrets with a placeholder,
+// such as client secrets, the private keys of SAML connections and the
+// values of action secrets. Public data, such as the public certificates of
+// signing keys, is left visible.
+//
+// It's meant for logging resources or showing the differences between them
+// without disclosing secrets. The JSON encoding of the resources sent to the
+// Management API is left untouched.
+func MarshalRedacted(v interface{}) ([]byte, error) {
+	value, err := redactedValueOf(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// stringifyRedacted returns a string representation of the value passed as an
+// argument, having replaced all the sensitive fields with a placeholder.
+func stringifyRedacted(v interface{}) string {
+	value, err := redactedValueOf(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return Stringify(value)
+}
+
+// redactedValueOf returns the generic JSON representation of v, having
+// replaced all the sensitive fields with a placeholder.
+func redactedValueOf(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+
+	return redact(value, rootSensitiveFields(v)), nil
+}
+
+// redact replaces the sensitive fields of value with a placeholder, along with
+// the fields in scoped, the sensitive fields of the object holding value.
+func redact(value interface{}, scoped map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range v {
+			if (sensitiveFields[key] || scoped[key]) && fieldValue != nil {
+				v[key] = redactedValue
+				continue
+			}
+			if _, ok := fieldValue.(string); ok && sensitiveStringFields[key] {
+				v[key] = redactedValue
+				continue
+			}
+			if key == "secrets" {
+				redactActionSecrets(fieldValue)
+			}
+			v[key] = redact(fieldValue, scopedSensitiveFields[key])
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redact(item, scoped)
+		}
+	}
+	return value
+}
+
+// redactActionSecrets replaces the values of action secrets with a
+// placeholder, keeping their names.
+func redactActionSecrets(value interface{}) {
+	secrets, ok := value.([]interface{})
+	if !ok {
+		return
+	}
+	for _, secret := range secrets {
+		if s, ok := secret.(map[string]interface{}); ok && s["value"] != nil {
+			s["value"] = redactedValue
+		}
+	}
+}
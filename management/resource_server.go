@@ -1,5 +1,9 @@
 package management
 
+import (
+	"encoding/json"
+)
+
 // ResourceServer is an entity that represents an external resource, capable of
 // accepting and responding to protected resource requests made by applications.
 type ResourceServer struct {
@@ -46,8 +50,81 @@ type ResourceServer struct {
 	// Enables the enforcement of the authorization policies.
 	EnforcePolicies *bool `json:"enforce_policies,omitempty"`
 
-	// The dialect for the access token ["access_token" or "access_token_authz"].
+	// The dialect for the access token ["access_token", "access_token_authz",
+	// "rfc9068_profile" or "rfc9068_profile_authz"].
 	TokenDialect *string `json:"token_dialect,omitempty"`
+
+	// The types of authorization details that can be requested with Rich
+	// Authorization Requests (RFC 9396) for this resource server.
+	AuthorizationDetails *[]ResourceServerAuthorizationDetails `json:"authorization_details,omitempty"`
+
+	// Settings for the encryption of the access tokens issued for this
+	// resource server.
+	TokenEncryption *ResourceServerTokenEncryption `json:"token_encryption,omitempty"`
+
+	// The consent policy of the resource server, such as
+	// "transactional-authorization-with-mfa".
+	ConsentPolicy *string `json:"consent_policy,omitempty"`
+
+	// Extras holds the fields returned by the Management API that this SDK
+	// doesn't support yet, so that they are preserved when the resource server is sent
+	// back to Auth0. Fields unknown to the API should not be added here.
+	Extras map[string]json.RawMessage `json:"-"`
+}
+
+// ResourceServerAuthorizationDetails is a type of authorization details that
+// can be requested for a resource server.
+type ResourceServerAuthorizationDetails struct {
+	// The type of authorization details, such as "payment_initiation".
+	Type *string `json:"type,omitempty"`
+}
+
+// ResourceServerTokenEncryption holds the settings for the encryption of the
+// access tokens issued for a resource server.
+type ResourceServerTokenEncryption struct {
+	// The format of the encrypted tokens ["compact-nested-jwe"].
+	Format *string `json:"format,omitempty"`
+
+	// The key used to encrypt the tokens.
+	EncryptionKey *ResourceServerTokenEncryptionKey `json:"encryption_key,omitempty"`
+}
+
+// ResourceServerTokenEncryptionKey is the key used to encrypt the access tokens
+// issued for a resource server.
+type ResourceServerTokenEncryptionKey struct {
+	// The name of the key.
+	Name *string `json:"name,omitempty"`
+
+	// The algorithm of the key ["RSA-OAEP-256", "RSA-OAEP-384" or "RSA-OAEP-512"].
+	Algorithm *string `json:"alg,omitempty"`
+
+	// The key ID of the key.
+	KeyID *string `json:"kid,omitempty"`
+
+	// The PEM-formatted public key or X509 certificate of the key.
+	PEM *string `json:"pem,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r *ResourceServer) MarshalJSON() ([]byte, error) {
+	type resourceServer ResourceServer
+	return marshalWithExtras((*resourceServer)(r), r.Extras)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *ResourceServer) UnmarshalJSON(b []byte) error {
+	type resourceServer ResourceServer
+	if err := json.Unmarshal(b, (*resourceServer)(r)); err != nil {
+		return err
+	}
+
+	extras, err := unmarshalExtras(b, (*resourceServer)(r), "is_system")
+	if err != nil {
+		return err
+	}
+	r.Extras = extras
+
+	return nil
 }
 
 // ResourceServerScope defines the specific actions, resource servers can be allowed to do.
@@ -90,6 +167,33 @@ func (m *ResourceServerManager) Read(id string, opts ...RequestOption) (rs *Reso
 	return
 }
 
+// ReadByIdentifier retrieves a resource server by its identifier, i.e. the
+// audience of its access tokens, which unlike its id is usually the same
+// across tenants.
+//
+// The identifier is escaped in the path of the request, including the slashes
+// of identifiers which are URLs.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Resource_Servers/get_resource_servers_by_id
+func (m *ResourceServerManager) ReadByIdentifier(identifier string, opts ...RequestOption) (*ResourceServer, error) {
+	if identifier == "" {
+		return nil, &APIError{StatusCode: 400, Err: "Bad Request", Message: "Identifier cannot be empty"}
+	}
+
+	var rs *ResourceServer
+	if err := m.Request("GET", m.URI("resource-servers", identifier), &rs, opts...); err != nil {
+		return nil, err
+	}
+
+	// Resource servers are read by id as well, which must not be mistaken for
+	// the identifier of another resource server.
+	if rs.GetIdentifier() != identifier {
+		return nil, &APIError{StatusCode: 404, Err: "Not Found", Message: "Resource server not found"}
+	}
+
+	return rs, nil
+}
+
 // Update a resource server.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Resource_Servers/patch_resource_servers_by_id
@@ -97,6 +201,90 @@ func (m *ResourceServerManager) Update(id string, rs *ResourceServer, opts ...Re
 	return m.Request("PATCH", m.URI("resource-servers", id), rs, opts...)
 }
 
+// Patch updates the fields of the resource server set on the patch, which unlike Update
+// can set fields to their zero value or clear them with null, and returns the
+// updated resource server.
+func (m *ResourceServerManager) Patch(id string, p *ResourceServerPatch, opts ...RequestOption) (*ResourceServer, error) {
+	return patchResource[ResourceServer](m.Management, m.URI("resource-servers", id), p, opts...)
+}
+
+// UpdateWithRetry reads the resource server, applies mutate to it and updates
+// the fields mutate changed. When the update conflicts with a concurrent
+// change, with a 409 or 412 error, the resource server is read again and mutate
+// applied again, up to 5 times, so that concurrent changes aren't overwritten.
+// It returns the updated resource server.
+//
+// Fields cleared by mutate are left out of the update.
+//
+// The options are applied to every request made.
+func (m *ResourceServerManager) UpdateWithRetry(id string, mutate func(rs *ResourceServer) error, opts ...RequestOption) (*ResourceServer, error) {
+	return updateWithRetry(m.Management, m.URI("resource-servers", id),
+		func() (*ResourceServer, error) { return m.Read(id, opts...) },
+		mutate,
+		opts...,
+	)
+}
+
+// Upsert creates the resource server if none exists with the same identifier,
+// or updates the existing one otherwise. The resource server is looked up by
+// its identifier, which is why it must be set.
+//
+// As the identifier of a resource server can't be updated, it is left out of
+// the update.
+//
+// The options are applied to every request made.
+func (m *ResourceServerManager) Upsert(rs *ResourceServer, opts ...RequestOption) error {
+	if rs.GetIdentifier() == "" {
+		return &APIError{StatusCode: 400, Err: "Bad Request", Message: "Identifier cannot be empty"}
+	}
+
+	existing, err := m.ReadByIdentifier(rs.GetIdentifier(), opts...)
+	if IsStatusNotFound(err) {
+		return m.Create(rs, opts...)
+	}
+	if err != nil {
+		return err
+	}
+
+	update := *rs
+	update.Identifier = nil
+	if err := m.Update(existing.GetID(), &update, opts...); err != nil {
+		return err
+	}
+
+	*rs = update
+	return nil
+}
+
+// Ensure converges the resource server to the desired state. The resource
+// server is created if none exists with the same identifier, or patched if it
+// has drifted.
+//
+// As the identifier of a resource server can't be updated, it is left out of
+// the update.
+//
+// The options are applied to every request made.
+func (m *ResourceServerManager) Ensure(rs *ResourceServer, opts ...RequestOption) (*EnsureResult, error) {
+	if rs.GetIdentifier() == "" {
+		return nil, &APIError{StatusCode: 400, Err: "Bad Request", Message: "Identifier cannot be empty"}
+	}
+
+	update := *rs
+	update.Identifier = nil
+
+	existing, result, err := ensure(rs, &update,
+		func() (*ResourceServer, error) { return m.ReadByIdentifier(rs.GetIdentifier(), opts...) },
+		func() error { return m.Create(rs, opts...) },
+		func(existing *ResourceServer) error { return m.Update(existing.GetID(), &update, opts...) },
+	)
+	if err != nil {
+		return nil, err
+	}
+	result.ID = existing.GetID()
+
+	return result, nil
+}
+
 // Delete a resource server.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Resource_Servers/delete_resource_servers_by_id
@@ -108,7 +296,7 @@ func (m *ResourceServerManager) Delete(id string, opts ...RequestOption) (err er
 //
 // See: https://auth0.com/docs/api/management/v2#!/Resource_Servers/get_resource_servers
 func (m *ResourceServerManager) List(opts ...RequestOption) (rl *ResourceServerList, err error) {
-	err = m.Request("GET", m.URI("resource-servers"), &rl, applyListDefaults(opts))
+	rl, err = listPage[*ResourceServerList](m.Management, m.URI("resource-servers"), applyListDefaults(opts))
 	return
 }
 
@@ -1,6 +1,7 @@
 package management
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 	"time"
@@ -140,3 +141,103 @@ func cleanupResourceServer(t *testing.T, resourceServerID string) {
 	err := api.ResourceServer.Delete(resourceServerID)
 	require.NoError(t, err)
 }
+
+func TestResourceServer_Upsert(t *testing.T) {
+	var requests []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.EscapedPath() == "/api/v2/resource-servers/https:%2F%2Fexisting.example.com":
+			w.Write([]byte(`{"id":"rs_123","identifier":"https://existing.example.com"}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v2/resource-servers/rs_123":
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]interface{}{"name": "Existing"}, body)
+			w.Write([]byte(`{"id":"rs_123","identifier":"https://existing.example.com","name":"Existing"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/resource-servers":
+			w.Write([]byte(`{"id":"rs_456","identifier":"https://new.example.com"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	existing := &ResourceServer{Identifier: auth0.String("https://existing.example.com"), Name: auth0.String("Existing")}
+	err := m.ResourceServer.Upsert(existing)
+	require.NoError(t, err)
+	assert.Equal(t, "rs_123", existing.GetID())
+	assert.Equal(t, "https://existing.example.com", existing.GetIdentifier())
+
+	created := &ResourceServer{Identifier: auth0.String("https://new.example.com")}
+	err = m.ResourceServer.Upsert(created)
+	require.NoError(t, err)
+	assert.Equal(t, "rs_456", created.GetID())
+
+	assert.Equal(t, []string{
+		"GET /api/v2/resource-servers/https:%2F%2Fexisting.example.com",
+		"PATCH /api/v2/resource-servers/rs_123",
+		"GET /api/v2/resource-servers/https:%2F%2Fnew.example.com",
+		"POST /api/v2/resource-servers",
+	}, requests)
+}
+
+func TestResourceServer_ReadByIdentifier(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v2/resource-servers/https:%2F%2Fapi.example.com%2Fv1%3Fregion=eu":
+			w.Write([]byte(`{"id":"rs_123","identifier":"https://api.example.com/v1?region=eu"}`))
+		case "/api/v2/resource-servers/rs_123":
+			w.Write([]byte(`{"id":"rs_123","identifier":"https://api.example.com/v1?region=eu"}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.EscapedPath())
+		}
+	})
+	m := newTestManagement(t, h)
+
+	rs, err := m.ResourceServer.ReadByIdentifier("https://api.example.com/v1?region=eu")
+	require.NoError(t, err)
+	assert.Equal(t, "rs_123", rs.GetID())
+
+	// The id of a resource server isn't its identifier.
+	_, err = m.ResourceServer.ReadByIdentifier("rs_123")
+	assert.True(t, IsStatusNotFound(err))
+}
+
+func TestResourceServer_RichAuthorizationRequests(t *testing.T) {
+	rs := &ResourceServer{
+		Identifier:   auth0.String("https://api.example.com"),
+		TokenDialect: auth0.String("rfc9068_profile_authz"),
+		AuthorizationDetails: &[]ResourceServerAuthorizationDetails{
+			{Type: auth0.String("payment_initiation")},
+		},
+		TokenEncryption: &ResourceServerTokenEncryption{
+			Format: auth0.String("compact-nested-jwe"),
+			EncryptionKey: &ResourceServerTokenEncryptionKey{
+				Name:      auth0.String("key"),
+				Algorithm: auth0.String("RSA-OAEP-256"),
+				PEM:       auth0.String("PEM"),
+			},
+		},
+		ConsentPolicy: auth0.String("transactional-authorization-with-mfa"),
+	}
+
+	b, err := json.Marshal(rs)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"identifier": "https://api.example.com",
+		"token_dialect": "rfc9068_profile_authz",
+		"authorization_details": [{"type": "payment_initiation"}],
+		"token_encryption": {
+			"format": "compact-nested-jwe",
+			"encryption_key": {"name": "key", "alg": "RSA-OAEP-256", "pem": "PEM"}
+		},
+		"consent_policy": "transactional-authorization-with-mfa"
+	}`, string(b))
+
+	var actual ResourceServer
+	err = json.Unmarshal(b, &actual)
+	require.NoError(t, err)
+	assert.Equal(t, rs, &actual)
+}
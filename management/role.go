@@ -1,5 +1,12 @@
 package management
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
 // Role is used to assign roles to a User.
 type Role struct {
 	// A unique ID for the role.
@@ -10,6 +17,33 @@ type Role struct {
 
 	// A description of the role created.
 	Description *string `json:"description,omitempty"`
+
+	// Extras holds the fields returned by the Management API that this SDK
+	// doesn't support yet, so that they are preserved when the role is sent
+	// back to Auth0. Fields unknown to the API should not be added here.
+	Extras map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r *Role) MarshalJSON() ([]byte, error) {
+	type role Role
+	return marshalWithExtras((*role)(r), r.Extras)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *Role) UnmarshalJSON(b []byte) error {
+	type role Role
+	if err := json.Unmarshal(b, (*role)(r)); err != nil {
+		return err
+	}
+
+	extras, err := unmarshalExtras(b, (*role)(r))
+	if err != nil {
+		return err
+	}
+	r.Extras = extras
+
+	return nil
 }
 
 // RoleList holds a list of Roles.
@@ -39,6 +73,19 @@ type PermissionList struct {
 	Permissions []*Permission `json:"permissions"`
 }
 
+// RoleState is the desired state of a role, used with RoleManager.Ensure.
+type RoleState struct {
+	// The role, which is looked up by its name.
+	Role *Role
+
+	// The permissions granted by the role.
+	Permissions []*Permission
+
+	// When true, the permissions granted by the role that aren't listed in
+	// Permissions are removed.
+	RemoveExtraPermissions bool
+}
+
 // RoleManager manages Auth0 Role resources.
 type RoleManager struct {
 	*Management
@@ -70,6 +117,194 @@ func (m *RoleManager) Update(id string, r *Role, opts ...RequestOption) (err err
 	return m.Request("PATCH", m.URI("roles", id), r, opts...)
 }
 
+// Patch updates the fields of the role set on the patch, which unlike Update
+// can set fields to their zero value or clear them with null, and returns the
+// updated role.
+func (m *RoleManager) Patch(id string, p *RolePatch, opts ...RequestOption) (*Role, error) {
+	return patchResource[Role](m.Management, m.URI("roles", id), p, opts...)
+}
+
+// UpdateWithRetry reads the role, applies mutate to it and updates the fields
+// mutate changed. When the update conflicts with a concurrent change, with a
+// 409 or 412 error, the role is read again and mutate applied again, up to 5
+// times, so that concurrent changes aren't overwritten. It returns the updated
+// role.
+//
+// Fields cleared by mutate are left out of the update.
+//
+// The options are applied to every request made.
+func (m *RoleManager) UpdateWithRetry(id string, mutate func(r *Role) error, opts ...RequestOption) (*Role, error) {
+	return updateWithRetry(m.Management, m.URI("roles", id),
+		func() (*Role, error) { return m.Read(id, opts...) },
+		mutate,
+		opts...,
+	)
+}
+
+// Upsert creates the role if none exists with the same name, or updates the
+// existing one otherwise. The role is looked up by its name, which is why it
+// must be set.
+//
+// The options are applied to every request made.
+func (m *RoleManager) Upsert(r *Role, opts ...RequestOption) error {
+	if r.GetName() == "" {
+		return &APIError{StatusCode: 400, Err: "Bad Request", Message: "Name cannot be empty"}
+	}
+
+	existing, err := m.ReadByName(r.GetName(), opts...)
+	if IsStatusNotFound(err) {
+		return m.Create(r, opts...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return m.Update(existing.GetID(), r, opts...)
+}
+
+// Ensure converges the role to the desired state. The role is created if
+// none exists with the same name, or patched if it has drifted. Missing
+// permissions are then granted, and the permissions that aren't desired are
+// removed if RemoveExtraPermissions is set.
+//
+// Permissions are reported in the result as the resource server identifier
+// and the permission name, separated by a space.
+//
+// The options are applied to every request made.
+func (m *RoleManager) Ensure(s *RoleState, opts ...RequestOption) (*EnsureResult, error) {
+	r := s.Role
+	if r.GetName() == "" {
+		return nil, &APIError{StatusCode: 400, Err: "Bad Request", Message: "Name cannot be empty"}
+	}
+
+	existing, result, err := ensure(r, r,
+		func() (*Role, error) { return m.ReadByName(r.GetName(), opts...) },
+		func() error { return m.Create(r, opts...) },
+		func(existing *Role) error { return m.Update(existing.GetID(), r, opts...) },
+	)
+	if err != nil {
+		return nil, err
+	}
+	result.ID = existing.GetID()
+
+	granted, err := listAll(m.Management, listPages(
+		func(opts ...RequestOption) (*PermissionList, error) { return m.Permissions(result.ID, opts...) },
+		(*PermissionList).items,
+	), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	grantedKeys := make(map[string]bool, len(granted))
+	for _, p := range granted {
+		grantedKeys[permissionKey(p)] = true
+	}
+
+	var missing []*Permission
+	for _, p := range s.Permissions {
+		if !grantedKeys[permissionKey(p)] {
+			missing = append(missing, &Permission{
+				ResourceServerIdentifier: p.ResourceServerIdentifier,
+				Name:                     p.Name,
+			})
+			result.Added = append(result.Added, permissionKey(p))
+		}
+	}
+	if len(missing) > 0 {
+		if err := m.AssociatePermissions(result.ID, missing, opts...); err != nil {
+			return nil, err
+		}
+	}
+
+	if !s.RemoveExtraPermissions {
+		return result, nil
+	}
+
+	desiredKeys := make(map[string]bool, len(s.Permissions))
+	for _, p := range s.Permissions {
+		desiredKeys[permissionKey(p)] = true
+	}
+
+	var extra []*Permission
+	for _, p := range granted {
+		if !desiredKeys[permissionKey(p)] {
+			extra = append(extra, &Permission{
+				ResourceServerIdentifier: p.ResourceServerIdentifier,
+				Name:                     p.Name,
+			})
+			result.Removed = append(result.Removed, permissionKey(p))
+		}
+	}
+	if len(extra) > 0 {
+		if err := m.RemovePermissions(result.ID, extra, opts...); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// AmbiguousNameError is returned when looking up a resource by a name shared
+// by several resources.
+type AmbiguousNameError struct {
+	// The kind of resource looked up, e.g. "role".
+	Resource string
+
+	// The name looked up.
+	Name string
+
+	// The ids of the resources with the name.
+	IDs []string
+}
+
+// Error formats the error into a string representation.
+func (e *AmbiguousNameError) Error() string {
+	return fmt.Sprintf("%d %ss are named %q: %s", len(e.IDs), e.Resource, e.Name, strings.Join(e.IDs, ", "))
+}
+
+// ReadByName retrieves the role with the given name, which unlike its id is
+// usually the same across tenants.
+//
+// The name is matched exactly. An error of type *AmbiguousNameError is
+// returned if several roles have the name.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Roles/get_roles
+func (m *RoleManager) ReadByName(name string, opts ...RequestOption) (*Role, error) {
+	if name == "" {
+		return nil, &APIError{StatusCode: 400, Err: "Bad Request", Message: "Name cannot be empty"}
+	}
+
+	roles, err := m.ListAll(withOptions(opts, Parameter("name_filter", name))...)
+	if err != nil {
+		return nil, err
+	}
+
+	// The name filter is case-insensitive and matches partial names.
+	var matches []*Role
+	for _, r := range roles {
+		if r.GetName() == name {
+			matches = append(matches, r)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, &APIError{StatusCode: 404, Err: "Not Found", Message: "Role not found"}
+	case 1:
+		return matches[0], nil
+	}
+
+	ids := make([]string, len(matches))
+	for i, r := range matches {
+		ids[i] = r.GetID()
+	}
+	return nil, &AmbiguousNameError{Resource: "role", Name: name, IDs: ids}
+}
+
+func permissionKey(p *Permission) string {
+	return p.GetResourceServerIdentifier() + " " + p.GetName()
+}
+
 // Delete a role.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/delete_roles_by_id
@@ -85,7 +320,7 @@ func (m *RoleManager) Delete(id string, opts ...RequestOption) (err error) {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/get_roles
 func (m *RoleManager) List(opts ...RequestOption) (r *RoleList, err error) {
-	err = m.Request("GET", m.URI("roles"), &r, applyListDefaults(opts))
+	r, err = listPage[*RoleList](m.Management, m.URI("roles"), applyListDefaults(opts))
 	return
 }
 
@@ -101,11 +336,139 @@ func (m *RoleManager) AssignUsers(id string, users []*User, opts ...RequestOptio
 	return m.Request("POST", m.URI("roles", id, "users"), &u, opts...)
 }
 
+// roleUsersBatchSize is the number of users assigned to a role by each
+// request of AssignUsersInBulk.
+const roleUsersBatchSize = 100
+
+// AssignUsersInBulk assigns a role to any number of users, in batches of at
+// most 100 users per request.
+//
+// Batches are sent one at a time, or up to the number of requests configured
+// with WithMaxConcurrentRequests at the same time. progress, if not nil, is
+// called with the number of users at the start of userIDs the role is known
+// to be assigned to, each time it grows. That number is also returned, even
+// when an error is returned, so that the assignment can be resumed with the
+// users that follow, as assigning a role to a user who has it already
+// succeeds.
+//
+// The options are applied to every request made.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Roles/post_role_users
+func (m *RoleManager) AssignUsersInBulk(id string, userIDs []string, progress func(done int), opts ...RequestOption) (int, error) {
+	return runBatches(m.maxConcurrency, len(userIDs), roleUsersBatchSize, func(start, end int) error {
+		users := make([]*User, end-start)
+		for i := range users {
+			users[i] = &User{ID: &userIDs[start+i]}
+		}
+		return m.AssignUsers(id, users, opts...)
+	}, progress)
+}
+
+// RemoveUsersInBulk removes a role from any number of users. As the
+// Management API can only remove roles from one user at a time, a request is
+// made for each user, so removing a role from many users takes as many
+// requests, which are subject to the rate limit of the tenant.
+//
+// Requests are sent one at a time, or up to the number of requests
+// configured with WithMaxConcurrentRequests at the same time. progress, if not
+// nil, is called with the number of users at the start of userIDs the role is
+// known to be removed from, each time it grows. That number is also returned,
+// even when an error is returned, so that the removal can be resumed with the
+// users that follow, as removing a role from a user who doesn't have it
+// succeeds.
+//
+// The options are applied to every request made.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Users/delete_user_roles
+func (m *RoleManager) RemoveUsersInBulk(id string, userIDs []string, progress func(done int), opts ...RequestOption) (int, error) {
+	roles := []*Role{{ID: &id}}
+	return runBatches(m.maxConcurrency, len(userIDs), 1, func(start, _ int) error {
+		return m.User.RemoveRoles(userIDs[start], roles, opts...)
+	}, progress)
+}
+
+// runBatches calls do with the bounds of each batch of at most size of the n
+// items, running up to concurrency batches at the same time, or one at a
+// time when concurrency isn't positive. No more batches are started once one
+// fails.
+//
+// It returns the number of items at the start which are done, calling
+// progress, if not nil, each time that number grows, along with the error of
+// the first batch which failed.
+func runBatches(concurrency, n, size int, do func(start, end int) error, progress func(done int)) (int, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	batchCount := (n + size - 1) / size
+	doneBatches := make([]bool, batchCount)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		done     int
+		firstErr error
+		slots    = make(chan struct{}, concurrency)
+	)
+
+	for batch := 0; batch < batchCount; batch++ {
+		slots <- struct{}{}
+
+		mu.Lock()
+		failed := firstErr != nil
+		mu.Unlock()
+		if failed {
+			<-slots
+			break
+		}
+
+		wg.Add(1)
+		go func(batch int) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			start := batch * size
+			end := start + size
+			if end > n {
+				end = n
+			}
+			err := do(start, end)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+
+			doneBatches[batch] = true
+			previous := done
+			for done < n && doneBatches[done/size] {
+				done += size
+				if done > n {
+					done = n
+				}
+			}
+			if done > previous && progress != nil {
+				progress(done)
+			}
+		}(batch)
+	}
+
+	wg.Wait()
+
+	return done, firstErr
+}
+
 // Users retrieves all users associated with a role.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/get_role_user
 func (m *RoleManager) Users(id string, opts ...RequestOption) (u *UserList, err error) {
-	err = m.Request("GET", m.URI("roles", id, "users"), &u, applyListDefaults(opts))
+	u, err = listPage[*UserList](m.Management, m.URI("roles", id, "users"), applyListDefaults(opts))
 	return
 }
 
@@ -122,7 +485,7 @@ func (m *RoleManager) AssociatePermissions(id string, permissions []*Permission,
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/get_role_permission
 func (m *RoleManager) Permissions(id string, opts ...RequestOption) (p *PermissionList, err error) {
-	err = m.Request("GET", m.URI("roles", id, "permissions"), &p, applyListDefaults(opts))
+	p, err = listPage[*PermissionList](m.Management, m.URI("roles", id, "permissions"), applyListDefaults(opts))
 	return
 }
 
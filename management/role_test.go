@@ -1,9 +1,13 @@
 package management
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -181,3 +185,218 @@ func cleanupRole(t *testing.T, roleID string) {
 		}
 	}
 }
+
+func TestRoleManager_Upsert(t *testing.T) {
+	var requests []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/roles":
+			if r.URL.Query().Get("name_filter") == "admin" {
+				w.Write([]byte(`{"start":0,"limit":50,"total":2,"roles":[{"id":"rol_1","name":"super-admin"},{"id":"rol_2","name":"admin"}]}`))
+				return
+			}
+			w.Write([]byte(`{"start":0,"limit":50,"total":0,"roles":[]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v2/roles/rol_2":
+			w.Write([]byte(`{"id":"rol_2","name":"admin","description":"Administrators"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/roles":
+			w.Write([]byte(`{"id":"rol_3","name":"viewer"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	existing := &Role{Name: auth0.String("admin"), Description: auth0.String("Administrators")}
+	err := m.Role.Upsert(existing)
+	require.NoError(t, err)
+	assert.Equal(t, "rol_2", existing.GetID())
+
+	created := &Role{Name: auth0.String("viewer")}
+	err = m.Role.Upsert(created)
+	require.NoError(t, err)
+	assert.Equal(t, "rol_3", created.GetID())
+
+	assert.Equal(t, []string{
+		"GET /api/v2/roles",
+		"PATCH /api/v2/roles/rol_2",
+		"GET /api/v2/roles",
+		"POST /api/v2/roles",
+	}, requests)
+}
+
+func TestRoleManager_ReadByName(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/roles", r.URL.Path)
+
+		switch r.URL.Query().Get("name_filter") {
+		case "admin":
+			w.Write([]byte(`{"start":0,"limit":50,"total":2,"roles":[{"id":"rol_1","name":"super-admin"},{"id":"rol_2","name":"admin"}]}`))
+		case "editor":
+			w.Write([]byte(`{"start":0,"limit":50,"total":2,"roles":[{"id":"rol_3","name":"editor"},{"id":"rol_4","name":"editor"}]}`))
+		default:
+			w.Write([]byte(`{"start":0,"limit":50,"total":0,"roles":[]}`))
+		}
+	})
+	m := newTestManagement(t, h)
+
+	role, err := m.Role.ReadByName("admin")
+	require.NoError(t, err)
+	assert.Equal(t, "rol_2", role.GetID())
+
+	_, err = m.Role.ReadByName("viewer")
+	assert.True(t, IsStatusNotFound(err))
+
+	_, err = m.Role.ReadByName("editor")
+	var ambiguousErr *AmbiguousNameError
+	require.ErrorAs(t, err, &ambiguousErr)
+	assert.Equal(t, []string{"rol_3", "rol_4"}, ambiguousErr.IDs)
+	assert.EqualError(t, err, `2 roles are named "editor": rol_3, rol_4`)
+}
+
+func TestRoleManager_Ensure(t *testing.T) {
+	var requests []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/roles":
+			w.Write([]byte(`{"start":0,"limit":50,"total":1,"roles":[{"id":"rol_1","name":"admin","description":"Admins"}]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v2/roles/rol_1":
+			w.Write([]byte(`{"id":"rol_1","name":"admin","description":"Administrators"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/roles/rol_1/permissions":
+			w.Write([]byte(`{"start":0,"limit":50,"total":2,"permissions":[
+				{"resource_server_identifier":"https://api","permission_name":"read:things","resource_server_name":"API"},
+				{"resource_server_identifier":"https://api","permission_name":"delete:things","resource_server_name":"API"}
+			]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/roles/rol_1/permissions":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"permissions":[{"resource_server_identifier":"https://api","permission_name":"write:things"}]}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v2/roles/rol_1/permissions":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"permissions":[{"resource_server_identifier":"https://api","permission_name":"delete:things"}]}`, string(body))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	result, err := m.Role.Ensure(&RoleState{
+		Role: &Role{Name: auth0.String("admin"), Description: auth0.String("Administrators")},
+		Permissions: []*Permission{
+			{ResourceServerIdentifier: auth0.String("https://api"), Name: auth0.String("read:things")},
+			{ResourceServerIdentifier: auth0.String("https://api"), Name: auth0.String("write:things")},
+		},
+		RemoveExtraPermissions: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &EnsureResult{
+		ID:      "rol_1",
+		Updated: true,
+		Added:   []string{"https://api write:things"},
+		Removed: []string{"https://api delete:things"},
+	}, result)
+
+	assert.Equal(t, []string{
+		"GET /api/v2/roles",
+		"PATCH /api/v2/roles/rol_1",
+		"GET /api/v2/roles/rol_1/permissions",
+		"POST /api/v2/roles/rol_1/permissions",
+		"DELETE /api/v2/roles/rol_1/permissions",
+	}, requests)
+
+	requests = nil
+	result, err = m.Role.Ensure(&RoleState{
+		Role: &Role{Name: auth0.String("admin"), Description: auth0.String("Admins")},
+		Permissions: []*Permission{
+			{ResourceServerIdentifier: auth0.String("https://api"), Name: auth0.String("read:things")},
+		},
+	})
+	require.NoError(t, err)
+	assert.False(t, result.HasChanges())
+	assert.Equal(t, []string{
+		"GET /api/v2/roles",
+		"GET /api/v2/roles/rol_1/permissions",
+	}, requests)
+}
+
+func TestRoleManager_AssignAndRemoveUsersInBulk(t *testing.T) {
+	var batches []int
+	var removed []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/roles/rol_1/users":
+			var body struct {
+				Users []string `json:"users"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			batches = append(batches, len(body.Users))
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodDelete:
+			var body struct {
+				Roles []string `json:"roles"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []string{"rol_1"}, body.Roles)
+			removed = append(removed, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	userIDs := make([]string, 250)
+	for i := range userIDs {
+		userIDs[i] = fmt.Sprintf("auth0|%d", i)
+	}
+
+	var progress []int
+	done, err := m.Role.AssignUsersInBulk("rol_1", userIDs, func(done int) {
+		progress = append(progress, done)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 250, done)
+	assert.Equal(t, []int{100, 100, 50}, batches)
+	assert.Equal(t, []int{100, 200, 250}, progress)
+
+	done, err = m.Role.RemoveUsersInBulk("rol_1", userIDs[:2], nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, done)
+	assert.Equal(t, []string{"/api/v2/users/auth0|0/roles", "/api/v2/users/auth0|1/roles"}, removed)
+}
+
+func TestRunBatches(t *testing.T) {
+	t.Run("concurrently", func(t *testing.T) {
+		var mu sync.Mutex
+		var batches [][2]int
+		var progress []int
+		done, err := runBatches(3, 250, 100, func(start, end int) error {
+			mu.Lock()
+			defer mu.Unlock()
+			batches = append(batches, [2]int{start, end})
+			return nil
+		}, func(done int) {
+			progress = append(progress, done)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 250, done)
+		assert.ElementsMatch(t, [][2]int{{0, 100}, {100, 200}, {200, 250}}, batches)
+		assert.Equal(t, 250, progress[len(progress)-1])
+		assert.IsIncreasing(t, progress)
+	})
+
+	t.Run("with a failing batch", func(t *testing.T) {
+		done, err := runBatches(1, 10, 2, func(start, end int) error {
+			if start == 4 {
+				return errors.New("failed")
+			}
+			return nil
+		}, nil)
+		assert.EqualError(t, err, "failed")
+		assert.Equal(t, 4, done)
+	})
+}
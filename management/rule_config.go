@@ -41,7 +41,7 @@ func (m *RuleConfigManager) Read(key string, opts ...RequestOption) (*RuleConfig
 			return r, nil
 		}
 	}
-	return nil, &managementError{404, "Not Found", "Rule config not found"}
+	return nil, &APIError{StatusCode: 404, Err: "Not Found", Message: "Rule config not found"}
 }
 
 // Delete a rule configuration variable identified by its key.
@@ -1,6 +1,9 @@
 package management
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // SigningKey is used for signing tokens.
 type SigningKey struct {
@@ -23,10 +26,10 @@ type SigningKey struct {
 	Previous *bool `json:"previous,omitempty"`
 
 	// The date and time when the key became the current key.
-	CurrentSince *time.Time `json:"current_since,omitempty"`
+	CurrentSince *Timestamp `json:"current_since,omitempty"`
 
 	// The date and time when the current key was rotated.
-	CurrentUntil *time.Time `json:"current_until,omitempty"`
+	CurrentUntil *Timestamp `json:"current_until,omitempty"`
 
 	// The cert fingerprint.
 	Fingerprint *string `json:"fingerprint,omitempty"`
@@ -38,7 +41,53 @@ type SigningKey struct {
 	Revoked *bool `json:"revoked,omitempty"`
 
 	// The date and time when the key was revoked.
-	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	RevokedAt *Timestamp `json:"revoked_at,omitempty"`
+}
+
+// Steps of a signing key rotation, reported by SigningKeyManager.RotateAndWait.
+const (
+	// SigningKeyRotationRotated is reported once the new key is created.
+	SigningKeyRotationRotated = "rotated"
+	// SigningKeyRotationCurrent is reported once the new key is the current
+	// key.
+	SigningKeyRotationCurrent = "current"
+	// SigningKeyRotationGracePeriod is reported before waiting for the grace
+	// period, for the previous key.
+	SigningKeyRotationGracePeriod = "grace_period"
+	// SigningKeyRotationRevoked is reported once the previous key is revoked.
+	SigningKeyRotationRevoked = "revoked"
+)
+
+// DefaultSigningKeyPollInterval is the time waited between two checks of
+// whether the new key is the current key in SigningKeyManager.RotateAndWait.
+const DefaultSigningKeyPollInterval = 5 * time.Second
+
+// SigningKeyRotation configures SigningKeyManager.RotateAndWait.
+type SigningKeyRotation struct {
+	// PollInterval is the time waited between two checks of whether the new
+	// key is the current key. Defaults to DefaultSigningKeyPollInterval.
+	PollInterval time.Duration
+
+	// RevokePrevious, if true, revokes the previous key once the grace
+	// period has elapsed.
+	RevokePrevious bool
+
+	// GracePeriod is how long the previous key remains valid after the new
+	// key became current, so that the tokens it signed can still be verified
+	// until they expire. Only used with RevokePrevious.
+	GracePeriod time.Duration
+
+	// OnProgress, if not nil, is called after each step of the rotation.
+	OnProgress func(SigningKeyRotationEvent)
+}
+
+// SigningKeyRotationEvent reports a step of a signing key rotation.
+type SigningKeyRotationEvent struct {
+	// Step of the rotation, such as SigningKeyRotationRotated.
+	Step string
+
+	// KID is the key id of the key the step applies to.
+	KID string
 }
 
 // SigningKeyManager manages Auth0 SigningKey resources.
@@ -81,3 +130,75 @@ func (m *SigningKeyManager) Revoke(kid string, opts ...RequestOption) (k *Signin
 	err = m.Request("PUT", m.URI("keys", "signing", kid, "revoke"), &k, opts...)
 	return
 }
+
+// RotateAndWait rotates the Application Signing Key, then waits until the new
+// key is the current key and returns it.
+//
+// If r.RevokePrevious is true, the previous key is then revoked once
+// r.GracePeriod has elapsed. The steps of the rotation are reported to
+// r.OnProgress, such as to keep records of the change.
+//
+// The requests are made with ctx, unless a Context option is passed.
+func (m *SigningKeyManager) RotateAndWait(ctx context.Context, r *SigningKeyRotation, opts ...RequestOption) (*SigningKey, error) {
+	opts = withContext(ctx, opts)
+	progress := func(step, kid string) {
+		if r.OnProgress != nil {
+			r.OnProgress(SigningKeyRotationEvent{Step: step, KID: kid})
+		}
+	}
+
+	keys, err := m.List(opts...)
+	if err != nil {
+		return nil, err
+	}
+	var previous *SigningKey
+	for _, k := range keys {
+		if k.GetCurrent() {
+			previous = k
+		}
+	}
+
+	k, err := m.Rotate(opts...)
+	if err != nil {
+		return nil, err
+	}
+	progress(SigningKeyRotationRotated, k.GetKID())
+
+	pollInterval := r.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultSigningKeyPollInterval
+	}
+	for !k.GetCurrent() {
+		if k, err = m.Read(k.GetKID(), opts...); err != nil {
+			return nil, err
+		}
+		if k.GetCurrent() {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-m.clock.After(pollInterval):
+		}
+	}
+	progress(SigningKeyRotationCurrent, k.GetKID())
+
+	if !r.RevokePrevious || previous == nil {
+		return k, nil
+	}
+
+	progress(SigningKeyRotationGracePeriod, previous.GetKID())
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-m.clock.After(r.GracePeriod):
+	}
+
+	if _, err := m.Revoke(previous.GetKID(), opts...); err != nil {
+		return nil, err
+	}
+	progress(SigningKeyRotationRevoked, previous.GetKID())
+
+	return k, nil
+}
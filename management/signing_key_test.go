@@ -1,9 +1,14 @@
 package management
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSigningKey(t *testing.T) {
@@ -61,3 +66,45 @@ func TestSigningKey(t *testing.T) {
 		assert.NotEmpty(t, revokedKey)
 	})
 }
+
+func TestSigningKeyManager_RotateAndWait(t *testing.T) {
+	var reads int
+	var revoked string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/keys/signing":
+			w.Write([]byte(`[{"kid":"old","current":true},{"kid":"older","previous":true}]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/keys/signing/rotate":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"kid":"new","cert":"-----BEGIN CERTIFICATE-----"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/keys/signing/new":
+			reads++
+			fmt.Fprintf(w, `{"kid":"new","current":%t,"next":%t}`, reads > 1, reads <= 1)
+		case r.Method == http.MethodPut:
+			revoked = r.URL.Path
+			w.Write([]byte(`{"kid":"old","revoked":true}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h, WithClock(immediateClock{}))
+
+	var events []SigningKeyRotationEvent
+	k, err := m.SigningKey.RotateAndWait(context.Background(), &SigningKeyRotation{
+		RevokePrevious: true,
+		GracePeriod:    time.Hour,
+		OnProgress:     func(e SigningKeyRotationEvent) { events = append(events, e) },
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "new", k.GetKID())
+	assert.True(t, k.GetCurrent())
+	assert.Equal(t, 2, reads)
+	assert.Equal(t, "/api/v2/keys/signing/old/revoke", revoked)
+	assert.Equal(t, []SigningKeyRotationEvent{
+		{Step: SigningKeyRotationRotated, KID: "new"},
+		{Step: SigningKeyRotationCurrent, KID: "new"},
+		{Step: SigningKeyRotationGracePeriod, KID: "old"},
+		{Step: SigningKeyRotationRevoked, KID: "old"},
+	}, events)
+}
@@ -1,6 +1,9 @@
 package management
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // StatManager manages Auth0 DailyStat resources.
 type StatManager struct {
@@ -22,12 +25,12 @@ func (m *StatManager) ActiveUsers(opts ...RequestOption) (i int, err error) {
 
 // DailyStat for an Auth0 Tenant.
 type DailyStat struct {
-	Date            *time.Time `json:"date"`
+	Date            *Timestamp `json:"date"`
 	Logins          *int       `json:"logins"`
 	Signups         *int       `json:"signups"`
 	LeakedPasswords *int       `json:"leaked_passwords"`
-	UpdatedAt       *time.Time `json:"updated_at"`
-	CreatedAt       *time.Time `json:"created_at"`
+	UpdatedAt       *Timestamp `json:"updated_at"`
+	CreatedAt       *Timestamp `json:"created_at"`
 }
 
 // Daily retrieves the number of logins, signups and breached-password
@@ -39,3 +42,147 @@ func (m *StatManager) Daily(opts ...RequestOption) (ds []*DailyStat, err error)
 	err = m.Request("GET", m.URI("stats", "daily"), &ds, opts...)
 	return
 }
+
+// statDateLayout is the layout of the dates of the from and to parameters of
+// the daily stats.
+const statDateLayout = "20060102"
+
+// StatTotals totals the daily stats of a period.
+//
+// These are counts of events, not of users: the daily stats don't tell which
+// users logged in, so the monthly or daily active users (MAU or DAU) of a
+// period can't be computed from them. Only ActiveUsers reports active users,
+// for the last 30 days.
+type StatTotals struct {
+	// From is the first day of the period.
+	From time.Time `json:"from"`
+
+	// To is the last day of the period.
+	To time.Time `json:"to"`
+
+	// Days is the number of days of the period.
+	Days int `json:"days"`
+
+	// Logins is the number of logins during the period. Users logging in
+	// several times are counted several times.
+	Logins int `json:"logins"`
+
+	// Signups is the number of signups during the period.
+	Signups int `json:"signups"`
+
+	// LeakedPasswords is the number of breached-password detections during
+	// the period.
+	LeakedPasswords int `json:"leaked_passwords"`
+}
+
+// AverageDailyLogins returns the average number of logins per day during the
+// period.
+func (t *StatTotals) AverageDailyLogins() float64 {
+	if t.Days == 0 {
+		return 0
+	}
+	return float64(t.Logins) / float64(t.Days)
+}
+
+func (t *StatTotals) add(stat *DailyStat) {
+	day := statDay(stat.GetDate().UTC())
+	if t.Days == 0 {
+		t.From = day
+	}
+	t.To = day
+	t.Days++
+	t.Logins += stat.GetLogins()
+	t.Signups += stat.GetSignups()
+	t.LeakedPasswords += stat.GetLeakedPasswords()
+}
+
+// statDay returns the day of t, which is the date of t in its location, as
+// the midnight of that date in UTC, like the dates of the daily stats.
+func statDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// DailyRange retrieves the daily stats of every day from the date of from to
+// the date of to, both included, in chronological order. The days for which
+// the Management API has no stats, such as days without activity, are filled
+// with zero counts.
+//
+// The dates of from and to are taken in their location, while the days of
+// the stats are days in UTC, as reported by the Management API.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Stats/get_daily
+func (m *StatManager) DailyRange(from, to time.Time, opts ...RequestOption) ([]*DailyStat, error) {
+	first, last := statDay(from), statDay(to)
+	if last.Before(first) {
+		return nil, fmt.Errorf("the end of the period %s is before its start %s", last.Format("2006-01-02"), first.Format("2006-01-02"))
+	}
+
+	stats, err := m.Daily(withOptions(opts,
+		Parameter("from", first.Format(statDateLayout)),
+		Parameter("to", last.Format(statDateLayout)),
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	byDay := make(map[time.Time]*DailyStat, len(stats))
+	for _, stat := range stats {
+		if stat.Date != nil {
+			byDay[statDay(stat.Date.UTC())] = stat
+		}
+	}
+
+	var days []*DailyStat
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		stat, ok := byDay[day]
+		if !ok {
+			zero := 0
+			stat = &DailyStat{
+				Date:            &Timestamp{day},
+				Logins:          &zero,
+				Signups:         &zero,
+				LeakedPasswords: &zero,
+			}
+		}
+		days = append(days, stat)
+	}
+
+	return days, nil
+}
+
+// Totals retrieves the daily stats of every day from the date of from to the
+// date of to, both included, as DailyRange does, and totals them.
+func (m *StatManager) Totals(from, to time.Time, opts ...RequestOption) (*StatTotals, error) {
+	stats, err := m.DailyRange(from, to, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := &StatTotals{}
+	for _, stat := range stats {
+		totals.add(stat)
+	}
+
+	return totals, nil
+}
+
+// Monthly retrieves the daily stats of every day from the date of from to the
+// date of to, both included, as DailyRange does, and totals them by calendar
+// month in UTC. The first and last months only cover the days of the period.
+func (m *StatManager) Monthly(from, to time.Time, opts ...RequestOption) ([]*StatTotals, error) {
+	stats, err := m.DailyRange(from, to, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var months []*StatTotals
+	for _, stat := range stats {
+		day := statDay(stat.GetDate().UTC())
+		if len(months) == 0 || months[len(months)-1].From.Month() != day.Month() {
+			months = append(months, &StatTotals{})
+		}
+		months[len(months)-1].add(stat)
+	}
+
+	return months, nil
+}
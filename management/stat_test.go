@@ -1,9 +1,12 @@
 package management
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStatManager_ActiveUsers(t *testing.T) {
@@ -21,3 +24,54 @@ func TestStatManager_Daily(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, daily)
 }
+
+func TestStatManager_DailyRange(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/stats/daily", r.URL.Path)
+		assert.Equal(t, "20230130", r.URL.Query().Get("from"))
+		assert.Equal(t, "20230202", r.URL.Query().Get("to"))
+
+		w.Write([]byte(`[
+			{"date":"2023-01-30T00:00:00.000Z","logins":10,"signups":2,"leaked_passwords":0},
+			{"date":"2023-02-01T00:00:00.000Z","logins":6,"signups":1,"leaked_passwords":1},
+			{"date":"2023-02-02T00:00:00.000Z","logins":4,"signups":0,"leaked_passwords":0}
+		]`))
+	})
+	m := newTestManagement(t, h)
+
+	// The dates are taken in the location of the times, even if it's a
+	// different date in UTC.
+	tokyo := time.FixedZone("JST", 9*60*60)
+	from := time.Date(2023, 1, 30, 1, 0, 0, 0, tokyo)
+	to := time.Date(2023, 2, 2, 1, 0, 0, 0, tokyo)
+
+	days, err := m.Stat.DailyRange(from, to)
+	require.NoError(t, err)
+	require.Len(t, days, 4)
+	assert.Equal(t, time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC), days[1].GetDate().Time)
+	assert.Equal(t, 0, days[1].GetLogins())
+	assert.Equal(t, 6, days[2].GetLogins())
+
+	totals, err := m.Stat.Totals(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, &StatTotals{
+		From:            time.Date(2023, 1, 30, 0, 0, 0, 0, time.UTC),
+		To:              time.Date(2023, 2, 2, 0, 0, 0, 0, time.UTC),
+		Days:            4,
+		Logins:          20,
+		Signups:         3,
+		LeakedPasswords: 1,
+	}, totals)
+	assert.Equal(t, 5.0, totals.AverageDailyLogins())
+
+	months, err := m.Stat.Monthly(from, to)
+	require.NoError(t, err)
+	require.Len(t, months, 2)
+	assert.Equal(t, 2, months[0].Days)
+	assert.Equal(t, 10, months[0].Logins)
+	assert.Equal(t, time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), months[1].From)
+	assert.Equal(t, 10, months[1].Logins)
+
+	_, err = m.Stat.DailyRange(to, from)
+	assert.EqualError(t, err, "the end of the period 2023-01-30 is before its start 2023-02-02")
+}
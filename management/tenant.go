@@ -24,6 +24,8 @@ type Tenant struct {
 	// Database Connections, Passwordless, Windows Azure Active Directory, ADFS.
 	DefaultDirectory *string `json:"default_directory,omitempty"`
 
+	// Error page settings, see TenantErrorPageRedirect, TenantErrorPageHTML
+	// and TenantErrorPageDefault for the common setups.
 	ErrorPage *TenantErrorPage `json:"error_page,omitempty"`
 
 	DeviceFlow *TenantDeviceFlow `json:"device_flow,omitempty"`
@@ -77,6 +79,50 @@ type Tenant struct {
 	EnabledLocales *[]string `json:"enabled_locales,omitempty"`
 
 	SessionCookie *TenantSessionCookie `json:"session_cookie,omitempty"`
+
+	// Sessions related settings for the tenant.
+	Sessions *TenantSessions `json:"sessions,omitempty"`
+
+	// Supported ACR values, used by clients requesting a specific
+	// authentication context with the acr_values parameter.
+	ACRValuesSupported *[]string `json:"acr_values_supported,omitempty"`
+
+	// mTLS configuration of the tenant.
+	MTLS *TenantMTLSConfiguration `json:"mtls,omitempty"`
+
+	// Whether the tenant supports Pushed Authorization Requests.
+	PushedAuthorizationRequestsSupported *bool `json:"pushed_authorization_requests_supported,omitempty"`
+
+	// Extras holds the fields returned by the Management API that this SDK
+	// doesn't support yet, so that they are preserved when the tenant is sent
+	// back to Auth0. Fields unknown to the API should not be added here.
+	Extras map[string]json.RawMessage `json:"-"`
+}
+
+// tenantFriendlyNameMaxLength is the maximum length of the friendly name of a
+// tenant.
+const tenantFriendlyNameMaxLength = 255
+
+// Validate checks the tenant settings against the constraints documented by the
+// Management API, such as the https URLs without fragment expected for the
+// default redirection URI and the error page, and the syntax of the support
+// email. A nil error is returned if the settings are valid, otherwise the
+// returned error is of type ValidationErrors.
+func (t *Tenant) Validate() error {
+	v := &validator{}
+
+	v.maxLength("friendly_name", t.FriendlyName, tenantFriendlyNameMaxLength)
+	v.httpsURL("picture_url", t.PictureURL)
+	v.emailAddress("support_email", t.SupportEmail)
+	v.httpsURL("support_url", t.SupportURL)
+	v.httpsURL("default_redirection_uri", t.DefaultRedirectionURI)
+	v.eachURL("allowed_logout_urls", t.AllowedLogoutURLs)
+
+	if t.ErrorPage != nil {
+		v.httpsURL("error_page.url", t.ErrorPage.URL)
+	}
+
+	return v.err()
 }
 
 // MarshalJSON is a custom serializer for the Tenant type.
@@ -114,7 +160,28 @@ func (t *Tenant) MarshalJSON() ([]byte, error) {
 		}
 	}
 
-	return json.Marshal(w)
+	return marshalWithExtras(w, t.Extras)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *Tenant) UnmarshalJSON(b []byte) error {
+	type tenant Tenant
+	type tenantWrapper struct {
+		*tenant
+		SessionLifetimeInMinutes     *int `json:"session_lifetime_in_minutes,omitempty"`
+		IdleSessionLifetimeInMinutes *int `json:"idle_session_lifetime_in_minutes,omitempty"`
+	}
+
+	w := &tenantWrapper{(*tenant)(t), nil, nil}
+
+	err := json.Unmarshal(b, w)
+	if err != nil {
+		return err
+	}
+
+	t.Extras, err = unmarshalExtras(b, w)
+
+	return err
 }
 
 // TenantChangePassword holds settings for the change password page.
@@ -148,6 +215,36 @@ type TenantErrorPage struct {
 	URL *string `json:"url,omitempty"`
 }
 
+// TenantErrorPageRedirect returns error page settings redirecting users to url,
+// which must be an https URL, instead of showing an error page.
+func TenantErrorPageRedirect(url string) *TenantErrorPage {
+	return &TenantErrorPage{
+		HTML:        auth0.String(""),
+		ShowLogLink: auth0.Bool(false),
+		URL:         auth0.String(url),
+	}
+}
+
+// TenantErrorPageHTML returns error page settings showing the custom html,
+// which may use the Liquid syntax, instead of the default error page.
+func TenantErrorPageHTML(html string) *TenantErrorPage {
+	return &TenantErrorPage{
+		HTML:        auth0.String(html),
+		ShowLogLink: auth0.Bool(false),
+		URL:         auth0.String(""),
+	}
+}
+
+// TenantErrorPageDefault returns error page settings showing the default error
+// page of Auth0, with a link to the logs if showLogLink is true.
+func TenantErrorPageDefault(showLogLink bool) *TenantErrorPage {
+	return &TenantErrorPage{
+		HTML:        auth0.String(""),
+		ShowLogLink: auth0.Bool(showLogLink),
+		URL:         auth0.String(""),
+	}
+}
+
 // TenantFlags holds information on flag toggles.
 type TenantFlags struct {
 	// This flag determines whether all current connections shall be enabled
@@ -243,6 +340,9 @@ type TenantFlags struct {
 	// If `true`, all Clients will be required to use Pushed Authorization Requests.
 	// This feature currently must be enabled for your tenant.
 	RequirePushedAuthorizationRequests *bool `json:"require_pushed_authorization_requests,omitempty"`
+
+	// Removes the alg property from the JWKS of the tenant.
+	RemoveAlgFromJWKS *bool `json:"remove_alg_from_jwks,omitempty"`
 }
 
 // TenantUniversalLogin holds universal login settings.
@@ -350,6 +450,19 @@ type TenantSessionCookie struct {
 	Mode *string `json:"mode,omitempty"`
 }
 
+// TenantSessions holds sessions related settings for the tenant.
+type TenantSessions struct {
+	// Whether to bypass prompting logout confirmation.
+	OIDCLogoutPromptEnabled *bool `json:"oidc_logout_prompt_enabled,omitempty"`
+}
+
+// TenantMTLSConfiguration holds the mTLS configuration of the tenant.
+type TenantMTLSConfiguration struct {
+	// If true, enables mTLS endpoint aliases, so that mTLS requests are made
+	// to the mtls.<domain> aliases of the tenant endpoints.
+	EnableEndpointAliases *bool `json:"enable_endpoint_aliases,omitempty"`
+}
+
 // TenantManager manages Auth0 Tenant resources.
 type TenantManager struct {
 	*Management
@@ -119,3 +119,69 @@ func TestTenantUniversalLoginColors_UnmarshalJSON(t *testing.T) {
 		assert.Equal(t, &actual, expected)
 	}
 }
+
+func TestTenant_UnmarshalJSON(t *testing.T) {
+	var tenant Tenant
+	err := json.Unmarshal([]byte(`{
+		"flags": {"enable_sso": true, "remove_alg_from_jwks": true},
+		"session_cookie": {"mode": "persistent"},
+		"session_lifetime": 168,
+		"idle_session_lifetime": 72,
+		"sessions": {"oidc_logout_prompt_enabled": false},
+		"acr_values_supported": ["urn:mace:incommon:iap:silver"],
+		"mtls": {"enable_endpoint_aliases": true},
+		"pushed_authorization_requests_supported": true,
+		"customize_mfa_in_postlogin_action": true
+	}`), &tenant)
+	require.NoError(t, err)
+
+	assert.True(t, tenant.GetFlags().GetEnableSSO())
+	assert.True(t, tenant.GetFlags().GetRemoveAlgFromJWKS())
+	assert.Equal(t, "persistent", tenant.GetSessionCookie().GetMode())
+	assert.Equal(t, 168.0, tenant.GetSessionLifetime())
+	assert.Equal(t, 72.0, tenant.GetIdleSessionLifetime())
+	assert.False(t, tenant.GetSessions().GetOIDCLogoutPromptEnabled())
+	assert.Equal(t, []string{"urn:mace:incommon:iap:silver"}, tenant.GetACRValuesSupported())
+	assert.True(t, tenant.GetMTLS().GetEnableEndpointAliases())
+	assert.True(t, tenant.GetPushedAuthorizationRequestsSupported())
+
+	// Only the fields that aren't typed are kept in the extras.
+	assert.Equal(t, map[string]json.RawMessage{
+		"customize_mfa_in_postlogin_action": json.RawMessage(`true`),
+	}, tenant.Extras)
+}
+
+func TestTenant_Validate(t *testing.T) {
+	tenant := &Tenant{
+		FriendlyName:          auth0.String("My Example Tenant"),
+		SupportEmail:          auth0.String("support@example.com"),
+		SupportURL:            auth0.String("https://support.example.com"),
+		DefaultRedirectionURI: auth0.String("https://example.com/login"),
+		ErrorPage:             TenantErrorPageRedirect("https://example.com/error"),
+	}
+	assert.NoError(t, tenant.Validate())
+
+	tenant = &Tenant{
+		SupportEmail:          auth0.String("Support <support@example.com>"),
+		SupportURL:            auth0.String("http://support.example.com"),
+		DefaultRedirectionURI: auth0.String("https://example.com/login#callback"),
+		ErrorPage:             TenantErrorPageRedirect("/error"),
+	}
+	var validationErrs ValidationErrors
+	require.ErrorAs(t, tenant.Validate(), &validationErrs)
+	assert.Len(t, validationErrs, 4)
+	assert.EqualError(t, validationErrs[0], `invalid support_email: "Support <support@example.com>" is not a valid email address`)
+	assert.Equal(t, "error_page.url", validationErrs[3].Field)
+}
+
+func TestTenantErrorPage(t *testing.T) {
+	for errorPage, expected := range map[*TenantErrorPage]string{
+		TenantErrorPageRedirect("https://example.com/error"): `{"html":"","show_log_link":false,"url":"https://example.com/error"}`,
+		TenantErrorPageHTML("<p>{{error}}</p>"):              `{"html":"<p>{{error}}</p>","show_log_link":false,"url":""}`,
+		TenantErrorPageDefault(true):                         `{"html":"","show_log_link":true,"url":""}`,
+	} {
+		payload, err := json.Marshal(errorPage)
+		require.NoError(t, err)
+		assert.JSONEq(t, expected, string(payload))
+	}
+}
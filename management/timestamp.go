@@ -0,0 +1,100 @@
+package management
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// timestampLayouts holds the layouts, other than Unix epochs, in which the
+// Management API returns timestamps.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+	"20060102",
+}
+
+// epochMillisThreshold is the smallest absolute Unix epoch that is treated as
+// being expressed in milliseconds rather than in seconds. In seconds, it
+// would represent a date in the year 5138.
+const epochMillisThreshold = 1e11
+
+// Timestamp represents a time that can be decoded from any of the formats used
+// by the Management API, which include RFC 3339 strings, date-only strings such
+// as "2006-01-02" or "20060102", and Unix epochs expressed either in seconds
+// or milliseconds, as a JSON number or string.
+//
+// Timestamps are always encoded as RFC 3339 strings. The methods of time.Time,
+// such as Equal, Before or After, are promoted, and take a time.Time:
+//
+//	u.GetCreatedAt().Equal(other.GetCreatedAt().Time)
+type Timestamp struct {
+	time.Time
+}
+
+// String returns the time formatted using the format string
+// "2006-01-02 15:04:05.999999999 -0700 MST".
+func (t Timestamp) String() string {
+	return t.Time.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return t.Time.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *Timestamp) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+
+	if len(b) > 0 && b[0] != '"' {
+		return t.parseEpoch(string(b))
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	for _, layout := range timestampLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+
+	if err := t.parseEpoch(s); err != nil {
+		return fmt.Errorf("unsupported timestamp format: %q", s)
+	}
+
+	return nil
+}
+
+func (t *Timestamp) parseEpoch(s string) error {
+	epoch, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		f, floatErr := strconv.ParseFloat(s, 64)
+		if floatErr != nil {
+			return fmt.Errorf("unsupported timestamp format: %s", s)
+		}
+		epoch = int64(f)
+	}
+
+	if epoch >= epochMillisThreshold || epoch <= -epochMillisThreshold {
+		t.Time = time.UnixMilli(epoch).UTC()
+	} else {
+		t.Time = time.Unix(epoch, 0).UTC()
+	}
+
+	return nil
+}
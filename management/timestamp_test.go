@@ -0,0 +1,118 @@
+package management
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestamp_UnmarshalJSON(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		given    string
+		expected time.Time
+	}{
+		{
+			name:     "RFC 3339",
+			given:    `"2023-05-01T10:20:30Z"`,
+			expected: time.Date(2023, 5, 1, 10, 20, 30, 0, time.UTC),
+		},
+		{
+			name:     "RFC 3339 with milliseconds",
+			given:    `"2023-05-01T10:20:30.123Z"`,
+			expected: time.Date(2023, 5, 1, 10, 20, 30, 123000000, time.UTC),
+		},
+		{
+			name:     "RFC 3339 with offset",
+			given:    `"2023-05-01T12:20:30+02:00"`,
+			expected: time.Date(2023, 5, 1, 10, 20, 30, 0, time.UTC),
+		},
+		{
+			name:     "without time zone",
+			given:    `"2023-05-01T10:20:30"`,
+			expected: time.Date(2023, 5, 1, 10, 20, 30, 0, time.UTC),
+		},
+		{
+			name:     "date only",
+			given:    `"2023-05-01"`,
+			expected: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "compact date",
+			given:    `"20230501"`,
+			expected: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "epoch milliseconds",
+			given:    `1682936430123`,
+			expected: time.Date(2023, 5, 1, 10, 20, 30, 123000000, time.UTC),
+		},
+		{
+			name:     "epoch milliseconds as a string",
+			given:    `"1682936430123"`,
+			expected: time.Date(2023, 5, 1, 10, 20, 30, 123000000, time.UTC),
+		},
+		{
+			name:     "epoch seconds",
+			given:    `1682936430`,
+			expected: time.Date(2023, 5, 1, 10, 20, 30, 0, time.UTC),
+		},
+		{
+			name:     "empty string",
+			given:    `""`,
+			expected: time.Time{},
+		},
+		{
+			name:     "null",
+			given:    `null`,
+			expected: time.Time{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var ts Timestamp
+			err := json.Unmarshal([]byte(testCase.given), &ts)
+			require.NoError(t, err)
+			assert.True(t, testCase.expected.Equal(ts.Time), "expected %s, got %s", testCase.expected, ts)
+		})
+	}
+}
+
+func TestTimestamp_UnmarshalJSONInvalid(t *testing.T) {
+	for _, given := range []string{`"yesterday"`, `true`, `{}`} {
+		var ts Timestamp
+		err := json.Unmarshal([]byte(given), &ts)
+		assert.Error(t, err, given)
+	}
+}
+
+func TestTimestamp_MarshalJSON(t *testing.T) {
+	ts := Timestamp{time.Date(2023, 5, 1, 10, 20, 30, 123000000, time.UTC)}
+
+	b, err := json.Marshal(ts)
+	require.NoError(t, err)
+	assert.Equal(t, `"2023-05-01T10:20:30.123Z"`, string(b))
+
+	b, err = json.Marshal(&DailyStat{Date: &ts})
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"date":"2023-05-01T10:20:30.123Z"`)
+}
+
+func TestDailyStat_UnmarshalMixedTimestamps(t *testing.T) {
+	var ds DailyStat
+	err := json.Unmarshal([]byte(`{
+		"date": "2023-05-01",
+		"logins": 10,
+		"created_at": 1682936430123,
+		"updated_at": "2023-05-01T10:20:30.123Z"
+	}`), &ds)
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), ds.GetDate().Time)
+	assert.True(t, ds.GetCreatedAt().Equal(ds.GetUpdatedAt().Time))
+	assert.Equal(t, 10, ds.GetLogins())
+}
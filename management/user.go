@@ -55,21 +55,22 @@ type User struct {
 	PhoneNumber *string `json:"phone_number,omitempty"`
 
 	// The time the user was created.
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
 
 	// The last time the user was updated.
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
 
 	// The last time the user has logged in.
-	LastLogin *time.Time `json:"last_login,omitempty"`
+	LastLogin *Timestamp `json:"last_login,omitempty"`
 
 	// The last time the user had their password reset.
 	// Only available for Database connection users.
-	LastPasswordReset *time.Time `json:"last_password_reset,omitempty"`
+	LastPasswordReset *Timestamp `json:"last_password_reset,omitempty"`
 
 	// UserMetadata holds data that the user has read/write access to.
-	// For example color_preference, blog_url, etc.
-	UserMetadata *map[string]interface{} `json:"user_metadata,omitempty"`
+	// For example color_preference, blog_url, etc. Keys set to nil are
+	// removed, and the whole metadata is cleared when set to Null.
+	UserMetadata *Nullable[map[string]interface{}] `json:"user_metadata,omitempty"`
 
 	// Identities is a list of user identities for when accounts are linked.
 	Identities []*UserIdentity `json:"identities,omitempty"`
@@ -97,8 +98,9 @@ type User struct {
 	// For RBAC, see the functions User.Roles, User.AssignRoles, and User.RemoveRoles.
 	AppMetadata *map[string]interface{} `json:"app_metadata,omitempty"`
 
-	// The user's picture url.
-	Picture *string `json:"picture,omitempty"`
+	// The user's picture url, which is reset to the default picture when set
+	// to Null.
+	Picture *Nullable[string] `json:"picture,omitempty"`
 
 	// A URL provided by the user in association with their profile.
 	URL *string `json:"url,omitempty"`
@@ -117,6 +119,11 @@ type User struct {
 
 	// Auth0 client ID. Only valid when updating email address.
 	ClientID *string `json:"client_id,omitempty"`
+
+	// Extras holds the fields returned by the Management API that this SDK
+	// doesn't support yet, so that they are preserved when the user is sent
+	// back to Auth0. Fields unknown to the API should not be added here.
+	Extras map[string]json.RawMessage `json:"-"`
 }
 
 // UnmarshalJSON is a custom deserializer for the User type.
@@ -136,6 +143,11 @@ func (u *User) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
+	u.Extras, err = unmarshalExtras(b, alias, "blocked_for", "guardian_authenticators", "multifactor_last_modified")
+	if err != nil {
+		return err
+	}
+
 	if alias.RawEmailVerified != nil {
 		var emailVerified bool
 		switch rawEmailVerified := alias.RawEmailVerified.(type) {
@@ -168,7 +180,28 @@ func (u *User) MarshalJSON() ([]byte, error) {
 		alias.RawEmailVerified = u.EmailVerified
 	}
 
-	return json.Marshal(alias)
+	return marshalWithExtras(alias, u.Extras)
+}
+
+// GetUserMetadata returns the UserMetadata map if it's set, an empty map
+// otherwise.
+func (u *User) GetUserMetadata() map[string]interface{} {
+	if u == nil {
+		return map[string]interface{}{}
+	}
+	if metadata, ok := u.UserMetadata.Get(); ok && metadata != nil {
+		return metadata
+	}
+	return map[string]interface{}{}
+}
+
+// GetPicture returns the Picture field if it's set, zero value otherwise.
+func (u *User) GetPicture() string {
+	if u == nil {
+		return ""
+	}
+	picture, _ := u.Picture.Get()
+	return picture
 }
 
 // UserIdentityLink contains the data needed for linking an identity to a given user.
@@ -273,7 +306,7 @@ type UserEnrollment struct {
 	AuthMethod *string `json:"auth_method,omitempty"`
 
 	// Start date and time of this enrollment.
-	EnrolledAt *time.Time `json:"enrolled_at,omitempty"`
+	EnrolledAt *Timestamp `json:"enrolled_at,omitempty"`
 
 	// ID of this enrollment.
 	ID *string `json:"id,omitempty"`
@@ -282,7 +315,7 @@ type UserEnrollment struct {
 	Identifier *string `json:"identifier,omitempty"`
 
 	// Last authentication date and time of this enrollment.
-	LastAuth *time.Time `json:"last_auth,omitempty"`
+	LastAuth *Timestamp `json:"last_auth,omitempty"`
 
 	// Name of enrollment (usually phone number).
 	Name *string `json:"name,omitempty"`
@@ -337,13 +370,13 @@ type AuthenticationMethod struct {
 	PublicKey *string `json:"public_key,omitempty"`
 
 	// Authenticator creation date.
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
 
 	// Enrollment date.
-	EnrolledAt *time.Time `json:"enrolled_at,omitempty"`
+	EnrolledAt *Timestamp `json:"enrolled_at,omitempty"`
 
 	// Last authentication.
-	LastAuthedAt *time.Time `json:"last_auth_at,omitempty"`
+	LastAuthedAt *Timestamp `json:"last_auth_at,omitempty"`
 
 	// Base32 encoded secret for TOTP generation.
 	TOTPSecret *string `json:"totp_secret,omitempty"`
@@ -428,6 +461,30 @@ func (m *UserManager) Update(id string, u *User, opts ...RequestOption) (err err
 	return m.Request("PATCH", m.URI("users", id), u, opts...)
 }
 
+// Patch updates the fields of the user set on the patch, which unlike Update
+// can set fields to their zero value or clear them with null, and returns the
+// updated user.
+func (m *UserManager) Patch(id string, p *UserPatch, opts ...RequestOption) (*User, error) {
+	return patchResource[User](m.Management, m.URI("users", id), p, opts...)
+}
+
+// UpdateWithRetry reads the user, applies mutate to it and updates the fields
+// mutate changed. When the update conflicts with a concurrent change, with a
+// 409 or 412 error, the user is read again and mutate applied again, up to 5
+// times, so that concurrent changes aren't overwritten. It returns the updated
+// user.
+//
+// Fields cleared by mutate are left out of the update.
+//
+// The options are applied to every request made.
+func (m *UserManager) UpdateWithRetry(id string, mutate func(u *User) error, opts ...RequestOption) (*User, error) {
+	return updateWithRetry(m.Management, m.URI("users", id),
+		func() (*User, error) { return m.Read(id, opts...) },
+		mutate,
+		opts...,
+	)
+}
+
 // Delete a single user based on its id.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/delete_users_by_id
@@ -439,7 +496,7 @@ func (m *UserManager) Delete(id string, opts ...RequestOption) (err error) {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/get_users
 func (m *UserManager) List(opts ...RequestOption) (ul *UserList, err error) {
-	err = m.Request("GET", m.URI("users"), &ul, applyListDefaults(opts))
+	ul, err = listPage[*UserList](m.Management, m.URI("users"), applyListDefaults(opts))
 	return
 }
 
@@ -448,6 +505,107 @@ func (m *UserManager) Search(opts ...RequestOption) (ul *UserList, err error) {
 	return m.List(opts...)
 }
 
+// UserDeletion reports the progress of UserManager.DeleteByQuery.
+type UserDeletion struct {
+	// ID of the user that was just deleted.
+	UserID string
+
+	// Number of users deleted so far.
+	Deleted int
+}
+
+// DefaultUserDeletionPollInterval is the time DeleteByQuery waits before
+// searching users again when the search only returns users it already
+// deleted, which the search index keeps returning for a short while.
+const DefaultUserDeletionPollInterval = time.Second
+
+// DeleteByQuery deletes every user matching the query, calling progress, if
+// not nil, as users are deleted. It returns the number of users deleted,
+// which is set even when an error is returned.
+//
+// The search of users doesn't support checkpoint pagination and only reaches
+// the first 1000 results of a query, so DeleteByQuery deletes the users found
+// by a search and searches again, until the search returns no users at all.
+// As the search index is updated shortly after users are deleted, rather than
+// at once, users which were deleted already are skipped. When a search only
+// returns such users, DeleteByQuery waits for DefaultUserDeletionPollInterval
+// before searching again, or until the context of the options is done.
+//
+// The Management API deletes users one at a time, so a request is made for
+// each user. Requests are sent one at a time, or up to the number of requests
+// configured with WithMaxConcurrentRequests at the same time, and the client
+// waits for the rate limit to reset when it is reached.
+//
+// The options are applied to every request made.
+//
+// See: https://auth0.com/docs/users/search/v3/query-syntax
+func (m *UserManager) DeleteByQuery(query string, progress func(UserDeletion), opts ...RequestOption) (int, error) {
+	ctx := requestContext(opts)
+	deleted := make(map[string]bool)
+
+	for {
+		pending, found, err := m.searchPending(query, deleted, opts...)
+		if err != nil {
+			return len(deleted), err
+		}
+		if !found {
+			return len(deleted), nil
+		}
+
+		if len(pending) == 0 {
+			select {
+			case <-ctx.Done():
+				return len(deleted), ctx.Err()
+			case <-m.clock.After(DefaultUserDeletionPollInterval):
+			}
+			continue
+		}
+
+		reported := 0
+		_, err = runBatches(m.maxConcurrency, len(pending), 1, func(start, _ int) error {
+			return m.Delete(pending[start], opts...)
+		}, func(done int) {
+			for ; reported < done; reported++ {
+				deleted[pending[reported]] = true
+				if progress != nil {
+					progress(UserDeletion{UserID: pending[reported], Deleted: len(deleted)})
+				}
+			}
+		})
+		if err != nil {
+			return len(deleted), err
+		}
+	}
+}
+
+// searchPending goes through the pages of users matching the query until it
+// finds a page with users that weren't deleted yet, as the search index may
+// still return users shortly after they are deleted, or until it reaches the
+// first 1000 results, which are the only ones a search can reach. It also
+// reports whether the search returned any user at all.
+func (m *UserManager) searchPending(query string, deleted map[string]bool, opts ...RequestOption) ([]string, bool, error) {
+	searchOpts := withOptions(opts, Query(query), IncludeFields("user_id"), PerPage(100))
+	found := false
+	for page := 0; page*100 < 1000; page++ {
+		l, err := m.Search(withPage(searchOpts, page)...)
+		if err != nil {
+			return nil, found, err
+		}
+		found = found || len(l.Users) > 0
+
+		var pending []string
+		for _, u := range l.Users {
+			if !deleted[u.GetID()] {
+				pending = append(pending, u.GetID())
+			}
+		}
+		if len(pending) > 0 || !l.HasNext() || len(l.Users) == 0 {
+			return pending, found, nil
+		}
+	}
+	return nil, found, nil
+}
+
 // ListByEmail retrieves all users matching a given email.
 //
 // If Auth0 is the identify provider (idP), the email address associated with a
@@ -472,7 +630,7 @@ func (m *UserManager) ListByEmail(email string, opts ...RequestOption) (us []*Us
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/get_user_roles
 func (m *UserManager) Roles(id string, opts ...RequestOption) (r *RoleList, err error) {
-	err = m.Request("GET", m.URI("users", id, "roles"), &r, applyListDefaults(opts))
+	r, err = listPage[*RoleList](m.Management, m.URI("users", id, "roles"), applyListDefaults(opts))
 	return
 }
 
@@ -504,10 +662,68 @@ func (m *UserManager) RemoveRoles(id string, roles []*Role, opts ...RequestOptio
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/get_permissions
 func (m *UserManager) Permissions(id string, opts ...RequestOption) (p *PermissionList, err error) {
-	err = m.Request("GET", m.URI("users", id, "permissions"), &p, applyListDefaults(opts))
+	p, err = listPage[*PermissionList](m.Management, m.URI("users", id, "permissions"), applyListDefaults(opts))
 	return
 }
 
+// AllPermissions retrieves every permission of the user, whether it's
+// assigned directly to the user or granted by one of the user's roles. Each
+// permission is only returned once, the first time it's found, starting with
+// the ones assigned directly.
+//
+// The options are applied to every request made.
+func (m *UserManager) AllPermissions(id string, opts ...RequestOption) ([]*Permission, error) {
+	direct, err := listAll(m.Management, func(opts ...RequestOption) ([]*Permission, List, error) {
+		l, err := m.Permissions(id, opts...)
+		if err != nil {
+			return nil, List{}, err
+		}
+		return l.Permissions, l.List, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := listAll(m.Management, func(opts ...RequestOption) ([]*Role, List, error) {
+		l, err := m.Roles(id, opts...)
+		if err != nil {
+			return nil, List{}, err
+		}
+		return l.Roles, l.List, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var all []*Permission
+	add := func(permissions []*Permission) {
+		for _, p := range permissions {
+			if key := permissionKey(p); !seen[key] {
+				seen[key] = true
+				all = append(all, p)
+			}
+		}
+	}
+	add(direct)
+
+	for _, r := range roles {
+		granted, err := listAll(m.Management, func(opts ...RequestOption) ([]*Permission, List, error) {
+			l, err := m.Role.Permissions(r.GetID(), opts...)
+			if err != nil {
+				return nil, List{}, err
+			}
+			return l.Permissions, l.List, nil
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		add(granted)
+	}
+
+	return all, nil
+}
+
 // AssignPermissions assigns permissions to the user.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/post_permissions
@@ -645,15 +861,31 @@ func (m *UserManager) Unlink(id, provider, userID string, opts ...RequestOption)
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/get_organizations
 func (m *UserManager) Organizations(id string, opts ...RequestOption) (p *OrganizationList, err error) {
-	err = m.Request("GET", m.URI("users", id, "organizations"), &p, applyListDefaults(opts))
+	p, err = listPage[*OrganizationList](m.Management, m.URI("users", id, "organizations"), applyListDefaults(opts))
 	return
 }
 
+// AllOrganizations retrieves all of the user's organizations by going through
+// every page of results.
+//
+// Pages are retrieved concurrently when WithListConcurrency is used.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Users/get_organizations
+func (m *UserManager) AllOrganizations(id string, opts ...RequestOption) ([]*Organization, error) {
+	return listAll(m.Management, func(opts ...RequestOption) ([]*Organization, List, error) {
+		l, err := m.Organizations(id, opts...)
+		if err != nil {
+			return nil, List{}, err
+		}
+		return l.Organizations, l.List, nil
+	}, opts...)
+}
+
 // ListAuthenticationMethods retrieves a list of authentication methods.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/get_authentication_methods
 func (m *UserManager) ListAuthenticationMethods(userID string, opts ...RequestOption) (a *AuthenticationMethodList, err error) {
-	err = m.Request("GET", m.URI("users", userID, "authentication-methods"), &a, applyListDefaults(opts))
+	a, err = listPage[*AuthenticationMethodList](m.Management, m.URI("users", userID, "authentication-methods"), applyListDefaults(opts))
 	return
 }
 
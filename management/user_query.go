@@ -0,0 +1,172 @@
+package management
+
+import (
+	"strings"
+)
+
+// UserQuery is a Lucene query searching users, built from the clauses
+// returned by helpers such as ByConnection, ByAppMetadata or ByRange and
+// combined with And, Or and Not.
+//
+// For example:
+//
+//	q := ByConnection("google-oauth2").And(ByAppMetadata("plan", "enterprise"))
+//	List(Search(q))
+//
+// Values are quoted and field names escaped, so that they are matched
+// literally. Empty queries are ignored when combining queries, so that a query
+// can be built up from the zero UserQuery.
+//
+// See: https://auth0.com/docs/manage-users/user-search/user-search-query-syntax
+type UserQuery string
+
+// ByField returns a query matching the users whose field, such as "email" or
+// "identities.connection", is exactly value.
+func ByField(field, value string) UserQuery {
+	return UserQuery(escapeQueryField(field) + ":" + quoteQueryValue(value))
+}
+
+// ByEmail returns a query matching the users with the given email.
+func ByEmail(email string) UserQuery {
+	return ByField("email", email)
+}
+
+// ByConnection returns a query matching the users with an identity from the
+// connection with the given name.
+func ByConnection(name string) UserQuery {
+	return ByField("identities.connection", name)
+}
+
+// ByProvider returns a query matching the users with an identity from the
+// given provider, e.g. "auth0", "google-oauth2" or "samlp".
+func ByProvider(provider string) UserQuery {
+	return ByField("identities.provider", provider)
+}
+
+// ByAppMetadata returns a query matching the users whose app_metadata holds
+// value under key. Nested keys are separated with dots.
+func ByAppMetadata(key, value string) UserQuery {
+	return ByField("app_metadata."+key, value)
+}
+
+// ByUserMetadata returns a query matching the users whose user_metadata holds
+// value under key. Nested keys are separated with dots.
+func ByUserMetadata(key, value string) UserQuery {
+	return ByField("user_metadata."+key, value)
+}
+
+// ByRange returns a query matching the users whose field is between min and
+// max included, such as dates for "created_at" or "last_login", or numbers
+// for "logins_count". An empty bound leaves the range open on that side.
+//
+//	ByRange("last_login", "2023-01-01", "")
+func ByRange(field, min, max string) UserQuery {
+	return UserQuery(escapeQueryField(field) + ":[" + rangeBound(min) + " TO " + rangeBound(max) + "]")
+}
+
+// ByExists returns a query matching the users who have a value for field,
+// such as "app_metadata.plan".
+func ByExists(field string) UserQuery {
+	return UserQuery("_exists_:" + escapeQueryField(field))
+}
+
+// Search configures a request to list the users matched by the query.
+//
+//	m.User.List(Search(ByConnection("corp").And(ByRange("logins_count", "10", ""))))
+func Search(q UserQuery) RequestOption {
+	return Query(q.String())
+}
+
+// And returns a query matching the users matched by q and by all others.
+func (q UserQuery) And(others ...UserQuery) UserQuery {
+	return q.join("AND", others)
+}
+
+// Or returns a query matching the users matched by q or by any of others.
+func (q UserQuery) Or(others ...UserQuery) UserQuery {
+	return q.join("OR", others)
+}
+
+// Not returns a query matching the users not matched by q.
+func (q UserQuery) Not() UserQuery {
+	return UserQuery("NOT " + q.group())
+}
+
+// String returns the query to search users with.
+func (q UserQuery) String() string {
+	return string(q)
+}
+
+// join combines q and others with operator, skipping the empty queries.
+func (q UserQuery) join(operator string, others []UserQuery) UserQuery {
+	var queries []UserQuery
+	for _, c := range append([]UserQuery{q}, others...) {
+		if c != "" {
+			queries = append(queries, c)
+		}
+	}
+	if len(queries) == 1 {
+		return queries[0]
+	}
+
+	clauses := make([]string, len(queries))
+	for i, c := range queries {
+		clauses[i] = c.group()
+	}
+	return UserQuery(strings.Join(clauses, " "+operator+" "))
+}
+
+// group wraps the query in parentheses if it's made of several terms, so that
+// it can be combined with other clauses.
+func (q UserQuery) group() string {
+	quoted, escaped, ranged := false, false, false
+	for _, r := range string(q) {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+		case r == '[':
+			ranged = true
+		case r == ']':
+			ranged = false
+		case r == ' ' && !ranged:
+			return "(" + string(q) + ")"
+		}
+	}
+	return string(q)
+}
+
+// queryReservedCharacters are the characters with a special meaning in a
+// Lucene query, which must be escaped to be matched literally.
+const queryReservedCharacters = `+-&|!(){}[]^"~*?:\/ `
+
+// escapeQueryField escapes the reserved characters of a field name, except the
+// dots separating nested fields.
+func escapeQueryField(field string) string {
+	var b strings.Builder
+	for _, r := range field {
+		if strings.ContainsRune(queryReservedCharacters, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// rangeBound returns the quoted bound of a range, or the wildcard if empty.
+func rangeBound(bound string) string {
+	if bound == "" {
+		return "*"
+	}
+	return quoteQueryValue(bound)
+}
+
+// quoteQueryValue quotes value as a phrase, escaping the quotes and
+// backslashes it contains.
+func quoteQueryValue(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
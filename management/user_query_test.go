@@ -0,0 +1,80 @@
+package management
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserQuery(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		query    UserQuery
+		expected string
+	}{
+		{
+			"connection",
+			ByConnection("Username-Password-Authentication"),
+			`identities.connection:"Username-Password-Authentication"`,
+		},
+		{
+			"provider",
+			ByProvider("google-oauth2"),
+			`identities.provider:"google-oauth2"`,
+		},
+		{
+			"escaped value",
+			ByEmail(`"quoted"\name@example.com`),
+			`email:"\"quoted\"\\name@example.com"`,
+		},
+		{
+			"escaped metadata key",
+			ByAppMetadata("billing.plan-tier", "pro plan"),
+			`app_metadata.billing.plan\-tier:"pro plan"`,
+		},
+		{
+			"and",
+			ByConnection("corp").And(ByUserMetadata("lang", "en")),
+			`identities.connection:"corp" AND user_metadata.lang:"en"`,
+		},
+		{
+			"nested",
+			ByConnection("corp").Or(ByProvider("samlp")).And(ByAppMetadata("role", "admin").Not()),
+			`(identities.connection:"corp" OR identities.provider:"samlp") AND (NOT app_metadata.role:"admin")`,
+		},
+		{
+			"range",
+			ByRange("logins_count", "10", ""),
+			`logins_count:["10" TO *]`,
+		},
+		{
+			"combined range",
+			ByRange("last_login", "2023-01-01", "2023-12-31").And(ByExists("app_metadata.plan")).Not(),
+			`NOT (last_login:["2023-01-01" TO "2023-12-31"] AND _exists_:app_metadata.plan)`,
+		},
+		{
+			"built from empty",
+			UserQuery("").And(ByEmail("a b@example.com")).Or(),
+			`email:"a b@example.com"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.query.String())
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "v3", r.URL.Query().Get("search_engine"))
+		assert.Equal(t, `email:"a+b@example.com" AND logins_count:["10" TO *]`, r.URL.Query().Get("q"))
+		w.Write([]byte(`{"users":[{"user_id":"auth0|123"}]}`))
+	})
+	m := newTestManagement(t, h)
+
+	users, err := m.User.List(Search(ByEmail("a+b@example.com").And(ByRange("logins_count", "10", ""))))
+	require.NoError(t, err)
+	assert.Equal(t, "auth0|123", users.Users[0].GetID())
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -446,11 +447,11 @@ func givenAUser(t *testing.T) *User {
 		GivenName:     auth0.String("Chuck"),
 		FamilyName:    auth0.String("Sanchez"),
 		Nickname:      auth0.String("Chucky"),
-		UserMetadata:  &userMetadata,
+		UserMetadata:  NullableValue(userMetadata),
 		EmailVerified: auth0.Bool(true),
 		VerifyEmail:   auth0.Bool(false),
 		AppMetadata:   &appMetadata,
-		Picture:       auth0.String("https://example-picture-url.jpg"),
+		Picture:       NullableValue("https://example-picture-url.jpg"),
 		Blocked:       auth0.Bool(false),
 	}
 
@@ -470,3 +471,138 @@ func cleanupUser(t *testing.T, userID string) {
 	err := api.User.Delete(userID)
 	require.NoError(t, err)
 }
+
+func TestUserManager_DeleteByQuery(t *testing.T) {
+	users := []string{"auth0|1", "auth0|2", "auth0|3"}
+	// The search index keeps returning deleted users until a search is
+	// started without any user being deleted since the previous one.
+	var deletedUsers []string
+	deletedSinceSearch := false
+	searches := 0
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "email:*@test.example.com", r.URL.Query().Get("q"))
+			assert.Equal(t, "user_id", r.URL.Query().Get("fields"))
+
+			if r.URL.Query().Get("page") == "0" {
+				searches++
+				if !deletedSinceSearch {
+					deletedUsers = nil
+				}
+				deletedSinceSearch = false
+			}
+
+			results := append(append([]string{}, deletedUsers...), users...)
+			total := len(results)
+
+			// Only return two results per page.
+			page, err := strconv.Atoi(r.URL.Query().Get("page"))
+			require.NoError(t, err)
+			if page*2 >= total {
+				results = nil
+			} else if page*2+2 < total {
+				results = results[page*2 : page*2+2]
+			} else {
+				results = results[page*2:]
+			}
+
+			l := UserList{List: List{Start: page * 2, Limit: 2, Total: total}}
+			for _, id := range results {
+				l.Users = append(l.Users, &User{ID: auth0.String(id)})
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(l))
+		case http.MethodDelete:
+			id := strings.TrimPrefix(r.URL.Path, "/api/v2/users/")
+			for i, u := range users {
+				if u == id {
+					users = append(users[:i], users[i+1:]...)
+					deletedUsers = append(deletedUsers, id)
+					deletedSinceSearch = true
+					break
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	m := newTestManagement(t, h, WithClock(immediateClock{}))
+
+	var progress []UserDeletion
+	deleted, err := m.User.DeleteByQuery("email:*@test.example.com", func(d UserDeletion) {
+		progress = append(progress, d)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, deleted)
+	assert.Empty(t, users)
+	// Searches go on until the index caught up and no user is returned.
+	assert.Equal(t, 4, searches)
+	assert.Empty(t, deletedUsers)
+	assert.Equal(t, []UserDeletion{
+		{UserID: "auth0|1", Deleted: 1},
+		{UserID: "auth0|2", Deleted: 2},
+		{UserID: "auth0|3", Deleted: 3},
+	}, progress)
+}
+
+func TestUserManager_AllOrganizations(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/users/auth0|1/organizations", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("per_page"))
+
+		switch r.URL.Query().Get("page") {
+		case "0":
+			w.Write([]byte(`{"start":0,"limit":2,"total":3,"organizations":[{"id":"org_1"},{"id":"org_2"}]}`))
+		case "1":
+			w.Write([]byte(`{"start":2,"limit":2,"total":3,"organizations":[{"id":"org_3"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	organizations, err := m.User.AllOrganizations("auth0|1", PerPage(2))
+	require.NoError(t, err)
+	require.Len(t, organizations, 3)
+	assert.Equal(t, "org_1", organizations[0].GetID())
+	assert.Equal(t, "org_3", organizations[2].GetID())
+}
+
+func TestUserManager_AllPermissions(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/users/auth0|1/permissions":
+			w.Write([]byte(`{"start":0,"limit":50,"total":1,"permissions":[
+				{"resource_server_identifier":"https://api","permission_name":"read:things"}
+			]}`))
+		case "/api/v2/users/auth0|1/roles":
+			w.Write([]byte(`{"start":0,"limit":50,"total":2,"roles":[{"id":"rol_1"},{"id":"rol_2"}]}`))
+		case "/api/v2/roles/rol_1/permissions":
+			w.Write([]byte(`{"start":0,"limit":50,"total":2,"permissions":[
+				{"resource_server_identifier":"https://api","permission_name":"read:things"},
+				{"resource_server_identifier":"https://api","permission_name":"write:things"}
+			]}`))
+		case "/api/v2/roles/rol_2/permissions":
+			w.Write([]byte(`{"start":0,"limit":50,"total":2,"permissions":[
+				{"resource_server_identifier":"https://api","permission_name":"write:things"},
+				{"resource_server_identifier":"https://other","permission_name":"read:things"}
+			]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	m := newTestManagement(t, h)
+
+	permissions, err := m.User.AllPermissions("auth0|1")
+	require.NoError(t, err)
+
+	var keys []string
+	for _, p := range permissions {
+		keys = append(keys, p.GetResourceServerIdentifier()+" "+p.GetName())
+	}
+	assert.Equal(t, []string{
+		"https://api read:things",
+		"https://api write:things",
+		"https://other read:things",
+	}, keys)
+}
@@ -0,0 +1,147 @@
+package management
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validator is implemented by resources that can be validated client-side
+// before being sent to the Management API.
+//
+// When the Management client is configured using WithValidation, payloads
+// implementing this interface are validated before every request that creates
+// or updates a resource.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError is returned when a resource fails the client-side
+// validation performed before it is sent to the Management API.
+type ValidationError struct {
+	// The JSON name of the invalid field, e.g. "refresh_token.rotation_type".
+	Field string `json:"field"`
+
+	// The reason why the field is invalid.
+	Message string `json:"message"`
+}
+
+// Error formats the error into a string representation.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors holds every ValidationError found while validating a
+// resource.
+type ValidationErrors []*ValidationError
+
+// Error formats the errors into a string representation.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// validator accumulates validation errors for a single resource.
+type validator struct {
+	errors ValidationErrors
+}
+
+func (v *validator) addError(field, format string, args ...interface{}) {
+	v.errors = append(v.errors, &ValidationError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// oneOf checks that value, if set, is one of the allowed values.
+func (v *validator) oneOf(field string, value *string, allowed []string) {
+	if value == nil {
+		return
+	}
+	v.checkOneOf(field, *value, allowed)
+}
+
+// eachOneOf checks that every item of values, if set, is one of the allowed
+// values.
+func (v *validator) eachOneOf(field string, values *[]string, allowed []string) {
+	if values == nil {
+		return
+	}
+	for _, value := range *values {
+		v.checkOneOf(field, value, allowed)
+	}
+}
+
+func (v *validator) checkOneOf(field, value string, allowed []string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.addError(field, "%q is not one of %q", value, allowed)
+}
+
+// maxLength checks that value, if set, is at most max characters long.
+func (v *validator) maxLength(field string, value *string, max int) {
+	if value == nil {
+		return
+	}
+	if length := utf8.RuneCountInString(*value); length > max {
+		v.addError(field, "is %d characters long, at most %d are allowed", length, max)
+	}
+}
+
+// httpsURL checks that value, if set, is an absolute https URL without a
+// fragment.
+func (v *validator) httpsURL(field string, value *string) {
+	if value == nil || *value == "" {
+		return
+	}
+	u, err := url.Parse(*value)
+	switch {
+	case err != nil:
+		v.addError(field, "%q is not a valid URL", *value)
+	case u.Scheme != "https":
+		v.addError(field, "%q must use the https scheme", *value)
+	case u.Fragment != "" || strings.Contains(*value, "#"):
+		v.addError(field, "%q must not contain a fragment", *value)
+	}
+}
+
+// emailAddress checks that value, if set, is a bare email address, without a
+// display name.
+func (v *validator) emailAddress(field string, value *string) {
+	if value == nil || *value == "" {
+		return
+	}
+	if a, err := mail.ParseAddress(*value); err != nil || a.Address != *value {
+		v.addError(field, "%q is not a valid email address", *value)
+	}
+}
+
+// eachURL checks that every item of values, if set, is an absolute URL.
+// Wildcards are accepted, as they are allowed by some fields.
+func (v *validator) eachURL(field string, values *[]string) {
+	if values == nil {
+		return
+	}
+	for _, value := range *values {
+		u, err := url.Parse(value)
+		if err != nil || u.Scheme == "" || (u.Host == "" && u.Opaque == "" && u.Path == "") {
+			v.addError(field, "%q is not a valid URL", value)
+		}
+	}
+}
+
+// err returns the accumulated errors, or nil if there are none.
+func (v *validator) err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return v.errors
+}
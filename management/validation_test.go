@@ -0,0 +1,177 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/go-auth0"
+)
+
+func TestClient_Validate(t *testing.T) {
+	t.Run("accepts well-known values", func(t *testing.T) {
+		c := &Client{
+			AppType:                 auth0.String(ClientAppTypeSPA),
+			GrantTypes:              &[]string{ClientGrantTypeAuthorizationCode, ClientGrantTypeRefreshToken},
+			TokenEndpointAuthMethod: auth0.String(ClientTokenEndpointAuthMethodNone),
+			JWTConfiguration:        &ClientJWTConfiguration{Algorithm: auth0.String(ClientJWTAlgorithmRS256)},
+			RefreshToken: &ClientRefreshToken{
+				RotationType:   auth0.String(ClientRefreshTokenRotationTypeRotating),
+				ExpirationType: auth0.String(ClientRefreshTokenExpirationTypeExpiring),
+			},
+		}
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("accepts unset values", func(t *testing.T) {
+		assert.NoError(t, (&Client{}).Validate())
+	})
+
+	t.Run("rejects unknown values", func(t *testing.T) {
+		c := &Client{
+			AppType:                 auth0.String("single_page"),
+			GrantTypes:              &[]string{ClientGrantTypeImplicit, "refresh"},
+			TokenEndpointAuthMethod: auth0.String("client_secret"),
+			JWTConfiguration:        &ClientJWTConfiguration{Algorithm: auth0.String("ES256")},
+			RefreshToken: &ClientRefreshToken{
+				RotationType:   auth0.String("roating"),
+				ExpirationType: auth0.String("never"),
+			},
+		}
+
+		err := c.Validate()
+		require.Error(t, err)
+
+		var validationErrors ValidationErrors
+		require.True(t, errors.As(err, &validationErrors))
+
+		var fields []string
+		for _, e := range validationErrors {
+			fields = append(fields, e.Field)
+		}
+		assert.Equal(t, []string{
+			"app_type",
+			"grant_types",
+			"token_endpoint_auth_method",
+			"jwt_configuration.alg",
+			"refresh_token.rotation_type",
+			"refresh_token.expiration_type",
+		}, fields)
+		assert.Contains(t, err.Error(), `invalid refresh_token.rotation_type: "roating" is not one of`)
+	})
+}
+
+func TestClient_ValidateDocumentedConstraints(t *testing.T) {
+	t.Run("accepts valid values", func(t *testing.T) {
+		c := &Client{
+			Description:       auth0.String(strings.Repeat("ü", 140)),
+			ClientMetadata:    &map[string]interface{}{"1": "a", "2": "b", "3": "c"},
+			InitiateLoginURI:  auth0.String("https://example.com/login"),
+			Callbacks:         &[]string{"https://example.com/callback", "https://*.example.com/callback", "com.example.app://callback"},
+			AllowedLogoutURLs: &[]string{"http://localhost:3000"},
+		}
+		assert.NoError(t, c.Validate())
+	})
+
+	var testCases = []struct {
+		name     string
+		given    *Client
+		expected string
+	}{
+		{
+			name:     "description too long",
+			given:    &Client{Description: auth0.String(strings.Repeat("a", 141))},
+			expected: "invalid description: is 141 characters long, at most 140 are allowed",
+		},
+		{
+			name: "too many metadata keys",
+			given: &Client{ClientMetadata: &map[string]interface{}{
+				"1": "", "2": "", "3": "", "4": "", "5": "", "6": "", "7": "", "8": "", "9": "", "10": "", "11": "",
+			}},
+			expected: "invalid client_metadata: has 11 keys, at most 10 are allowed",
+		},
+		{
+			name:     "initiate login uri using http",
+			given:    &Client{InitiateLoginURI: auth0.String("http://example.com/login")},
+			expected: `invalid initiate_login_uri: "http://example.com/login" must use the https scheme`,
+		},
+		{
+			name:     "initiate login uri with a fragment",
+			given:    &Client{InitiateLoginURI: auth0.String("https://example.com/login#foo")},
+			expected: `invalid initiate_login_uri: "https://example.com/login#foo" must not contain a fragment`,
+		},
+		{
+			name:     "invalid callback",
+			given:    &Client{Callbacks: &[]string{"https://example.com", "example.com/callback"}},
+			expected: `invalid callbacks: "example.com/callback" is not a valid URL`,
+		},
+		{
+			name:     "invalid logout url",
+			given:    &Client{AllowedLogoutURLs: &[]string{"https://exa mple.com"}},
+			expected: `invalid allowed_logout_urls: "https://exa mple.com" is not a valid URL`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.EqualError(t, testCase.given.Validate(), testCase.expected)
+		})
+	}
+}
+
+func TestWithValidation(t *testing.T) {
+	requests := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"client_id":"123"}`))
+	})
+	invalidClient := &Client{Description: auth0.String(strings.Repeat("a", 141))}
+
+	t.Run("validates payloads when enabled", func(t *testing.T) {
+		m := newTestManagement(t, h, WithValidation())
+
+		err := m.Client.Create(context.Background(), invalidClient)
+		var validationErrors ValidationErrors
+		assert.True(t, errors.As(err, &validationErrors))
+		assert.Equal(t, 0, requests)
+
+		err = m.Client.Update(context.Background(), "123", &Client{Description: auth0.String("Valid")})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, requests)
+
+		_, err = m.Client.Read(context.Background(), "123")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("does not validate payloads by default", func(t *testing.T) {
+		m := newTestManagement(t, h)
+
+		err := m.Client.Create(context.Background(), invalidClient)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, requests)
+	})
+}
+
+func TestConnection_Validate(t *testing.T) {
+	assert.NoError(t, (&Connection{Strategy: auth0.String(ConnectionStrategySAML)}).Validate())
+	assert.NoError(t, (&Connection{Strategy: auth0.String("yandex")}).Validate())
+	assert.NoError(t, (&Connection{}).Validate())
+
+	err := (&Connection{Strategy: auth0.String("google-oauth")}).Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid strategy: "google-oauth" is not one of`)
+}
+
+func TestValidationErrors_Error(t *testing.T) {
+	err := ValidationErrors{
+		{Field: "name", Message: "is required"},
+		{Field: "description", Message: "is too long"},
+	}
+	assert.Equal(t, "invalid name: is required; invalid description: is too long", err.Error())
+}
@@ -0,0 +1,199 @@
+package tokencache
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// Storage stores the encrypted contents of an Encrypted cache.
+type Storage interface {
+	// Read returns the stored contents, or nil if nothing was stored yet.
+	Read() ([]byte, error)
+
+	// Write replaces the stored contents.
+	Write(data []byte) error
+}
+
+// FileStorage is a Storage keeping the contents in a file, only readable and
+// writable by its owner.
+type FileStorage struct {
+	path string
+}
+
+// NewFileStorage returns a FileStorage keeping the contents in the file at
+// path, which is created when first written along with its directory.
+func NewFileStorage(path string) *FileStorage {
+	return &FileStorage{path: path}
+}
+
+// Read returns the contents of the file, or nil if it doesn't exist.
+func (s *FileStorage) Read() ([]byte, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// Write replaces the contents of the file. The contents are written to a
+// temporary file first, which then replaces the file, so that the file is
+// never partially written.
+func (s *FileStorage) Write(data []byte) error {
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), s.path)
+}
+
+// Encrypted is a Cache keeping the tokens in a Storage, encrypted with
+// AES-GCM so that they can't be read or altered without the key.
+//
+// The tokens are read from the storage on every call, so that tokens cached
+// by other processes sharing the storage are found. Writes aren't coordinated
+// across processes though: when several processes cache a token at the same
+// time, only the token cached last is kept.
+type Encrypted struct {
+	storage Storage
+	aead    cipher.AEAD
+
+	mu sync.Mutex
+}
+
+// NewEncrypted returns an Encrypted cache keeping the tokens in storage,
+// encrypted with key, which must be 16, 24 or 32 bytes long to use AES-128,
+// AES-192 or AES-256.
+func NewEncrypted(storage Storage, key []byte) (*Encrypted, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("tokencache: invalid key: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Encrypted{storage: storage, aead: aead}, nil
+}
+
+// NewEncryptedFile returns an Encrypted cache keeping the tokens in the file
+// at path, encrypted with key.
+//
+// See NewEncrypted and NewFileStorage.
+func NewEncryptedFile(path string, key []byte) (*Encrypted, error) {
+	return NewEncrypted(NewFileStorage(path), key)
+}
+
+// Get returns the token cached under key, or nil if there is none.
+func (c *Encrypted) Get(key string) (*oauth2.Token, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tokens, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens[key], nil
+}
+
+// Set caches the token under key, replacing any token cached under it.
+func (c *Encrypted) Set(key string, token *oauth2.Token) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tokens, err := c.load()
+	if err != nil {
+		return err
+	}
+
+	tokens[key] = token
+
+	return c.save(tokens)
+}
+
+// Delete removes the token cached under key, if any.
+func (c *Encrypted) Delete(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tokens, err := c.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := tokens[key]; !ok {
+		return nil
+	}
+
+	delete(tokens, key)
+
+	return c.save(tokens)
+}
+
+func (c *Encrypted) load() (map[string]*oauth2.Token, error) {
+	data, err := c.storage.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := map[string]*oauth2.Token{}
+	if len(data) == 0 {
+		return tokens, nil
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, errors.New("tokencache: stored tokens are corrupted")
+	}
+
+	plaintext, err := c.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+	if err != nil {
+		return nil, errors.New("tokencache: stored tokens can't be decrypted, they may be corrupted or encrypted with another key")
+	}
+
+	if err := json.Unmarshal(plaintext, &tokens); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+func (c *Encrypted) save(tokens map[string]*oauth2.Token) error {
+	plaintext, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	return c.storage.Write(c.aead.Seal(nonce, nonce, plaintext, nil))
+}
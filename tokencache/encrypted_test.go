@@ -0,0 +1,109 @@
+package tokencache
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+type memoryStorage struct {
+	data []byte
+}
+
+func (s *memoryStorage) Read() ([]byte, error) {
+	return s.data, nil
+}
+
+func (s *memoryStorage) Write(data []byte) error {
+	s.data = data
+	return nil
+}
+
+func TestEncrypted(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, 32)
+	storage := &memoryStorage{}
+
+	c, err := NewEncrypted(storage, key)
+	require.NoError(t, err)
+
+	token, err := c.Get("key")
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	expected := &oauth2.Token{
+		AccessToken: "secret-access-token",
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(time.Hour).Round(time.Second),
+	}
+	require.NoError(t, c.Set("key", expected))
+	require.NoError(t, c.Set("other", &oauth2.Token{AccessToken: "other"}))
+	assert.NotContains(t, string(storage.data), expected.AccessToken)
+
+	t.Run("reads the tokens written by other caches", func(t *testing.T) {
+		other, err := NewEncrypted(storage, key)
+		require.NoError(t, err)
+
+		token, err := other.Get("key")
+		require.NoError(t, err)
+		assert.Equal(t, expected.AccessToken, token.AccessToken)
+		assert.True(t, expected.Expiry.Equal(token.Expiry))
+	})
+
+	t.Run("fails with another key", func(t *testing.T) {
+		other, err := NewEncrypted(storage, bytes.Repeat([]byte{2}, 32))
+		require.NoError(t, err)
+
+		_, err = other.Get("key")
+		assert.Error(t, err)
+	})
+
+	t.Run("deletes tokens", func(t *testing.T) {
+		require.NoError(t, c.Delete("key"))
+		require.NoError(t, c.Delete("missing"))
+
+		token, err := c.Get("key")
+		require.NoError(t, err)
+		assert.Nil(t, token)
+
+		token, err = c.Get("other")
+		require.NoError(t, err)
+		assert.Equal(t, "other", token.AccessToken)
+	})
+}
+
+func TestNewEncrypted_InvalidKey(t *testing.T) {
+	_, err := NewEncrypted(&memoryStorage{}, []byte("short"))
+	assert.Error(t, err)
+}
+
+func TestEncryptedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "auth0", "tokens")
+	key := bytes.Repeat([]byte{1}, 16)
+
+	c, err := NewEncryptedFile(path, key)
+	require.NoError(t, err)
+	require.NoError(t, c.Set("key", &oauth2.Token{AccessToken: "token"}))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+
+	c, err = NewEncryptedFile(path, key)
+	require.NoError(t, err)
+	token, err := c.Get("key")
+	require.NoError(t, err)
+	assert.Equal(t, "token", token.AccessToken)
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
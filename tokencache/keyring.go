@@ -0,0 +1,69 @@
+package tokencache
+
+import (
+	"errors"
+
+	"golang.org/x/oauth2"
+)
+
+// ErrKeyringUnsupported is returned by Keyring when the operating system has
+// no supported keyring.
+var ErrKeyringUnsupported = errors.New("tokencache: no supported keyring on this operating system")
+
+// errKeyringNotFound is returned by the keyring functions when no secret is
+// stored for the service and account.
+var errKeyringNotFound = errors.New("tokencache: secret not found in keyring")
+
+// Keyring is a Cache storing the tokens in the keyring of the operating
+// system, so that they aren't stored in plain text files:
+//
+//   - the Keychain on macOS, using the security command.
+//   - the Credential Manager on Windows, splitting the tokens larger than
+//     the 2560 bytes a credential can hold across several credentials.
+//   - the Secret Service on Linux, such as GNOME Keyring or KWallet, using
+//     the secret-tool command of libsecret.
+//
+// Other operating systems aren't supported, in which case ErrKeyringUnsupported
+// is returned.
+type Keyring struct {
+	service string
+}
+
+// NewKeyring returns a Keyring storing the tokens as secrets of the given
+// service, such as the name of the tool using the SDK, with the keys of the
+// tokens as accounts.
+func NewKeyring(service string) *Keyring {
+	return &Keyring{service: service}
+}
+
+// Get returns the token cached under key, or nil if there is none.
+func (k *Keyring) Get(key string) (*oauth2.Token, error) {
+	secret, err := keyringGet(k.service, key)
+	if errors.Is(err, errKeyringNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeToken(secret)
+}
+
+// Set caches the token under key, replacing any token cached under it.
+func (k *Keyring) Set(key string, token *oauth2.Token) error {
+	secret, err := encodeToken(token)
+	if err != nil {
+		return err
+	}
+
+	return keyringSet(k.service, key, secret)
+}
+
+// Delete removes the token cached under key, if any.
+func (k *Keyring) Delete(key string) error {
+	err := keyringDelete(k.service, key)
+	if errors.Is(err, errKeyringNotFound) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,131 @@
+package tokencache
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// chunksMarker prefixes the secret stored under an account whose secret is
+// split into chunks, followed by the number of chunks.
+const chunksMarker = "tokencache-chunks:"
+
+// chunkedKeyring stores secrets in a keyring limiting the size of secrets,
+// such as the Credential Manager on Windows, by splitting the secrets larger
+// than maxSize into chunks stored under the accounts "account#1",
+// "account#2" and so on. The account itself then holds the number of chunks.
+type chunkedKeyring struct {
+	maxSize int
+	get     func(service, account string) ([]byte, error)
+	set     func(service, account string, secret []byte) error
+	delete  func(service, account string) error
+}
+
+func (k *chunkedKeyring) Get(service, account string) ([]byte, error) {
+	secret, err := k.get(service, account)
+	if err != nil {
+		return nil, err
+	}
+
+	count, chunked, err := chunkCount(secret)
+	if err != nil || !chunked {
+		return secret, err
+	}
+
+	var joined []byte
+	for i := 1; i <= count; i++ {
+		chunk, err := k.get(service, chunkAccount(account, i))
+		if err != nil {
+			return nil, fmt.Errorf("tokencache: failed to read chunk %d of %d: %w", i, count, err)
+		}
+		joined = append(joined, chunk...)
+	}
+
+	return joined, nil
+}
+
+func (k *chunkedKeyring) Set(service, account string, secret []byte) error {
+	previous, err := k.storedChunks(service, account)
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	if len(secret) > k.maxSize {
+		for start := 0; start < len(secret); start += k.maxSize {
+			end := start + k.maxSize
+			if end > len(secret) {
+				end = len(secret)
+			}
+			count++
+			if err := k.set(service, chunkAccount(account, count), secret[start:end]); err != nil {
+				return err
+			}
+		}
+		secret = []byte(chunksMarker + strconv.Itoa(count))
+	}
+
+	if err := k.set(service, account, secret); err != nil {
+		return err
+	}
+
+	return k.deleteChunks(service, account, count+1, previous)
+}
+
+func (k *chunkedKeyring) Delete(service, account string) error {
+	previous, err := k.storedChunks(service, account)
+	if err != nil {
+		return err
+	}
+
+	if err := k.delete(service, account); err != nil {
+		return err
+	}
+
+	return k.deleteChunks(service, account, 1, previous)
+}
+
+// storedChunks returns the number of chunks the secret stored under the
+// account is split into, or 0 if there is none.
+func (k *chunkedKeyring) storedChunks(service, account string) (int, error) {
+	secret, err := k.get(service, account)
+	if errors.Is(err, errKeyringNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	count, _, err := chunkCount(secret)
+	return count, err
+}
+
+// deleteChunks deletes the chunks of the account from first to last.
+func (k *chunkedKeyring) deleteChunks(service, account string, first, last int) error {
+	for i := first; i <= last; i++ {
+		if err := k.delete(service, chunkAccount(account, i)); err != nil && !errors.Is(err, errKeyringNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// chunkCount returns the number of chunks a secret is split into, and whether
+// it's split at all.
+func chunkCount(secret []byte) (int, bool, error) {
+	if !bytes.HasPrefix(secret, []byte(chunksMarker)) {
+		return 0, false, nil
+	}
+
+	count, err := strconv.Atoi(string(secret[len(chunksMarker):]))
+	if err != nil || count < 1 {
+		return 0, true, fmt.Errorf("tokencache: invalid number of chunks %q", secret[len(chunksMarker):])
+	}
+
+	return count, true, nil
+}
+
+func chunkAccount(account string, i int) string {
+	return account + "#" + strconv.Itoa(i)
+}
@@ -0,0 +1,65 @@
+package tokencache
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const securityCommand = "/usr/bin/security"
+
+// securityNotFound is the exit code of the security command when the item
+// can't be found in the keychain.
+const securityNotFound = 44
+
+func keyringGet(service, account string) ([]byte, error) {
+	out, err := exec.Command(securityCommand, "find-generic-password", "-s", service, "-a", account, "-w").Output()
+	if err != nil {
+		return nil, securityError(err)
+	}
+
+	return []byte(strings.TrimSuffix(string(out), "\n")), nil
+}
+
+func keyringSet(service, account string, secret []byte) error {
+	if err := checkSecurityArguments(service, account); err != nil {
+		return err
+	}
+
+	// The secret is passed to the interactive mode of the command, in hex so
+	// that it doesn't need escaping, as arguments are visible to other
+	// processes.
+	cmd := exec.Command(securityCommand, "-i")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf(
+		"add-generic-password -U -s \"%s\" -a \"%s\" -X %s\n",
+		service, account, hex.EncodeToString(secret),
+	))
+
+	return securityError(cmd.Run())
+}
+
+func keyringDelete(service, account string) error {
+	err := exec.Command(securityCommand, "delete-generic-password", "-s", service, "-a", account).Run()
+	return securityError(err)
+}
+
+// checkSecurityArguments returns an error if the arguments can't be quoted
+// for the interactive mode of the security command.
+func checkSecurityArguments(args ...string) error {
+	for _, arg := range args {
+		if strings.ContainsAny(arg, "\"\\\n") {
+			return fmt.Errorf("tokencache: keyring service and key can't contain quotes, backslashes or new lines: %q", arg)
+		}
+	}
+	return nil
+}
+
+func securityError(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == securityNotFound {
+		return errKeyringNotFound
+	}
+	return err
+}
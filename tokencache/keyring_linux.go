@@ -0,0 +1,49 @@
+package tokencache
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+)
+
+const secretToolCommand = "secret-tool"
+
+func keyringGet(service, account string) ([]byte, error) {
+	if _, err := exec.LookPath(secretToolCommand); err != nil {
+		return nil, ErrKeyringUnsupported
+	}
+
+	out, err := exec.Command(secretToolCommand, "lookup", "service", service, "account", account).Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(out) == 0 {
+		// secret-tool exits with an error and no output when no secret
+		// matches the attributes.
+		return nil, errKeyringNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func keyringSet(service, account string, secret []byte) error {
+	if _, err := exec.LookPath(secretToolCommand); err != nil {
+		return ErrKeyringUnsupported
+	}
+
+	// The secret is read from the standard input, as arguments are visible
+	// to other processes.
+	cmd := exec.Command(secretToolCommand, "store", "--label="+service+" "+account, "service", service, "account", account)
+	cmd.Stdin = bytes.NewReader(secret)
+
+	return cmd.Run()
+}
+
+func keyringDelete(service, account string) error {
+	if _, err := exec.LookPath(secretToolCommand); err != nil {
+		return ErrKeyringUnsupported
+	}
+
+	return exec.Command(secretToolCommand, "clear", "service", service, "account", account).Run()
+}
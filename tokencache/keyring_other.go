@@ -0,0 +1,15 @@
+//go:build !darwin && !linux && !windows
+
+package tokencache
+
+func keyringGet(service, account string) ([]byte, error) {
+	return nil, ErrKeyringUnsupported
+}
+
+func keyringSet(service, account string, secret []byte) error {
+	return ErrKeyringUnsupported
+}
+
+func keyringDelete(service, account string) error {
+	return ErrKeyringUnsupported
+}
@@ -0,0 +1,103 @@
+package tokencache
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestKeyring(t *testing.T) {
+	k := NewKeyring("go-auth0-test")
+	key := "https://example.auth0.com|client|https://example.auth0.com/api/v2/"
+
+	token, err := k.Get(key)
+	if errors.Is(err, ErrKeyringUnsupported) {
+		t.Skip("no keyring available")
+	}
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	expected := &oauth2.Token{
+		AccessToken: "token",
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(time.Hour).Round(time.Second),
+	}
+	require.NoError(t, k.Set(key, expected))
+	t.Cleanup(func() {
+		_ = k.Delete(key)
+	})
+
+	token, err = k.Get(key)
+	require.NoError(t, err)
+	assert.Equal(t, expected.AccessToken, token.AccessToken)
+	assert.True(t, expected.Expiry.Equal(token.Expiry))
+
+	require.NoError(t, k.Delete(key))
+	token, err = k.Get(key)
+	require.NoError(t, err)
+	assert.Nil(t, token)
+}
+
+func TestChunkedKeyring(t *testing.T) {
+	stored := map[string][]byte{}
+	k := &chunkedKeyring{
+		maxSize: 2560,
+		get: func(service, account string) ([]byte, error) {
+			secret, ok := stored[service+":"+account]
+			if !ok {
+				return nil, errKeyringNotFound
+			}
+			return secret, nil
+		},
+		set: func(service, account string, secret []byte) error {
+			if len(secret) > 2560 {
+				return errors.New("secret too large")
+			}
+			stored[service+":"+account] = append([]byte(nil), secret...)
+			return nil
+		},
+		delete: func(service, account string) error {
+			if _, ok := stored[service+":"+account]; !ok {
+				return errKeyringNotFound
+			}
+			delete(stored, service+":"+account)
+			return nil
+		},
+	}
+
+	// A Management API token with a full list of scopes is around 4KB.
+	large, err := encodeToken(&oauth2.Token{
+		AccessToken: "eyJhbGciOiJSUzI1NiJ9." + strings.Repeat("c2NvcGU", 570) + ".signature",
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(time.Hour).Round(time.Second),
+	})
+	require.NoError(t, err)
+	require.Greater(t, len(large), 4000)
+
+	require.NoError(t, k.Set("service", "key", large))
+	assert.Len(t, stored, 3)
+
+	secret, err := k.Get("service", "key")
+	require.NoError(t, err)
+	assert.Equal(t, large, secret)
+
+	// Replacing it with a smaller secret removes the chunks.
+	require.NoError(t, k.Set("service", "key", []byte("small")))
+	assert.Equal(t, map[string][]byte{"service:key": []byte("small")}, stored)
+
+	secret, err = k.Get("service", "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("small"), secret)
+
+	require.NoError(t, k.Set("service", "key", large))
+	require.NoError(t, k.Delete("service", "key"))
+	assert.Empty(t, stored)
+
+	_, err = k.Get("service", "key")
+	assert.ErrorIs(t, err, errKeyringNotFound)
+}
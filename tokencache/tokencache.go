@@ -0,0 +1,45 @@
+// Package tokencache provides caches persisting the access tokens obtained by
+// the Auth0 clients, so that they can be reused by other processes or after a
+// restart rather than requesting new ones.
+//
+// Keyring stores the tokens in the keyring of the operating system, which
+// suits command line tools, while Encrypted stores them encrypted in a file or
+// any other Storage, which suits long-running workers.
+//
+//	m, err := management.New(
+//		domain,
+//		management.WithClientCredentials(clientID, clientSecret),
+//		management.WithTokenCache(tokencache.NewKeyring("my-cli")),
+//	)
+package tokencache
+
+import (
+	"encoding/json"
+
+	"golang.org/x/oauth2"
+)
+
+// Cache persists access tokens under keys identifying what they were issued
+// for. Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the token cached under key, or nil if there is none.
+	Get(key string) (*oauth2.Token, error)
+
+	// Set caches the token under key, replacing any token cached under it.
+	Set(key string, token *oauth2.Token) error
+
+	// Delete removes the token cached under key, if any.
+	Delete(key string) error
+}
+
+func encodeToken(token *oauth2.Token) ([]byte, error) {
+	return json.Marshal(token)
+}
+
+func decodeToken(b []byte) (*oauth2.Token, error) {
+	var token oauth2.Token
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}